@@ -11,4 +11,7 @@ type EventsResponse struct {
 // Data for request body.
 type Data struct {
 	Data []byte `json:"data" msgpack:"dat"`
+	// ID (optional) is a client generated idempotency ID.
+	// If specified, data with an ID that was already seen is ignored.
+	ID string `json:"id,omitempty" msgpack:"id,omitempty"`
 }
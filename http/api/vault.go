@@ -1,10 +1,63 @@
 package api
 
-import "github.com/keys-pub/keys/dstore/events"
+import (
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/dstore/events"
+	"github.com/keys-pub/keys/saltpack"
+	"github.com/pkg/errors"
+)
 
 // VaultResponse ...
 type VaultResponse struct {
 	Vault     []*events.Event `json:"vault"`
 	Index     int64           `json:"idx"`
 	Truncated bool            `json:"truncated,omitempty"`
+	// Max is the latest index for the vault (for progress).
+	Max int64 `json:"max,omitempty"`
+	// Snapshot, if included, is the state of the vault at the snapshot index,
+	// and Vault is the events after it.
+	Snapshot *VaultSnapshot `json:"snapshot,omitempty"`
+}
+
+// VaultSnapshot is a (client encrypted) snapshot of the vault at an index.
+type VaultSnapshot struct {
+	Data      []byte `json:"data" msgpack:"dat"`
+	Index     int64  `json:"idx" msgpack:"idx"`
+	Timestamp int64  `json:"ts,omitempty" msgpack:"ts,omitempty"`
+}
+
+// VaultInvite provides an encrypted (shared) vault key to a recipient.
+type VaultInvite struct {
+	Vault        keys.ID `json:"vault" msgpack:"vault"`
+	Recipient    keys.ID `json:"recipient" msgpack:"recipient"`
+	Sender       keys.ID `json:"sender" msgpack:"sender"`
+	EncryptedKey []byte  `json:"k" msgpack:"k"` // Encrypted api.Key to recipient
+}
+
+// Key decrypted by recipient.
+// The sender is verified by the (signcrypted) encrypted key.
+func (i *VaultInvite) Key(recipient *keys.EdX25519Key) (*api.Key, keys.ID, error) {
+	key, sender, err := api.DecryptKey(i.EncryptedKey, saltpack.NewKeyring(recipient))
+	if err != nil {
+		return nil, "", err
+	}
+	if key.ID != i.Vault {
+		return nil, "", errors.Errorf("invite key mismatch")
+	}
+	var from keys.ID
+	if sender != nil {
+		from = sender.ID()
+	}
+	return key, from, nil
+}
+
+// VaultInvitesResponse ...
+type VaultInvitesResponse struct {
+	Invites []*VaultInvite `json:"invites" msgpack:"invites"`
+}
+
+// UserVaultInviteResponse ...
+type UserVaultInviteResponse struct {
+	Invite *VaultInvite `json:"invite"`
 }
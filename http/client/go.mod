@@ -6,22 +6,21 @@ require (
 	github.com/davecgh/go-spew v1.1.1
 	github.com/keys-pub/keys v0.1.18-0.20201124170605-b802bea21f73
 	github.com/keys-pub/keys-ext/firestore v0.0.0-20201120035752-fc8566e1f7c4
-	github.com/keys-pub/keys-ext/http/api v0.0.0-20201120215311-661239608411
+	github.com/keys-pub/keys-ext/http/api v0.0.0-20201124173412-72095c733b73
 	github.com/keys-pub/keys-ext/http/server v0.0.0-20201120215828-874010c80395
 	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.5.1
 	github.com/vmihailenco/msgpack/v4 v4.3.12
-	golang.org/x/crypto v0.0.0-20201117144127-c1f2f97bffc9 // indirect
 	google.golang.org/api v0.35.0
 	google.golang.org/protobuf v1.25.0 // indirect
 )
 
 // replace github.com/keys-pub/keys => ../../../keys
 
-// replace github.com/keys-pub/keys-ext/http/api => ../api
+replace github.com/keys-pub/keys-ext/http/api => ../api
 
-// replace github.com/keys-pub/keys-ext/http/server => ../server
+replace github.com/keys-pub/keys-ext/http/server => ../server
 
 // replace github.com/keys-pub/keys-ext/firestore => ../../firestore
 
-// replace github.com/keys-pub/keys-ext/ws/api => ../../ws/api
+replace github.com/keys-pub/keys-ext/ws/api => ../../ws/api
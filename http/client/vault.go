@@ -22,6 +22,29 @@ type Vault struct {
 	Events    []*VaultEvent
 	Index     int64
 	Truncated bool
+	// Snapshot (if included) is the vault state before Events.
+	Snapshot *VaultSnapshot
+	// Max is the latest index on the remote (for progress).
+	Max int64
+	// Size is the response size in bytes (for progress).
+	Size int64
+}
+
+// VaultSnapshot is the state of the vault at an index, the last event for
+// each path.
+type VaultSnapshot struct {
+	Events []*VaultEvent
+	Index  int64
+	// Timestamp is set from the remote API (untrusted).
+	Timestamp time.Time
+}
+
+// snapshotEvent is VaultEvent with the remote index, for a snapshot.
+type snapshotEvent struct {
+	Path  string `msgpack:"p"`
+	Data  []byte `msgpack:"dat"`
+	ID    string `msgpack:"id,omitempty"`
+	Index int64  `msgpack:"idx"`
 }
 
 // VaultEvent describes a vault event.
@@ -30,6 +53,9 @@ type VaultEvent struct {
 	Path string `json:"path" msgpack:"p"`
 	// Data ...
 	Data []byte `json:"data" msgpack:"dat"`
+	// ID (optional) is an idempotency ID, so the same event, if sent again,
+	// isn't added twice.
+	ID string `json:"id,omitempty" msgpack:"id,omitempty"`
 
 	// RemoteIndex is set from the remote events API (untrusted).
 	RemoteIndex int64 `json:"-" msgpack:"-"`
@@ -63,6 +89,7 @@ func (c *Client) VaultSend(ctx context.Context, key *keys.EdX25519Key, events []
 		}
 		out = append(out, &api.Data{
 			Data: vaultEncrypt(b, key),
+			ID:   event.ID,
 		})
 	}
 
@@ -122,8 +149,7 @@ func (c *Client) Vault(ctx context.Context, key *keys.EdX25519Key, opt ...VaultO
 		params.Add("idx", strconv.FormatInt(opts.Index, 10))
 	}
 	if opts.Limit != 0 {
-		// TODO: Support limit
-		return nil, errors.Errorf("limit not currently supported")
+		params.Add("limit", strconv.Itoa(opts.Limit))
 	}
 
 	resp, err := c.get(ctx, path, params, http.Authorization(key))
@@ -139,10 +165,100 @@ func (c *Client) Vault(ctx context.Context, key *keys.EdX25519Key, opt ...VaultO
 		return nil, err
 	}
 
-	return vaultDecryptResponse(&out, key)
+	vault, err := vaultDecryptResponse(&out, key)
+	if err != nil {
+		return nil, err
+	}
+	vault.Size = int64(len(resp.Data))
+	return vault, nil
+}
+
+// VaultSnapshotSave saves a snapshot of the vault with a key.
+// The snapshot is encrypted with the key before saving.
+func (c *Client) VaultSnapshotSave(ctx context.Context, key *keys.EdX25519Key, snapshot *VaultSnapshot) error {
+	path := dstore.Path("vault", key.ID(), "snapshot")
+	vals := url.Values{}
+
+	events := make([]*snapshotEvent, 0, len(snapshot.Events))
+	for _, event := range snapshot.Events {
+		if event.RemoteIndex == 0 {
+			return errors.Errorf("remote index is required for snapshot")
+		}
+		events = append(events, &snapshotEvent{
+			Path:  event.Path,
+			Data:  event.Data,
+			ID:    event.ID,
+			Index: event.RemoteIndex,
+		})
+	}
+	mb, err := msgpack.Marshal(events)
+	if err != nil {
+		return err
+	}
+	req := &api.VaultSnapshot{
+		Data:  vaultEncrypt(mb, key),
+		Index: snapshot.Index,
+	}
+	b, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.put(ctx, path, vals, bytes.NewReader(b), http.ContentHash(b), http.Authorization(key)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// VaultCompact compacts the vault, dropping events superseded by the saved
+// snapshot.
+func (c *Client) VaultCompact(ctx context.Context, key *keys.EdX25519Key) error {
+	path := dstore.Path("vault", key.ID(), "compact")
+	vals := url.Values{}
+	if _, err := c.post(ctx, path, vals, nil, "", http.Authorization(key)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func vaultDecryptSnapshot(snapshot *api.VaultSnapshot, key *keys.EdX25519Key) (*VaultSnapshot, error) {
+	decrypted, err := vaultDecrypt(snapshot.Data, key)
+	if err != nil {
+		return nil, err
+	}
+	var events []*snapshotEvent
+	if err := msgpack.Unmarshal(decrypted, &events); err != nil {
+		return nil, err
+	}
+	out := make([]*VaultEvent, 0, len(events))
+	for _, event := range events {
+		if event.Index > snapshot.Index {
+			return nil, errors.Errorf("invalid snapshot event index")
+		}
+		out = append(out, &VaultEvent{
+			Path:        event.Path,
+			Data:        event.Data,
+			ID:          event.ID,
+			RemoteIndex: event.Index,
+		})
+	}
+	return &VaultSnapshot{
+		Events:    out,
+		Index:     snapshot.Index,
+		Timestamp: tsutil.ConvertMillis(snapshot.Timestamp),
+	}, nil
 }
 
 func vaultDecryptResponse(resp *api.VaultResponse, key *keys.EdX25519Key) (*Vault, error) {
+	var snapshot *VaultSnapshot
+	if resp.Snapshot != nil {
+		s, err := vaultDecryptSnapshot(resp.Snapshot, key)
+		if err != nil {
+			return nil, err
+		}
+		snapshot = s
+	}
+
 	out := make([]*VaultEvent, 0, len(resp.Vault))
 	for _, revent := range resp.Vault {
 		decrypted, err := vaultDecrypt(revent.Data, key)
@@ -157,7 +273,7 @@ func vaultDecryptResponse(resp *api.VaultResponse, key *keys.EdX25519Key) (*Vaul
 		event.RemoteIndex = revent.Index
 		out = append(out, &event)
 	}
-	return &Vault{Events: out, Index: resp.Index, Truncated: resp.Truncated}, nil
+	return &Vault{Events: out, Index: resp.Index, Truncated: resp.Truncated, Snapshot: snapshot, Max: resp.Max}, nil
 }
 
 func vaultEncrypt(b []byte, key *keys.EdX25519Key) []byte {
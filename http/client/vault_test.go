@@ -69,6 +69,19 @@ func TestVault(t *testing.T) {
 	require.Equal(t, "/col1/key5", vault.Events[3].Path)
 	require.Equal(t, []byte("test5"), vault.Events[3].Data)
 
+	// Limit
+	vault, err = cl.Vault(context.TODO(), alice, client.VaultLimit(4))
+	require.NoError(t, err)
+	require.Equal(t, 4, len(vault.Events))
+	require.True(t, vault.Truncated)
+	require.Equal(t, int64(6), vault.Max)
+	require.True(t, vault.Size > 0)
+	vault, err = cl.Vault(context.TODO(), alice, client.VaultIndex(vault.Index), client.VaultLimit(4))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vault.Events))
+	require.False(t, vault.Truncated)
+	require.Equal(t, vault.Max, vault.Index)
+
 	err = cl.VaultDelete(context.TODO(), alice)
 	require.NoError(t, err)
 
@@ -83,6 +96,55 @@ func TestVault(t *testing.T) {
 	require.EqualError(t, err, "vault was deleted (404)")
 }
 
+func TestVaultSnapshot(t *testing.T) {
+	var err error
+	env, closeFn := newEnv(t)
+	defer closeFn()
+
+	cl := newTestClient(t, env)
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	events := []*client.VaultEvent{
+		client.NewVaultEvent("/col1/key1", []byte("test1.1")),
+		client.NewVaultEvent("/col1/key2", []byte("test2")),
+		client.NewVaultEvent("/col1/key1", []byte("test1.2")),
+	}
+	err = cl.VaultSend(context.TODO(), alice, events)
+	require.NoError(t, err)
+
+	vault, err := cl.Vault(context.TODO(), alice)
+	require.NoError(t, err)
+	require.Nil(t, vault.Snapshot)
+	require.Equal(t, 3, len(vault.Events))
+
+	snapshot := &client.VaultSnapshot{
+		Events: []*client.VaultEvent{vault.Events[1], vault.Events[2]},
+		Index:  vault.Index,
+	}
+	err = cl.VaultSnapshotSave(context.TODO(), alice, snapshot)
+	require.NoError(t, err)
+	err = cl.VaultCompact(context.TODO(), alice)
+	require.NoError(t, err)
+
+	err = cl.VaultSend(context.TODO(), alice, []*client.VaultEvent{client.NewVaultEvent("/col1/key3", []byte("test3"))})
+	require.NoError(t, err)
+
+	vault, err = cl.Vault(context.TODO(), alice)
+	require.NoError(t, err)
+	require.NotNil(t, vault.Snapshot)
+	require.Equal(t, int64(3), vault.Snapshot.Index)
+	require.Equal(t, 2, len(vault.Snapshot.Events))
+	require.Equal(t, "/col1/key2", vault.Snapshot.Events[0].Path)
+	require.Equal(t, []byte("test2"), vault.Snapshot.Events[0].Data)
+	require.Equal(t, int64(2), vault.Snapshot.Events[0].RemoteIndex)
+	require.Equal(t, "/col1/key1", vault.Snapshot.Events[1].Path)
+	require.Equal(t, []byte("test1.2"), vault.Snapshot.Events[1].Data)
+	require.Equal(t, int64(3), vault.Snapshot.Events[1].RemoteIndex)
+	require.Equal(t, 1, len(vault.Events))
+	require.Equal(t, []byte("test3"), vault.Events[0].Data)
+	require.Equal(t, int64(4), vault.Index)
+}
+
 func TestVaultMax(t *testing.T) {
 	// api.SetLogger(NewLogger(DebugLevel))
 	env, closeFn := newEnv(t) // client.NewLogger(client.DebugLevel)
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	kapi "github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/http"
+)
+
+// VaultInvite shares a vault key with recipients.
+// The vault key is encrypted (signcrypted) from the sender to each recipient.
+// The name (optional) is included with the encrypted key.
+func (c *Client) VaultInvite(ctx context.Context, key *keys.EdX25519Key, sender *keys.EdX25519Key, name string, recipients ...keys.ID) error {
+	path := dstore.Path("vault", key.ID(), "invites")
+	invites := make([]*api.VaultInvite, 0, len(recipients))
+	for _, recipient := range recipients {
+		k := kapi.NewKey(key)
+		k.Notes = name
+		encryptedKey, err := kapi.EncryptKey(k, sender, recipient)
+		if err != nil {
+			return err
+		}
+		invites = append(invites, &api.VaultInvite{
+			Vault:        key.ID(),
+			Recipient:    recipient,
+			Sender:       sender.ID(),
+			EncryptedKey: encryptedKey,
+		})
+	}
+
+	b, err := json.Marshal(invites)
+	if err != nil {
+		return err
+	}
+
+	params := url.Values{}
+	if _, err := c.post(ctx, path, params, bytes.NewReader(b), http.ContentHash(b), http.Authorization(key)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// VaultInvites returns invites for a vault, the users it is shared with.
+func (c *Client) VaultInvites(ctx context.Context, key *keys.EdX25519Key) ([]*api.VaultInvite, error) {
+	path := dstore.Path("vault", key.ID(), "invites")
+	params := url.Values{}
+	resp, err := c.get(ctx, path, params, http.Authorization(key))
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	var out api.VaultInvitesResponse
+	if err := json.Unmarshal(resp.Data, &out); err != nil {
+		return nil, err
+	}
+	return out.Invites, nil
+}
+
+// VaultInviteDelete removes an invite for a recipient.
+func (c *Client) VaultInviteDelete(ctx context.Context, key *keys.EdX25519Key, recipient keys.ID) error {
+	path := dstore.Path("vault", key.ID(), "invite", recipient)
+	params := url.Values{}
+	if _, err := c.delete(ctx, path, params, http.Authorization(key)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UserVaultInvites returns vault invites for a user.
+func (c *Client) UserVaultInvites(ctx context.Context, user *keys.EdX25519Key) ([]*api.VaultInvite, error) {
+	path := dstore.Path("user", user.ID(), "vault-invites")
+	params := url.Values{}
+	resp, err := c.get(ctx, path, params, http.Authorization(user))
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	var out api.VaultInvitesResponse
+	if err := json.Unmarshal(resp.Data, &out); err != nil {
+		return nil, err
+	}
+	return out.Invites, nil
+}
+
+// UserVaultInvite returns a vault invite for a user (if one exists).
+func (c *Client) UserVaultInvite(ctx context.Context, user *keys.EdX25519Key, vault keys.ID) (*api.VaultInvite, error) {
+	path := dstore.Path("user", user.ID(), "vault-invite", vault)
+	params := url.Values{}
+	resp, err := c.get(ctx, path, params, http.Authorization(user))
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	var out api.UserVaultInviteResponse
+	if err := json.Unmarshal(resp.Data, &out); err != nil {
+		return nil, err
+	}
+	return out.Invite, nil
+}
+
+// UserVaultInviteDelete removes a vault invite for a user (to decline or
+// leave a shared vault).
+func (c *Client) UserVaultInviteDelete(ctx context.Context, user *keys.EdX25519Key, vault keys.ID) error {
+	path := dstore.Path("user", user.ID(), "vault-invite", vault)
+	params := url.Values{}
+	if _, err := c.delete(ctx, path, params, http.Authorization(user)); err != nil {
+		return err
+	}
+	return nil
+}
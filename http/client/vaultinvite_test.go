@@ -0,0 +1,63 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys-ext/http/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultInvites(t *testing.T) {
+	env, closeFn := newEnv(t)
+	defer closeFn()
+	ctx := context.TODO()
+
+	tk := testKeysSeeded()
+	alice, bob, vk := tk.alice, tk.bob, tk.channel
+
+	aliceClient := newTestClient(t, env)
+	bobClient := newTestClient(t, env)
+
+	err := aliceClient.VaultSend(ctx, vk, []*client.VaultEvent{client.NewVaultEvent("/test", []byte("value"))})
+	require.NoError(t, err)
+
+	err = aliceClient.VaultInvite(ctx, vk, alice, "Deploy", alice.ID(), bob.ID())
+	require.NoError(t, err)
+
+	invites, err := aliceClient.VaultInvites(ctx, vk)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(invites))
+
+	// Bob
+	invites, err = bobClient.UserVaultInvites(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(invites))
+	key, sender, err := invites[0].Key(bob)
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), sender)
+	require.Equal(t, "Deploy", key.Notes)
+	sk, err := key.AsEdX25519()
+	require.NoError(t, err)
+	vault, err := bobClient.Vault(ctx, sk)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(vault.Events))
+
+	invite, err := bobClient.UserVaultInvite(ctx, bob, vk.ID())
+	require.NoError(t, err)
+	require.NotNil(t, invite)
+
+	// Remove bob
+	err = aliceClient.VaultInviteDelete(ctx, vk, bob.ID())
+	require.NoError(t, err)
+	invite, err = bobClient.UserVaultInvite(ctx, bob, vk.ID())
+	require.NoError(t, err)
+	require.Nil(t, invite)
+
+	// Delete vault removes invites
+	err = aliceClient.VaultDelete(ctx, vk)
+	require.NoError(t, err)
+	invites, err = aliceClient.UserVaultInvites(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(invites))
+}
@@ -1,19 +1,19 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"strconv"
 
 	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/dstore"
 	"github.com/keys-pub/keys/dstore/events"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 )
 
 func (s *Server) events(c echo.Context, path string, max int) (*api.EventsResponse, error) {
-	request := c.Request()
-	ctx := request.Context()
-
 	var index int
 	if f := c.QueryParam("idx"); f != "" {
 		i, err := strconv.Atoi(f)
@@ -50,13 +50,19 @@ func (s *Server) events(c echo.Context, path string, max int) (*api.EventsRespon
 		return nil, ErrResponse(c, http.StatusBadRequest, errors.Errorf("invalid dir"))
 	}
 
+	return s.eventsFrom(c, path, int64(index), limit, dir)
+}
+
+// eventsFrom returns events from index.
+func (s *Server) eventsFrom(c echo.Context, path string, index int64, limit int, dir events.Direction) (*api.EventsResponse, error) {
+	ctx := c.Request().Context()
 	s.logger.Infof("Events %s (from=%d)", path, index)
-	iter, err := s.fi.Events(ctx, path, events.Index(int64(index)), events.Limit(int64(limit)), events.WithDirection(dir))
+	iter, err := s.fi.Events(ctx, path, events.Index(index), events.Limit(int64(limit)), events.WithDirection(dir))
 	if err != nil {
 		return nil, s.internalError(c, err)
 	}
 	defer iter.Release()
-	to := int64(index)
+	to := index
 	events := []*events.Event{}
 	for {
 		event, err := iter.Next()
@@ -76,3 +82,49 @@ func (s *Server) events(c echo.Context, path string, max int) (*api.EventsRespon
 		Index:  to,
 	}, nil
 }
+
+// eventsDeleteTo removes events at or below index, and returns the number of
+// events removed.
+// The events interface can only remove all events at a path, so we iterate
+// over the event log documents.
+func (s *Server) eventsDeleteTo(ctx context.Context, path string, index int64) (int, error) {
+	iter, err := s.fi.DocumentIterator(ctx, dstore.Path(path, "log"))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Release()
+	paths := []string{}
+	for {
+		doc, err := iter.Next()
+		if err != nil {
+			return 0, err
+		}
+		if doc == nil {
+			break
+		}
+		idx, err := eventDocumentIndex(doc)
+		if err != nil {
+			return 0, err
+		}
+		if idx <= index {
+			paths = append(paths, doc.Path)
+		}
+	}
+	if err := s.fi.DeleteAll(ctx, paths); err != nil {
+		return 0, err
+	}
+	return len(paths), nil
+}
+
+// eventDocumentIndex returns the index of an event log document.
+// Firestore stores the index as a field, dstore.Mem in the (JSON) event data.
+func eventDocumentIndex(doc *dstore.Document) (int64, error) {
+	if idx, ok := doc.Int64("idx"); ok {
+		return idx, nil
+	}
+	var event events.Event
+	if err := json.Unmarshal(doc.Bytes("data"), &event); err != nil {
+		return 0, errors.Wrapf(err, "invalid event %s", doc.Path)
+	}
+	return event.Index, nil
+}
@@ -19,8 +19,8 @@ require (
 
 // replace github.com/keys-pub/keys => ../../../keys
 
-// replace github.com/keys-pub/keys-ext/http/api => ../api
+replace github.com/keys-pub/keys-ext/http/api => ../api
 
 // replace github.com/keys-pub/keys-ext/firestore => ../../firestore
 
-// replace github.com/keys-pub/keys-ext/ws/api => ../../ws/api
+replace github.com/keys-pub/keys-ext/ws/api => ../../ws/api
@@ -18,6 +18,8 @@ type Redis interface {
 	Set(ctx context.Context, k string, v string) error
 	// Delete key.
 	Delete(ctx context.Context, k string) error
+	// GetDelete returns value at key and deletes it, atomically.
+	GetDelete(ctx context.Context, k string) (string, error)
 	// Expire key.
 	Expire(ctx context.Context, k string, dt time.Duration) error
 	// Increment value at key.
@@ -97,6 +99,20 @@ func (m *rdsTest) Delete(ctx context.Context, k string) error {
 	return nil
 }
 
+func (m *rdsTest) GetDelete(ctx context.Context, k string) (string, error) {
+	m.Lock()
+	defer m.Unlock()
+	e, err := m.get(ctx, k)
+	if err != nil {
+		return "", err
+	}
+	delete(m.kv, k)
+	if e == nil {
+		return "", nil
+	}
+	return e.Value, nil
+}
+
 func (m *rdsTest) Set(ctx context.Context, k string, v string) error {
 	m.Lock()
 	defer m.Unlock()
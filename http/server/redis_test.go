@@ -35,6 +35,13 @@ func TestRedis(t *testing.T) {
 	val, err = rds.Get(context.TODO(), n1)
 	require.NoError(t, err)
 	require.Equal(t, "2", val)
+
+	val, err = rds.GetDelete(context.TODO(), n1)
+	require.NoError(t, err)
+	require.Equal(t, "2", val)
+	val, err = rds.GetDelete(context.TODO(), n1)
+	require.NoError(t, err)
+	require.Empty(t, val)
 }
 
 func TestRedisExpiration(t *testing.T) {
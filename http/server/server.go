@@ -148,6 +148,17 @@ func (s *Server) AddRoutes(e *echo.Echo) {
 	e.GET("/vault/:kid", s.listVault)
 	e.DELETE("/vault/:kid", s.deleteVault)
 	e.HEAD("/vault/:kid", s.headVault)
+	e.PUT("/vault/:kid/snapshot", s.putVaultSnapshot)
+	e.POST("/vault/:kid/compact", s.postVaultCompact)
+	// Vault (invite)
+	e.POST("/vault/:kid/invites", s.postVaultInvites)
+	e.GET("/vault/:kid/invites", s.getVaultInvites)
+	e.DELETE("/vault/:kid/invite/:rid", s.deleteVaultInvite)
+
+	// User (vaults)
+	e.GET("/user/:kid/vault-invites", s.userVaultInvites)
+	e.GET("/user/:kid/vault-invite/:vid", s.getUserVaultInvite)
+	e.DELETE("/user/:kid/vault-invite/:vid", s.deleteUserVaultInvite)
 
 	// Disco
 	e.PUT("/disco/:kid/:rid/:type", s.putDisco)
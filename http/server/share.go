@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
@@ -10,6 +11,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// shareMaxSize is the max size for a share, large enough for a sealed secret.
+const shareMaxSize = 64 * 1024
+
 func (s *Server) putShare(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 	ctx := c.Request().Context()
@@ -17,15 +21,17 @@ func (s *Server) putShare(c echo.Context) error {
 	if c.Request().Body == nil {
 		return ErrBadRequest(c, errors.Errorf("missing body"))
 	}
+	if c.Request().ContentLength > shareMaxSize {
+		return ErrBadRequest(c, errors.Errorf("message too large (greater than 64KiB)"))
+	}
 
-	b, err := ioutil.ReadAll(c.Request().Body)
+	b, err := ioutil.ReadAll(io.LimitReader(c.Request().Body, shareMaxSize+1))
 	if err != nil {
 		return s.internalError(c, err)
 	}
 
-	if len(b) > 512 {
-		// TODO: Check length before reading data
-		return ErrBadRequest(c, errors.Errorf("message too large (greater than 512 bytes)"))
+	if len(b) > shareMaxSize {
+		return ErrBadRequest(c, errors.Errorf("message too large (greater than 64KiB)"))
 	}
 
 	auth, err := s.auth(c, newAuth("Authorization", "kid", b))
@@ -71,16 +77,13 @@ func (s *Server) getShare(c echo.Context) error {
 	}
 
 	key := fmt.Sprintf("s-%s", auth.KID)
-	out, err := s.rds.Get(ctx, key)
+	// Get and delete (atomically), so only one receiver can read it.
+	out, err := s.rds.GetDelete(ctx, key)
 	if err != nil {
 		return s.internalError(c, err)
 	}
 	if out == "" {
 		return ErrNotFound(c, nil)
 	}
-	// Delete after get
-	if err := s.rds.Delete(ctx, key); err != nil {
-		return s.internalError(c, err)
-	}
 	return c.Blob(http.StatusOK, echo.MIMEOctetStream, []byte(out))
 }
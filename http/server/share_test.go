@@ -2,12 +2,14 @@ package server_test
 
 import (
 	"bytes"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys/dstore"
 	"github.com/keys-pub/keys/http"
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -79,4 +81,52 @@ func TestShare(t *testing.T) {
 	code, _, body = srv.Serve(req)
 	require.Equal(t, http.StatusForbidden, code)
 	require.Equal(t, `{"error":{"code":403,"message":"auth failed"}}`, body)
+
+	// PUT /share/:kid (too large)
+	large := bytes.Repeat([]byte{0x01}, 64*1024+1)
+	req, err = http.NewAuthRequest("PUT", dstore.Path("share", key.ID()), bytes.NewReader(large), http.ContentHash(large), env.clock.Now(), http.Authorization(key))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"message too large (greater than 64KiB)"}}`, body)
+}
+
+func TestShareConcurrentReceive(t *testing.T) {
+	// Test clock isn't safe for concurrent use
+	clock := tsutil.NewClock()
+	env := newEnvWithFire(t, testFire(t, clock), clock)
+	srv := newTestServer(t, env)
+
+	key := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	// PUT /share/:kid
+	content := []byte("test1")
+	req, err := http.NewAuthRequest("PUT", dstore.Path("share", key.ID())+"?expire=1m", bytes.NewReader(content), http.ContentHash(content), env.clock.Now(), http.Authorization(key))
+	require.NoError(t, err)
+	code, _, _ := srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+
+	// GET /share/:kid (concurrently)
+	n := 10
+	reqs := make([]*http.Request, 0, n)
+	for i := 0; i < n; i++ {
+		req, err := http.NewAuthRequest("GET", dstore.Path("share", key.ID()), nil, "", env.clock.Now(), http.Authorization(key))
+		require.NoError(t, err)
+		reqs = append(reqs, req)
+	}
+	var wg sync.WaitGroup
+	var mtx sync.Mutex
+	codes := map[int]int{}
+	for _, req := range reqs {
+		wg.Add(1)
+		go func(req *http.Request) {
+			defer wg.Done()
+			code, _, _ := srv.Serve(req)
+			mtx.Lock()
+			codes[code]++
+			mtx.Unlock()
+		}(req)
+	}
+	wg.Wait()
+	require.Equal(t, map[int]int{http.StatusOK: 1, http.StatusNotFound: n - 1}, codes)
 }
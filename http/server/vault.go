@@ -1,14 +1,21 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys-ext/http/api"
 	"github.com/keys-pub/keys/dstore"
 	"github.com/keys-pub/keys/dstore/events"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 )
@@ -32,25 +39,62 @@ func (s *Server) listVault(c echo.Context) error {
 		return ErrNotFound(c, errVaultDeleted)
 	}
 
-	limit := 1000
+	ctx := c.Request().Context()
+	limit, err := queryLimit(c, 1000)
+	if err != nil {
+		return ErrBadRequest(c, err)
+	}
 	path := dstore.Path("vaults", auth.KID)
-	resp, err := s.events(c, path, limit)
+
+	// If listing from the start, or from before the vault was compacted, we
+	// return the snapshot and the events after it.
+	snapshot, err := s.vaultSnapshot(ctx, auth.KID)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if snapshot != nil {
+		index, err := queryIndex(c)
+		if err != nil {
+			return ErrBadRequest(c, err)
+		}
+		compacted, err := s.vaultCompacted(ctx, auth.KID)
+		if err != nil {
+			return s.internalError(c, err)
+		}
+		if index != 0 && index >= compacted {
+			snapshot = nil
+		}
+	}
+
+	var resp *api.EventsResponse
+	if snapshot != nil {
+		resp, err = s.eventsFrom(c, path, snapshot.Index, limit, events.Ascending)
+	} else {
+		resp, err = s.events(c, path, limit)
+	}
 	if err != nil {
 		return err
 	}
 	if len(resp.Events) == 0 && resp.Index == 0 {
 		return ErrNotFound(c, errVaultNotFound)
 	}
-	truncated := false
-	if len(resp.Events) >= limit {
-		// TODO: This is a lie if the number of results are exactly equal to limit
-		truncated = true
+
+	// Max (latest) index, to know if truncated, and for client progress.
+	positions, err := s.fi.EventPositions(ctx, []string{path})
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	max := resp.Index
+	if len(positions) > 0 && positions[0].Index > max {
+		max = positions[0].Index
 	}
 
 	out := &api.VaultResponse{
 		Vault:     resp.Events,
 		Index:     resp.Index,
-		Truncated: truncated,
+		Truncated: resp.Index < max,
+		Snapshot:  snapshot,
+		Max:       max,
 	}
 	return JSON(c, http.StatusOK, out)
 }
@@ -89,12 +133,49 @@ func (s *Server) postVault(c echo.Context) error {
 	ctx := c.Request().Context()
 	cpath := dstore.Path("vaults", auth.KID)
 	data := make([][]byte, 0, len(req))
+	// IDs by position in data
+	ids := map[int]string{}
+	seen := map[string]bool{}
 	for _, d := range req {
+		if d.ID != "" {
+			if len(d.ID) > 128 || strings.Contains(d.ID, "/") {
+				return ErrBadRequest(c, errors.Errorf("invalid id"))
+			}
+			if seen[d.ID] {
+				continue
+			}
+			seen[d.ID] = true
+			exists, err := s.fi.Exists(ctx, vaultIDPath(auth.KID, d.ID))
+			if err != nil {
+				return s.internalError(c, err)
+			}
+			if exists {
+				s.logger.Infof("Ignoring replayed vault event %s", d.ID)
+				continue
+			}
+			ids[len(data)] = d.ID
+		}
 		data = append(data, d.Data)
 	}
-	if _, _, err := s.fi.EventsAdd(ctx, cpath, data); err != nil {
+	if len(data) == 0 {
+		var out struct{}
+		return JSON(c, http.StatusOK, out)
+	}
+	added, _, err := s.fi.EventsAdd(ctx, cpath, data)
+	if err != nil {
 		return s.internalError(c, err)
 	}
+	// Record IDs after the events are added, so if this fails, we might get a
+	// duplicate on retry, but we never drop an event.
+	// The event index and time are saved, so the ID is removed when the event
+	// is compacted (after vaultIDRetention).
+	ts := tsutil.Millis(s.clock.Now())
+	for i, id := range ids {
+		val := map[string]interface{}{"idx": added[i].Index, "ts": ts}
+		if err := s.fi.Set(ctx, vaultIDPath(auth.KID, id), val); err != nil {
+			return s.internalError(c, err)
+		}
+	}
 
 	var out struct{}
 	return JSON(c, http.StatusOK, out)
@@ -121,6 +202,19 @@ func (s *Server) deleteVault(c echo.Context) error {
 		return s.internalError(c, err)
 	}
 
+	if err := s.deleteVaultSnapshot(ctx, auth.KID); err != nil {
+		return s.internalError(c, err)
+	}
+	if _, err := s.fi.Delete(ctx, dstore.Path("vaults-compact", auth.KID)); err != nil {
+		return s.internalError(c, err)
+	}
+	if err := s.deleteVaultIDs(ctx, auth.KID, 0, time.Time{}); err != nil {
+		return s.internalError(c, err)
+	}
+	if err := s.removeVaultInvites(ctx, auth.KID); err != nil {
+		return s.internalError(c, err)
+	}
+
 	cpath := dstore.Path("vaults", auth.KID)
 	exists, err := s.fi.EventsDelete(ctx, cpath)
 	if err != nil {
@@ -168,11 +262,326 @@ func (s *Server) headVault(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
+// vaultSnapshotMaxSize is the max (request body) size for a vault snapshot.
+const vaultSnapshotMaxSize = 32 * 1024 * 1024
+
+var errVaultSnapshotTooLarge = errors.New("snapshot too large (greater than 32MiB)")
+
+func (s *Server) putVaultSnapshot(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	if c.Request().Body == nil {
+		return ErrBadRequest(c, errors.Errorf("no body data"))
+	}
+	if c.Request().ContentLength > vaultSnapshotMaxSize {
+		return ErrEntityTooLarge(c, errVaultSnapshotTooLarge)
+	}
+	body := http.MaxBytesReader(c.Response(), c.Request().Body, vaultSnapshotMaxSize)
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		// MaxBytesReader returns the max bytes and an error if exceeded.
+		if len(b) == vaultSnapshotMaxSize {
+			return ErrEntityTooLarge(c, errVaultSnapshotTooLarge)
+		}
+		return s.internalError(c, err)
+	}
+
+	auth, err := s.auth(c, newAuth("Authorization", "kid", b))
+	if err != nil {
+		return ErrForbidden(c, err)
+	}
+
+	deleted, err := s.isVaultDeleted(c, auth.KID)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if deleted {
+		return ErrNotFound(c, errVaultDeleted)
+	}
+
+	var snapshot api.VaultSnapshot
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return ErrBadRequest(c, err)
+	}
+	if len(snapshot.Data) == 0 {
+		return ErrBadRequest(c, errors.Errorf("no snapshot data"))
+	}
+
+	positions, err := s.fi.EventPositions(ctx, []string{dstore.Path("vaults", auth.KID)})
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if len(positions) == 0 {
+		return ErrNotFound(c, errVaultNotFound)
+	}
+	if snapshot.Index <= 0 || snapshot.Index > positions[0].Index {
+		return ErrBadRequest(c, errors.Errorf("invalid snapshot index"))
+	}
+	compacted, err := s.vaultCompacted(ctx, auth.KID)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if snapshot.Index < compacted {
+		return ErrConflict(c, errors.Errorf("snapshot is before compaction"))
+	}
+
+	snapshot.Timestamp = tsutil.Millis(s.clock.Now())
+	if err := s.setVaultSnapshot(ctx, auth.KID, &snapshot); err != nil {
+		return s.internalError(c, err)
+	}
+
+	var out struct{}
+	return JSON(c, http.StatusOK, out)
+}
+
+// postVaultCompact drops events superseded by the snapshot.
+// Events at or below the snapshot index are removed, and clients listing from
+// before the snapshot index get the snapshot instead.
+// IDs for removed events are kept for vaultIDRetention.
+func (s *Server) postVaultCompact(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuth("Authorization", "kid", nil))
+	if err != nil {
+		return ErrForbidden(c, err)
+	}
+
+	deleted, err := s.isVaultDeleted(c, auth.KID)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if deleted {
+		return ErrNotFound(c, errVaultDeleted)
+	}
+
+	snapshot, err := s.vaultSnapshot(ctx, auth.KID)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if snapshot == nil {
+		return ErrBadRequest(c, errors.Errorf("no vault snapshot"))
+	}
+
+	// Set the compacted index before removing events, so clients listing
+	// from a removed event get the snapshot.
+	val := map[string]interface{}{"idx": snapshot.Index}
+	if err := s.fi.Set(ctx, dstore.Path("vaults-compact", auth.KID), val); err != nil {
+		return s.internalError(c, err)
+	}
+	removed, err := s.eventsDeleteTo(ctx, dstore.Path("vaults", auth.KID), snapshot.Index)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	s.logger.Infof("Compacted %d vault events (to=%d)", removed, snapshot.Index)
+	// Keep recent IDs, so a client retrying a push (that succeeded, but wasn't
+	// acknowledged) doesn't add duplicate events after compaction.
+	before := s.clock.Now().Add(-vaultIDRetention)
+	if err := s.deleteVaultIDs(ctx, auth.KID, snapshot.Index, before); err != nil {
+		return s.internalError(c, err)
+	}
+
+	var out struct{}
+	return JSON(c, http.StatusOK, out)
+}
+
+// Snapshots can be larger than the max document size (1MiB for Firestore), so
+// the snapshot data is saved in chunks.
+//
+//	vaults-snapshot/{kid}                    Snapshot info
+//	vaults-snapshot-data/{kid}-{gen}-{n}     Snapshot data chunks
+//
+// A new snapshot is saved under a new generation (gen), and the previous
+// chunks are removed after the info is updated, so a partial snapshot is never
+// listed.
+const vaultSnapshotChunkSize = 512 * 1024
+
+type vaultSnapshotInfo struct {
+	Index     int64  `msgpack:"idx"`
+	Timestamp int64  `msgpack:"ts"`
+	Gen       string `msgpack:"gen"`
+	Chunks    int    `msgpack:"n"`
+}
+
+func (s *Server) setVaultSnapshot(ctx context.Context, kid keys.ID, snapshot *api.VaultSnapshot) error {
+	prev, err := s.vaultSnapshotInfo(ctx, kid)
+	if err != nil {
+		return err
+	}
+	info := &vaultSnapshotInfo{
+		Index:     snapshot.Index,
+		Timestamp: snapshot.Timestamp,
+		Gen:       encoding.MustEncode(keys.RandBytes(16), encoding.Base62),
+	}
+	for i := 0; i < len(snapshot.Data); i += vaultSnapshotChunkSize {
+		end := i + vaultSnapshotChunkSize
+		if end > len(snapshot.Data) {
+			end = len(snapshot.Data)
+		}
+		path := vaultSnapshotChunkPath(kid, info.Gen, info.Chunks)
+		if err := s.fi.Set(ctx, path, dstore.Data(snapshot.Data[i:end])); err != nil {
+			return err
+		}
+		info.Chunks++
+	}
+	if err := s.fi.Set(ctx, dstore.Path("vaults-snapshot", kid), dstore.From(info)); err != nil {
+		return err
+	}
+	if prev != nil {
+		return s.fi.DeleteAll(ctx, prev.chunkPaths(kid))
+	}
+	return nil
+}
+
+func (s *Server) vaultSnapshot(ctx context.Context, kid keys.ID) (*api.VaultSnapshot, error) {
+	info, err := s.vaultSnapshotInfo(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	docs, err := s.fi.GetAll(ctx, info.chunkPaths(kid))
+	if err != nil {
+		return nil, err
+	}
+	if len(docs) != info.Chunks {
+		return nil, errors.Errorf("missing vault snapshot data")
+	}
+	data := []byte{}
+	for _, doc := range docs {
+		data = append(data, doc.Bytes("data")...)
+	}
+	return &api.VaultSnapshot{
+		Data:      data,
+		Index:     info.Index,
+		Timestamp: info.Timestamp,
+	}, nil
+}
+
+func (s *Server) vaultSnapshotInfo(ctx context.Context, kid keys.ID) (*vaultSnapshotInfo, error) {
+	var info vaultSnapshotInfo
+	ok, err := s.fi.Load(ctx, dstore.Path("vaults-snapshot", kid), &info)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &info, nil
+}
+
+func (s *Server) deleteVaultSnapshot(ctx context.Context, kid keys.ID) error {
+	info, err := s.vaultSnapshotInfo(ctx, kid)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return nil
+	}
+	if _, err := s.fi.Delete(ctx, dstore.Path("vaults-snapshot", kid)); err != nil {
+		return err
+	}
+	return s.fi.DeleteAll(ctx, info.chunkPaths(kid))
+}
+
+func (i *vaultSnapshotInfo) chunkPaths(kid keys.ID) []string {
+	paths := make([]string, 0, i.Chunks)
+	for n := 0; n < i.Chunks; n++ {
+		paths = append(paths, vaultSnapshotChunkPath(kid, i.Gen, n))
+	}
+	return paths
+}
+
+func vaultSnapshotChunkPath(kid keys.ID, gen string, n int) string {
+	return dstore.Path("vaults-snapshot-data", fmt.Sprintf("%s-%s-%d", kid, gen, n))
+}
+
+// vaultCompacted returns the index the vault was compacted to, or 0.
+func (s *Server) vaultCompacted(ctx context.Context, kid keys.ID) (int64, error) {
+	doc, err := s.fi.Get(ctx, dstore.Path("vaults-compact", kid))
+	if err != nil {
+		return 0, err
+	}
+	if doc == nil {
+		return 0, nil
+	}
+	index, _ := doc.Int64("idx")
+	return index, nil
+}
+
+// queryLimit returns the limit query param, capped at max.
+func queryLimit(c echo.Context, max int) (int, error) {
+	f := c.QueryParam("limit")
+	if f == "" {
+		return max, nil
+	}
+	limit, err := strconv.Atoi(f)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid limit")
+	}
+	if limit <= 0 || limit > max {
+		return max, nil
+	}
+	return limit, nil
+}
+
+func queryIndex(c echo.Context) (int64, error) {
+	f := c.QueryParam("idx")
+	if f == "" {
+		return 0, nil
+	}
+	index, err := strconv.ParseInt(f, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid index")
+	}
+	return index, nil
+}
+
 func (s Server) isVaultDeleted(c echo.Context, kid keys.ID) (bool, error) {
 	ctx := c.Request().Context()
 	return s.fi.Exists(ctx, dstore.Path("vaults-rm", kid))
 }
 
+func vaultIDPath(kid keys.ID, id string) string {
+	return dstore.Path("vaults-id", kid.String()+"-"+id)
+}
+
+// vaultIDRetention is how long (push) IDs are kept after their events are
+// compacted, for clients retrying a push.
+const vaultIDRetention = time.Hour * 24 * 7
+
+// deleteVaultIDs removes (push) IDs for events at or below index, saved before
+// a time (if not zero), or all IDs if index is 0.
+func (s *Server) deleteVaultIDs(ctx context.Context, kid keys.ID, index int64, before time.Time) error {
+	iter, err := s.fi.DocumentIterator(ctx, "vaults-id", dstore.Prefix(kid.String()+"-"))
+	if err != nil {
+		return err
+	}
+	defer iter.Release()
+	paths := []string{}
+	for {
+		doc, err := iter.Next()
+		if err != nil {
+			return err
+		}
+		if doc == nil {
+			break
+		}
+		if index != 0 {
+			if idx, _ := doc.Int64("idx"); idx > index {
+				continue
+			}
+			if ts, _ := doc.Int64("ts"); !before.IsZero() && ts >= tsutil.Millis(before) {
+				continue
+			}
+		}
+		paths = append(paths, doc.Path)
+	}
+	return s.fi.DeleteAll(ctx, paths)
+}
+
 func (s *Server) setVaultDeleted(c echo.Context, kid keys.ID) error {
 	ctx := c.Request().Context()
 	return s.fi.Set(ctx, dstore.Path("vaults-rm", kid), dstore.Data([]byte{}))
@@ -2,11 +2,14 @@ package server_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys-ext/firestore"
@@ -209,6 +212,278 @@ func testVault(t *testing.T, env *env, alice *keys.EdX25519Key) {
 	require.Equal(t, `{"error":{"code":404,"message":"vault was deleted"}}`, body)
 }
 
+func TestVaultReplay(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServer(t, env)
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	post := func(vault []*api.Data) {
+		data, err := json.Marshal(vault)
+		require.NoError(t, err)
+		contentHash := http.ContentHash(data)
+		req, err := http.NewAuthRequest("POST", dstore.Path("vault", alice.ID()), bytes.NewReader(data), contentHash, clock.Now(), http.Authorization(alice))
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, `{}`, body)
+	}
+
+	// POST /vault/:kid
+	post([]*api.Data{
+		&api.Data{Data: []byte("test1"), ID: "id1"},
+		&api.Data{Data: []byte("test2"), ID: "id2"},
+	})
+	// POST /vault/:kid (replay, with new)
+	post([]*api.Data{
+		&api.Data{Data: []byte("test1"), ID: "id1"},
+		&api.Data{Data: []byte("test2"), ID: "id2"},
+		&api.Data{Data: []byte("test3"), ID: "id3"},
+		&api.Data{Data: []byte("test3"), ID: "id3"},
+		&api.Data{Data: []byte("test4")},
+	})
+
+	// GET /vault/:kid
+	req, err := http.NewAuthRequest("GET", dstore.Path("vault", alice.ID()), nil, "", clock.Now(), http.Authorization(alice))
+	require.NoError(t, err)
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	var resp api.VaultResponse
+	err = json.Unmarshal([]byte(body), &resp)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.Vault))
+	require.Equal(t, []byte("test1"), resp.Vault[0].Data)
+	require.Equal(t, []byte("test2"), resp.Vault[1].Data)
+	require.Equal(t, []byte("test3"), resp.Vault[2].Data)
+	require.Equal(t, []byte("test4"), resp.Vault[3].Data)
+
+	// POST /vault/:kid (invalid id)
+	data, err := json.Marshal([]*api.Data{&api.Data{Data: []byte("test5"), ID: "a/b"}})
+	require.NoError(t, err)
+	req, err = http.NewAuthRequest("POST", dstore.Path("vault", alice.ID()), bytes.NewReader(data), http.ContentHash(data), clock.Now(), http.Authorization(alice))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid id"}}`, body)
+}
+
+func TestVaultLimit(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServer(t, env)
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	// POST /vault/:kid
+	vault := []*api.Data{}
+	for i := 0; i < 5; i++ {
+		vault = append(vault, &api.Data{Data: []byte(fmt.Sprintf("test%d", i))})
+	}
+	data, err := json.Marshal(vault)
+	require.NoError(t, err)
+	req, err := http.NewAuthRequest("POST", dstore.Path("vault", alice.ID()), bytes.NewReader(data), http.ContentHash(data), clock.Now(), http.Authorization(alice))
+	require.NoError(t, err)
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, body)
+
+	get := func(idx int64) *api.VaultResponse {
+		req, err := http.NewAuthRequest("GET", dstore.Path("vault", alice.ID())+"?limit=2&idx="+strconv.Itoa(int(idx)), nil, "", clock.Now(), http.Authorization(alice))
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		require.Equal(t, http.StatusOK, code)
+		var resp api.VaultResponse
+		err = json.Unmarshal([]byte(body), &resp)
+		require.NoError(t, err)
+		return &resp
+	}
+
+	// GET /vault/:kid?limit=2
+	resp := get(0)
+	require.Equal(t, 2, len(resp.Vault))
+	require.Equal(t, int64(5), resp.Max)
+	require.True(t, resp.Truncated)
+	resp = get(resp.Index)
+	require.Equal(t, 2, len(resp.Vault))
+	require.True(t, resp.Truncated)
+	resp = get(resp.Index)
+	require.Equal(t, 1, len(resp.Vault))
+	require.Equal(t, []byte("test4"), resp.Vault[0].Data)
+	require.Equal(t, int64(5), resp.Index)
+	require.False(t, resp.Truncated)
+
+	// GET /vault/:kid?limit=a
+	req, err = http.NewAuthRequest("GET", dstore.Path("vault", alice.ID())+"?limit=a", nil, "", clock.Now(), http.Authorization(alice))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid limit: strconv.Atoi: parsing \"a\": invalid syntax"}}`, body)
+}
+
+func TestVaultSnapshot(t *testing.T) {
+	clock := tsutil.NewTestClock()
+	env := newEnvWithFire(t, testFire(t, clock), clock)
+	srv := newTestServer(t, env)
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	serve := func(method string, path string, i interface{}) (int, string) {
+		var b []byte
+		contentHash := ""
+		if i != nil {
+			data, err := json.Marshal(i)
+			require.NoError(t, err)
+			b = data
+			contentHash = http.ContentHash(data)
+		}
+		req, err := http.NewAuthRequest(method, path, bytes.NewReader(b), contentHash, clock.Now(), http.Authorization(alice))
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		return code, body
+	}
+	list := func(idx int64) *api.VaultResponse {
+		code, body := serve("GET", dstore.Path("vault", alice.ID())+"?idx="+strconv.Itoa(int(idx)), nil)
+		require.Equal(t, http.StatusOK, code)
+		var resp api.VaultResponse
+		err := json.Unmarshal([]byte(body), &resp)
+		require.NoError(t, err)
+		return &resp
+	}
+
+	// PUT /vault/:kid/snapshot (not found)
+	code, body := serve("PUT", dstore.Path("vault", alice.ID(), "snapshot"), &api.VaultSnapshot{Data: []byte("snapshot"), Index: 1})
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, `{"error":{"code":404,"message":"vault not found"}}`, body)
+
+	// POST /vault/:kid
+	code, _ = serve("POST", dstore.Path("vault", alice.ID()), []*api.Data{
+		&api.Data{Data: []byte("test1"), ID: "id1"},
+		&api.Data{Data: []byte("test2"), ID: "id2"},
+		&api.Data{Data: []byte("test3"), ID: "id3"},
+	})
+	require.Equal(t, http.StatusOK, code)
+	ids, err := env.fi.Documents(context.TODO(), "vaults-id")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(ids))
+
+	// POST /vault/:kid/compact (no snapshot)
+	code, body = serve("POST", dstore.Path("vault", alice.ID(), "compact"), nil)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"no vault snapshot"}}`, body)
+
+	// PUT /vault/:kid/snapshot (invalid index)
+	code, body = serve("PUT", dstore.Path("vault", alice.ID(), "snapshot"), &api.VaultSnapshot{Data: []byte("snapshot"), Index: 4})
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid snapshot index"}}`, body)
+
+	// PUT /vault/:kid/snapshot
+	code, body = serve("PUT", dstore.Path("vault", alice.ID(), "snapshot"), &api.VaultSnapshot{Data: []byte("snapshot2"), Index: 2})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, body)
+
+	// GET /vault/:kid (snapshot and tail)
+	resp := list(0)
+	require.NotNil(t, resp.Snapshot)
+	require.Equal(t, []byte("snapshot2"), resp.Snapshot.Data)
+	require.Equal(t, int64(2), resp.Snapshot.Index)
+	require.Equal(t, 1, len(resp.Vault))
+	require.Equal(t, []byte("test3"), resp.Vault[0].Data)
+	require.Equal(t, int64(3), resp.Index)
+
+	// GET /vault/:kid?idx=1 (not compacted)
+	resp = list(1)
+	require.Nil(t, resp.Snapshot)
+	require.Equal(t, 2, len(resp.Vault))
+
+	// POST /vault/:kid/compact
+	logPath := dstore.Path("vaults", alice.ID(), "log")
+	docs, err := env.fi.Documents(context.TODO(), logPath)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(docs))
+	code, body = serve("POST", dstore.Path("vault", alice.ID(), "compact"), nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, body)
+	docs, err = env.fi.Documents(context.TODO(), logPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(docs))
+	// IDs are kept (for retention)
+	ids, err = env.fi.Documents(context.TODO(), "vaults-id")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(ids))
+
+	// POST /vault/:kid (replayed, after compaction)
+	code, _ = serve("POST", dstore.Path("vault", alice.ID()), []*api.Data{
+		&api.Data{Data: []byte("test1"), ID: "id1"},
+	})
+	require.Equal(t, http.StatusOK, code)
+	docs, err = env.fi.Documents(context.TODO(), logPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(docs))
+
+	// POST /vault/:kid/compact (after retention)
+	clock.Add(time.Hour * 24 * 8)
+	code, _ = serve("POST", dstore.Path("vault", alice.ID(), "compact"), nil)
+	require.Equal(t, http.StatusOK, code)
+	ids, err = env.fi.Documents(context.TODO(), "vaults-id")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ids))
+	require.Equal(t, dstore.Path("vaults-id", alice.ID().String()+"-id3"), ids[0].Path)
+
+	// GET /vault/:kid?idx=1 (compacted)
+	resp = list(1)
+	require.NotNil(t, resp.Snapshot)
+	require.Equal(t, 1, len(resp.Vault))
+	require.Equal(t, []byte("test3"), resp.Vault[0].Data)
+
+	// GET /vault/:kid?idx=2
+	resp = list(2)
+	require.Nil(t, resp.Snapshot)
+	require.Equal(t, 1, len(resp.Vault))
+
+	// PUT /vault/:kid/snapshot (before compaction)
+	code, body = serve("PUT", dstore.Path("vault", alice.ID(), "snapshot"), &api.VaultSnapshot{Data: []byte("snapshot1"), Index: 1})
+	require.Equal(t, http.StatusConflict, code)
+	require.Equal(t, `{"error":{"code":409,"message":"snapshot is before compaction"}}`, body)
+
+	// PUT /vault/:kid/snapshot (too large)
+	tooLarge := bytes.Repeat([]byte{0x01}, 32*1024*1024+1)
+	req, err := http.NewAuthRequest("PUT", dstore.Path("vault", alice.ID(), "snapshot"), bytes.NewReader(tooLarge), http.ContentHash(tooLarge), clock.Now(), http.Authorization(alice))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.Equal(t, `{"error":{"code":413,"message":"snapshot too large (greater than 32MiB)"}}`, body)
+	// Unknown length
+	req, err = http.NewAuthRequest("PUT", dstore.Path("vault", alice.ID(), "snapshot"), bytes.NewReader(tooLarge), http.ContentHash(tooLarge), clock.Now(), http.Authorization(alice))
+	require.NoError(t, err)
+	req.ContentLength = -1
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.Equal(t, `{"error":{"code":413,"message":"snapshot too large (greater than 32MiB)"}}`, body)
+
+	// PUT /vault/:kid/snapshot (large, in chunks)
+	large := bytes.Repeat([]byte{0x01}, 1200*1024)
+	code, _ = serve("PUT", dstore.Path("vault", alice.ID(), "snapshot"), &api.VaultSnapshot{Data: large, Index: 3})
+	require.Equal(t, http.StatusOK, code)
+	resp = list(0)
+	require.NotNil(t, resp.Snapshot)
+	require.Equal(t, large, resp.Snapshot.Data)
+	require.Equal(t, int64(3), resp.Snapshot.Index)
+	chunks, err := env.fi.Documents(context.TODO(), "vaults-snapshot-data")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(chunks))
+
+	// DELETE /vault/:kid
+	code, _ = serve("DELETE", dstore.Path("vault", alice.ID()), nil)
+	require.Equal(t, http.StatusOK, code)
+	chunks, err = env.fi.Documents(context.TODO(), "vaults-snapshot-data")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(chunks))
+	ids, err = env.fi.Documents(context.TODO(), "vaults-id")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(ids))
+}
+
 func TestVaultAuthFirestore(t *testing.T) {
 	if os.Getenv("TEST_FIRESTORE") != "1" {
 		t.Skip()
@@ -0,0 +1,251 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// Vault invites share a vault (collection) key with other users.
+// The invite is the vault key encrypted (signcrypted) to the recipient, so
+// only the vault key is required to post invites, and the recipient verifies
+// the sender.
+
+func (s *Server) postVaultInvites(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	if c.Request().Body == nil {
+		return ErrBadRequest(c, errors.Errorf("missing body"))
+	}
+	b, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+
+	auth, err := s.auth(c, newAuth("Authorization", "kid", b))
+	if err != nil {
+		return ErrForbidden(c, err)
+	}
+
+	deleted, err := s.isVaultDeleted(c, auth.KID)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if deleted {
+		return ErrNotFound(c, errVaultDeleted)
+	}
+
+	var invites []*api.VaultInvite
+	if err := json.Unmarshal(b, &invites); err != nil {
+		return ErrBadRequest(c, errors.Errorf("invalid vault invites"))
+	}
+	if len(invites) > 10 {
+		return ErrBadRequest(c, errors.Errorf("too many invites"))
+	}
+
+	for _, invite := range invites {
+		if invite.Vault != auth.KID {
+			return ErrBadRequest(c, errors.Errorf("invalid vault invite kid"))
+		}
+		if len(invite.EncryptedKey) > 1024 {
+			return ErrBadRequest(c, errors.Errorf("invalid vault invite key"))
+		}
+		if _, err := keys.ParseID(invite.Sender.String()); err != nil {
+			return ErrBadRequest(c, errors.Errorf("invalid vault invite sender"))
+		}
+		rid, err := keys.ParseID(invite.Recipient.String())
+		if err != nil {
+			return ErrBadRequest(c, errors.Errorf("invalid vault invite recipient"))
+		}
+
+		val := dstore.From(invite)
+		if err := s.fi.Set(ctx, dstore.Path("vaults-invites", auth.KID, "invites", rid), val); err != nil {
+			return s.internalError(c, err)
+		}
+		if err := s.fi.Set(ctx, dstore.Path("users", rid, "vault-invites", auth.KID), val); err != nil {
+			return s.internalError(c, err)
+		}
+	}
+
+	var out struct{}
+	return JSON(c, http.StatusOK, out)
+}
+
+func (s *Server) getVaultInvites(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuth("Authorization", "kid", nil))
+	if err != nil {
+		return ErrForbidden(c, err)
+	}
+
+	deleted, err := s.isVaultDeleted(c, auth.KID)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if deleted {
+		return ErrNotFound(c, errVaultDeleted)
+	}
+
+	invites, err := s.vaultInvites(ctx, dstore.Path("vaults-invites", auth.KID, "invites"))
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	out := &api.VaultInvitesResponse{Invites: invites}
+	return JSON(c, http.StatusOK, out)
+}
+
+func (s *Server) deleteVaultInvite(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuth("Authorization", "kid", nil))
+	if err != nil {
+		return ErrForbidden(c, err)
+	}
+
+	rid, err := keys.ParseID(c.Param("rid"))
+	if err != nil {
+		return ErrBadRequest(c, err)
+	}
+
+	ok, err := s.removeVaultInvite(ctx, auth.KID, rid)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if !ok {
+		return ErrNotFound(c, errors.Errorf("invite not found"))
+	}
+	var out struct{}
+	return JSON(c, http.StatusOK, out)
+}
+
+func (s *Server) userVaultInvites(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuth("Authorization", "kid", nil))
+	if err != nil {
+		return ErrForbidden(c, err)
+	}
+
+	invites, err := s.vaultInvites(ctx, dstore.Path("users", auth.KID, "vault-invites"))
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	out := &api.VaultInvitesResponse{Invites: invites}
+	return JSON(c, http.StatusOK, out)
+}
+
+func (s *Server) getUserVaultInvite(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuth("Authorization", "kid", nil))
+	if err != nil {
+		return ErrForbidden(c, err)
+	}
+
+	vid, err := keys.ParseID(c.Param("vid"))
+	if err != nil {
+		return ErrBadRequest(c, err)
+	}
+
+	var invite api.VaultInvite
+	ok, err := s.fi.Load(ctx, dstore.Path("users", auth.KID, "vault-invites", vid), &invite)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if !ok {
+		return ErrNotFound(c, errors.Errorf("invite not found"))
+	}
+
+	out := &api.UserVaultInviteResponse{Invite: &invite}
+	return JSON(c, http.StatusOK, out)
+}
+
+// deleteUserVaultInvite removes an invite for the user, declining (or
+// leaving) a shared vault.
+func (s *Server) deleteUserVaultInvite(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuth("Authorization", "kid", nil))
+	if err != nil {
+		return ErrForbidden(c, err)
+	}
+
+	vid, err := keys.ParseID(c.Param("vid"))
+	if err != nil {
+		return ErrBadRequest(c, err)
+	}
+
+	ok, err := s.removeVaultInvite(ctx, vid, auth.KID)
+	if err != nil {
+		return s.internalError(c, err)
+	}
+	if !ok {
+		return ErrNotFound(c, errors.Errorf("invite not found"))
+	}
+	var out struct{}
+	return JSON(c, http.StatusOK, out)
+}
+
+func (s *Server) vaultInvites(ctx context.Context, path string) ([]*api.VaultInvite, error) {
+	iter, err := s.fi.DocumentIterator(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	invites := []*api.VaultInvite{}
+	for {
+		doc, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			break
+		}
+		var invite api.VaultInvite
+		if err := doc.To(&invite); err != nil {
+			return nil, err
+		}
+		invites = append(invites, &invite)
+	}
+	return invites, nil
+}
+
+func (s *Server) removeVaultInvite(ctx context.Context, vid keys.ID, rid keys.ID) (bool, error) {
+	ok, err := s.fi.Delete(ctx, dstore.Path("vaults-invites", vid, "invites", rid))
+	if err != nil {
+		return false, err
+	}
+	uok, err := s.fi.Delete(ctx, dstore.Path("users", rid, "vault-invites", vid))
+	if err != nil {
+		return false, err
+	}
+	return ok || uok, nil
+}
+
+// removeVaultInvites removes all invites for a vault (when it's deleted).
+func (s *Server) removeVaultInvites(ctx context.Context, vid keys.ID) error {
+	invites, err := s.vaultInvites(ctx, dstore.Path("vaults-invites", vid, "invites"))
+	if err != nil {
+		return err
+	}
+	for _, invite := range invites {
+		if _, err := s.removeVaultInvite(ctx, vid, invite.Recipient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/keys-pub/keys-ext/http/api"
+	kapi "github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultInvites(t *testing.T) {
+	env := newEnv(t)
+	// env.logLevel = server.DebugLevel
+
+	tk := testKeysSeeded()
+	alice, bob, channel := tk.alice, tk.bob, tk.channel
+
+	srv := newTestServer(t, env)
+	clock := env.clock
+
+	// GET /user/:kid/vault-invites (bob)
+	req, err := http.NewAuthRequest("GET", dstore.Path("user", bob.ID(), "vault-invites"), nil, "", clock.Now(), http.Authorization(bob))
+	require.NoError(t, err)
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{"invites":[]}`, body)
+
+	// POST /vault/:kid/invites
+	encryptedKey, err := kapi.EncryptKey(kapi.NewKey(channel), alice, bob.ID())
+	require.NoError(t, err)
+	invites := []*api.VaultInvite{{
+		Vault:        channel.ID(),
+		Recipient:    bob.ID(),
+		Sender:       alice.ID(),
+		EncryptedKey: encryptedKey,
+	}}
+	b, err := json.Marshal(invites)
+	require.NoError(t, err)
+	req, err = http.NewAuthRequest("POST", dstore.Path("vault", channel.ID(), "invites"), bytes.NewReader(b), http.ContentHash(b), clock.Now(), http.Authorization(channel))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, body)
+
+	// POST /vault/:kid/invites (invalid vault)
+	invalid := []*api.VaultInvite{{
+		Vault:        alice.ID(),
+		Recipient:    bob.ID(),
+		Sender:       alice.ID(),
+		EncryptedKey: encryptedKey,
+	}}
+	b, err = json.Marshal(invalid)
+	require.NoError(t, err)
+	req, err = http.NewAuthRequest("POST", dstore.Path("vault", channel.ID(), "invites"), bytes.NewReader(b), http.ContentHash(b), clock.Now(), http.Authorization(channel))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid vault invite kid"}}`, body)
+
+	// GET /vault/:kid/invites
+	req, err = http.NewAuthRequest("GET", dstore.Path("vault", channel.ID(), "invites"), nil, "", clock.Now(), http.Authorization(channel))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	var resp api.VaultInvitesResponse
+	err = json.Unmarshal([]byte(body), &resp)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Invites))
+	require.Equal(t, bob.ID(), resp.Invites[0].Recipient)
+
+	// GET /user/:kid/vault-invites (bob)
+	req, err = http.NewAuthRequest("GET", dstore.Path("user", bob.ID(), "vault-invites"), nil, "", clock.Now(), http.Authorization(bob))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	resp = api.VaultInvitesResponse{}
+	err = json.Unmarshal([]byte(body), &resp)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Invites))
+	key, sender, err := resp.Invites[0].Key(bob)
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), sender)
+	require.Equal(t, channel.ID(), key.ID)
+
+	// GET /user/:kid/vault-invite/:vid (bob)
+	req, err = http.NewAuthRequest("GET", dstore.Path("user", bob.ID(), "vault-invite", channel.ID()), nil, "", clock.Now(), http.Authorization(bob))
+	require.NoError(t, err)
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+
+	// DELETE /vault/:kid/invite/:rid
+	req, err = http.NewAuthRequest("DELETE", dstore.Path("vault", channel.ID(), "invite", bob.ID()), nil, "", clock.Now(), http.Authorization(channel))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, body)
+
+	// GET /user/:kid/vault-invite/:vid (bob, not found)
+	req, err = http.NewAuthRequest("GET", dstore.Path("user", bob.ID(), "vault-invite", channel.ID()), nil, "", clock.Now(), http.Authorization(bob))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, `{"error":{"code":404,"message":"invite not found"}}`, body)
+
+	// DELETE /user/:kid/vault-invite/:vid (bob, not found)
+	req, err = http.NewAuthRequest("DELETE", dstore.Path("user", bob.ID(), "vault-invite", channel.ID()), nil, "", clock.Now(), http.Authorization(bob))
+	require.NoError(t, err)
+	code, _, _ = srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+}
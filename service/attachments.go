@@ -0,0 +1,99 @@
+package service
+
+import (
+	"io"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/attachments"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// AttachmentPut (RPC) saves an attachment from a stream.
+// The first request specifies the id (optional) and name, followed by data.
+func (s *service) AttachmentPut(srv Keys_AttachmentPutServer) error {
+	req, err := srv.Recv()
+	if err == io.EOF {
+		return errors.Errorf("no attachment request")
+	}
+	if err != nil {
+		return err
+	}
+	if req.Name == "" {
+		return errors.Errorf("no name specified")
+	}
+	r := &attachmentPutReader{srv: srv, buf: req.Data}
+	att, err := attachments.Put(s.vault, req.ID, req.Name, r)
+	if err != nil {
+		return err
+	}
+	return srv.SendAndClose(&AttachmentPutResponse{
+		Attachment: attachmentToRPC(att),
+	})
+}
+
+// AttachmentGet (RPC) streams an attachment.
+// The first response has the attachment (with no data), followed by data.
+func (s *service) AttachmentGet(req *AttachmentGetRequest, srv Keys_AttachmentGetServer) error {
+	if req.ID == "" {
+		return errors.Errorf("id not specified")
+	}
+	att, err := attachments.Get(s.vault, req.ID)
+	if err != nil {
+		return err
+	}
+	if att == nil {
+		return keys.NewErrNotFound(req.ID)
+	}
+	if err := srv.Send(&AttachmentGetResponse{Attachment: attachmentToRPC(att)}); err != nil {
+		return err
+	}
+	if _, err := attachments.Read(s.vault, req.ID, &attachmentGetWriter{srv: srv}); err != nil {
+		return err
+	}
+	return nil
+}
+
+type attachmentPutReader struct {
+	srv Keys_AttachmentPutServer
+	buf []byte
+}
+
+func (r *attachmentPutReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.srv.Recv()
+		if err != nil {
+			return 0, err
+		}
+		// Make sure request only sends data after init
+		if req.ID != "" || req.Name != "" {
+			return 0, errors.Errorf("after stream is initalized, only data should be sent")
+		}
+		r.buf = req.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+type attachmentGetWriter struct {
+	srv Keys_AttachmentGetServer
+}
+
+func (w *attachmentGetWriter) Write(p []byte) (int, error) {
+	if err := w.srv.Send(&AttachmentGetResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func attachmentToRPC(att *attachments.Attachment) *Attachment {
+	return &Attachment{
+		ID:        att.ID,
+		Name:      att.Name,
+		Size:      att.Size,
+		Digest:    att.Digest,
+		CreatedAt: tsutil.Millis(att.CreatedAt),
+		UpdatedAt: tsutil.Millis(att.UpdatedAt),
+	}
+}
@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys-ext/auth/fido2"
 	"github.com/keys-pub/keys-ext/vault"
 	"github.com/keys-pub/keys/dstore"
 	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -22,10 +25,14 @@ import (
 type auth struct {
 	sync.Mutex
 	env       *Env
-	tokens    map[string]string
+	tokens    map[string]*authToken
 	allowlist *dstore.StringSet
+	clock     tsutil.Clock
 
 	fas fido2.FIDO2Server
+
+	// onAuthorized is called for authorized requests (for idle auto-lock).
+	onAuthorized func()
 }
 
 func newAuth(env *Env) *auth {
@@ -37,6 +44,7 @@ func newAuth(env *Env) *auth {
 		"/keys.Keys/AuthVault",
 		"/keys.Keys/AuthReset",
 		"/keys.Keys/AuthRecover",
+		"/keys.Keys/VaultRestore",
 		"/keys.Keys/Rand",
 		"/keys.Keys/RandPassword",
 		"/keys.Keys/RuntimeStatus",
@@ -44,8 +52,9 @@ func newAuth(env *Env) *auth {
 
 	return &auth{
 		env:       env,
-		tokens:    map[string]string{},
+		tokens:    map[string]*authToken{},
 		allowlist: allowlist,
+		clock:     tsutil.NewClock(),
 	}
 }
 
@@ -60,6 +69,11 @@ func (a *auth) setup(ctx context.Context, vlt *vault.Vault, req *AuthSetupReques
 	case PaperKeyAuth:
 		// TODO: Implement
 		return errors.Errorf("setup with paper key not supported")
+	case KeyFileAuth:
+		if err := setupKeyFile(vlt, req.Secret, req.Path); err != nil {
+			return authErr(err, req.Type, "failed to setup")
+		}
+		return nil
 	case FIDO2HMACSecretAuth:
 		_, err := generateHMACSecret(ctx, a.fas, vlt, req.Secret, req.Device, a.env.AppName())
 		if err != nil {
@@ -73,6 +87,9 @@ func (a *auth) setup(ctx context.Context, vlt *vault.Vault, req *AuthSetupReques
 
 func (a *auth) unlock(ctx context.Context, vlt *vault.Vault, req *AuthUnlockRequest) (string, error) {
 	logger.Infof("Unlock (%s)", req.Type)
+	if err := checkScopes(req.Scopes); err != nil {
+		return "", err
+	}
 
 	switch req.Type {
 	case PasswordAuth:
@@ -87,17 +104,30 @@ func (a *auth) unlock(ctx context.Context, vlt *vault.Vault, req *AuthUnlockRequ
 		if err := unlockHMACSecret(ctx, a.fas, vlt, req.Secret); err != nil {
 			return "", authErr(err, req.Type, "failed to unlock")
 		}
+	case KeyFileAuth:
+		if _, err := unlockKeyFile(vlt, req.Secret); err != nil {
+			return "", authErr(err, req.Type, "failed to unlock")
+		}
 	default:
 		return "", errors.Errorf("unsupported auth type")
 	}
 
 	logger.Infof("Unlocked (%s)", req.Type)
-	token := a.registerToken(req.Client)
+	token := a.registerToken(req.Client, req.Scopes)
+	if err := vlt.AuthLogAdd(&vault.AuthLogEntry{
+		Type:     vault.AuthLogToken,
+		AuthType: authTypeFromRPC(req.Type),
+		Client:   req.Client,
+	}); err != nil {
+		logger.Warningf("Failed to add to auth log: %v", err)
+	}
 	return token, nil
 }
 
 func (a *auth) lock(vlt *vault.Vault) {
-	a.tokens = map[string]string{}
+	a.Lock()
+	a.tokens = map[string]*authToken{}
+	a.Unlock()
 	vlt.Lock()
 }
 
@@ -108,6 +138,8 @@ func (a *auth) provision(ctx context.Context, vlt *vault.Vault, req *AuthProvisi
 		return provisionPassword(vlt, req.Secret)
 	case PaperKeyAuth:
 		return provisionPaperKey(vlt, req.Secret)
+	case KeyFileAuth:
+		return provisionKeyFile(vlt, req.Secret, req.Path)
 	case FIDO2HMACSecretAuth:
 		if req.Generate {
 			logger.Infof("Generate FIDO2 HMAC-Secret with device ", req.Device)
@@ -120,14 +152,60 @@ func (a *auth) provision(ctx context.Context, vlt *vault.Vault, req *AuthProvisi
 	}
 }
 
-func (a *auth) registerToken(client string) string {
+// authToken is a token issued (on unlock) for a client.
+type authToken struct {
+	token  string
+	client string
+	// scopes, or empty for full access.
+	scopes    []string
+	createdAt time.Time
+}
+
+func (t *authToken) allowed(scope string) bool {
+	return len(t.scopes) == 0 || containsString(t.scopes, scope)
+}
+
+func (a *auth) registerToken(client string, scopes []string) string {
 	token := generateToken()
-	logger.Debugf("Auth register client: %s", client)
-	a.tokens[client] = token
+	logger.Debugf("Auth register client: %s %v", client, scopes)
+	a.Lock()
+	defer a.Unlock()
+	a.tokens[client] = &authToken{
+		token:     token,
+		client:    client,
+		scopes:    scopes,
+		createdAt: a.clock.Now(),
+	}
 	return token
 }
 
+// authTokens returns issued tokens, sorted by client.
+func (a *auth) authTokens() []*authToken {
+	a.Lock()
+	defer a.Unlock()
+	out := make([]*authToken, 0, len(a.tokens))
+	for _, t := range a.tokens {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].client < out[j].client })
+	return out
+}
+
+// revokeToken removes the token for client.
+func (a *auth) revokeToken(client string) bool {
+	a.Lock()
+	defer a.Unlock()
+	if _, ok := a.tokens[client]; !ok {
+		return false
+	}
+	delete(a.tokens, client)
+	return true
+}
+
 func authErr(err error, typ AuthType, wrap string) error {
+	if terr, ok := errors.Cause(err).(vault.UnlockThrottledError); ok {
+		return status.Error(codes.ResourceExhausted, terr.Error())
+	}
 	if errors.Cause(err) == vault.ErrInvalidAuth {
 		switch typ {
 		case PasswordAuth:
@@ -158,14 +236,29 @@ func (a *auth) unaryInterceptor(ctx context.Context, req interface{}, info *grpc
 	return handler(ctx, req)
 }
 
-func (a *auth) checkToken(token string) error {
+func (a *auth) checkToken(token string) (*authToken, error) {
+	a.Lock()
+	defer a.Unlock()
 	for _, t := range a.tokens {
-		if t == token {
-			return nil
+		if t.token == token {
+			return t, nil
 		}
 	}
 	logger.Infof("Invalid auth token")
-	return status.Error(codes.Unauthenticated, "invalid token")
+	return nil, status.Error(codes.Unauthenticated, "invalid token")
+}
+
+// checkScope checks the token is allowed to call method.
+func (a *auth) checkScope(t *authToken, method string) error {
+	scope := methodScope(method)
+	if !t.allowed(scope) {
+		logger.Infof("Token (%s) doesn't have scope %s for %s", t.client, scope, method)
+		return status.Errorf(codes.PermissionDenied, "token doesn't have scope %s", scope)
+	}
+	if freshUnlockMethods[methodName(method)] && a.clock.Now().Sub(t.createdAt) > freshUnlockDuration {
+		return status.Error(codes.Unauthenticated, "unlock required (token isn't recent)")
+	}
+	return nil
 }
 
 func (a *auth) authorize(ctx context.Context, method string) error {
@@ -183,7 +276,17 @@ func (a *auth) authorize(ctx context.Context, method string) error {
 			return status.Error(codes.Unauthenticated, "authorization missing")
 		}
 		token := md["authorization"][0]
-		return a.checkToken(token)
+		t, err := a.checkToken(token)
+		if err != nil {
+			return err
+		}
+		if err := a.checkScope(t, method); err != nil {
+			return err
+		}
+		if a.onAuthorized != nil {
+			a.onAuthorized()
+		}
+		return nil
 	}
 	return status.Error(codes.Unauthenticated, "no authorization in context")
 }
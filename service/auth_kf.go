@@ -0,0 +1,152 @@
+package service
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/pkg/errors"
+)
+
+// Key file auth is for headless environments (CI runners, servers) that can't
+// enter a password or use a FIDO2 device.
+//
+// The key file has a 32 byte key, either raw or hex encoded. The key file must
+// not be readable (or writable) by group or others. The client (CLI) reads the
+// key file and sends the key (hex encoded) as the auth secret.
+
+func setupKeyFile(vlt *vault.Vault, secret string, path string) error {
+	key, err := keyFileSecretToKey(secret)
+	if err != nil {
+		return err
+	}
+	provision := vault.NewProvision(vault.KeyFileAuth)
+	provision.Path = path
+	if err := vlt.Setup(key, provision); err != nil {
+		return err
+	}
+	return nil
+}
+
+func unlockKeyFile(vlt *vault.Vault, secret string) (*vault.Provision, error) {
+	key, err := keyFileSecretToKey(secret)
+	if err != nil {
+		return nil, err
+	}
+	return vlt.Unlock(key)
+}
+
+func provisionKeyFile(vlt *vault.Vault, secret string, path string) (*vault.Provision, error) {
+	key, err := keyFileSecretToKey(secret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to provision key file")
+	}
+	provision := vault.NewProvision(vault.KeyFileAuth)
+	provision.Path = path
+	if err := vlt.Provision(key, provision); err != nil {
+		return nil, err
+	}
+	logger.Infof("Provision (key file): %s", provision.ID)
+	return provision, nil
+}
+
+func keyFileSecretToKey(secret string) (*[32]byte, error) {
+	b, err := hex.DecodeString(secret)
+	if err != nil || len(b) != 32 {
+		return nil, errors.Errorf("invalid key file secret")
+	}
+	return keys.Bytes32(b), nil
+}
+
+func keyFileSecret(key *[32]byte) string {
+	return hex.EncodeToString(key[:])
+}
+
+// readKeyFile reads a key from a file path.
+func readKeyFile(path string) (*[32]byte, error) {
+	f, err := os.Open(path) // #nosec
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readKeyFileFrom(f)
+}
+
+// readKeyFileFD reads a key from a file descriptor.
+func readKeyFileFD(fd uintptr) (*[32]byte, error) {
+	f := os.NewFile(fd, "key-fd")
+	if f == nil {
+		return nil, errors.Errorf("invalid file descriptor %d", fd)
+	}
+	defer f.Close()
+	return readKeyFileFrom(f)
+}
+
+func readKeyFileFrom(f *os.File) (*[32]byte, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkKeyFileMode(fi); err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return parseKeyFile(b)
+}
+
+// checkKeyFileMode checks the key file isn't accessible by group or others.
+// Only regular files are checked (a file descriptor might be a pipe).
+func checkKeyFileMode(fi os.FileInfo) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	if !fi.Mode().IsRegular() {
+		return nil
+	}
+	if perm := fi.Mode().Perm(); perm&0077 != 0 {
+		return errors.Errorf("key file permissions are too open (%#o), should be 0600", perm)
+	}
+	return nil
+}
+
+func parseKeyFile(b []byte) (*[32]byte, error) {
+	if len(b) == 32 {
+		return keys.Bytes32(b), nil
+	}
+	s := bytes.TrimSpace(b)
+	if len(s) == 64 {
+		key, err := hex.DecodeString(string(s))
+		if err == nil {
+			return keys.Bytes32(key), nil
+		}
+	}
+	return nil, errors.Errorf("invalid key file, should be 32 bytes (or hex encoded)")
+}
+
+// generateKeyFile creates a new key file (which must not already exist).
+func generateKeyFile(path string) (*[32]byte, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600) // #nosec
+	if err != nil {
+		return nil, err
+	}
+	key := keys.Rand32()
+	if _, err := f.Write([]byte(hex.EncodeToString(key[:]) + "\n")); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "KeysTest-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "key")
+
+	key, err := generateKeyFile(path)
+	require.NoError(t, err)
+	_, err = generateKeyFile(path)
+	require.Error(t, err)
+
+	out, err := readKeyFile(path)
+	require.NoError(t, err)
+	require.Equal(t, key, out)
+
+	// Raw
+	raw := filepath.Join(dir, "raw")
+	err = ioutil.WriteFile(raw, key[:], 0600)
+	require.NoError(t, err)
+	out, err = readKeyFile(raw)
+	require.NoError(t, err)
+	require.Equal(t, key, out)
+
+	// Invalid
+	err = ioutil.WriteFile(raw, []byte("invalid"), 0600)
+	require.NoError(t, err)
+	_, err = readKeyFile(raw)
+	require.EqualError(t, err, "invalid key file, should be 32 bytes (or hex encoded)")
+
+	if runtime.GOOS != "windows" {
+		err = os.Chmod(path, 0644)
+		require.NoError(t, err)
+		_, err = readKeyFile(path)
+		require.EqualError(t, err, "key file permissions are too open (0644), should be 0600")
+	}
+}
+
+func TestAuthKeyFile(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+
+	key := keys.Rand32()
+	_, err := service.AuthSetup(ctx, &AuthSetupRequest{
+		Secret: keyFileSecret(key),
+		Type:   KeyFileAuth,
+		Path:   "/etc/keys/key",
+	})
+	require.NoError(t, err)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: keyFileSecret(key),
+		Type:   KeyFileAuth,
+		Client: "test",
+	})
+	require.NoError(t, err)
+
+	key2 := keys.Rand32()
+	_, err = service.AuthProvision(ctx, &AuthProvisionRequest{
+		Secret: keyFileSecret(key2),
+		Type:   KeyFileAuth,
+		Path:   "/etc/keys/key2",
+	})
+	require.NoError(t, err)
+
+	provisions, err := service.AuthProvisions(ctx, &AuthProvisionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(provisions.Provisions))
+	require.Equal(t, KeyFileAuth, provisions.Provisions[0].Type)
+	require.Equal(t, "/etc/keys/key", provisions.Provisions[0].Path)
+	require.Equal(t, "/etc/keys/key2", provisions.Provisions[1].Path)
+
+	testAuthLock(t, service)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: keyFileSecret(key2),
+		Type:   KeyFileAuth,
+		Client: "test",
+	})
+	require.NoError(t, err)
+	testAuthLock(t, service)
+
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: keyFileSecret(keys.Rand32()),
+		Type:   KeyFileAuth,
+		Client: "test",
+	})
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = invalid auth")
+
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: "invalid",
+		Type:   KeyFileAuth,
+		Client: "test",
+	})
+	require.EqualError(t, err, "failed to unlock: invalid key file secret")
+}
@@ -1,6 +1,13 @@
 package service
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys-ext/vault"
 	"github.com/keys-pub/keys/encoding"
 	"github.com/pkg/errors"
@@ -26,3 +33,46 @@ func unlockPaperKey(vlt *vault.Vault, paperKey string) (*vault.Provision, error)
 	}
 	return vlt.Unlock(key)
 }
+
+// generatePaperKey returns a new (BIP39) paper key phrase.
+func generatePaperKey() (string, error) {
+	return encoding.BytesToPhrase(keys.RandBytes(32))
+}
+
+// paperKeyChecksum returns a short checksum for a paper key, so a recovery kit
+// can be checked against what was written down.
+func paperKeyChecksum(paperKey string) (string, error) {
+	key, err := encoding.PhraseToBytes(paperKey, true)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(append([]byte("keys.pub/paper-key"), key[:]...))
+	return hex.EncodeToString(h[:4]), nil
+}
+
+// paperKeyRecoveryKit returns the recovery kit (text) for a paper key.
+func paperKeyRecoveryKit(paperKey string, provision *AuthProvision, ts time.Time) (string, error) {
+	checksum, err := paperKeyChecksum(paperKey)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Keys Recovery Kit\n\n")
+	if provision != nil {
+		fmt.Fprintf(&sb, "Provision: %s\n", provision.ID)
+	}
+	fmt.Fprintf(&sb, "Created: %s\n\n", ts.Format(time.RFC3339))
+	fmt.Fprintf(&sb, "Paper key:\n")
+	for i, word := range strings.Fields(paperKey) {
+		fmt.Fprintf(&sb, "%3d. %-10s", i+1, word)
+		if (i+1)%4 == 0 {
+			fmt.Fprintf(&sb, "\n")
+		}
+	}
+	fmt.Fprintf(&sb, "\nChecksum: %s\n\n", checksum)
+	fmt.Fprintf(&sb, "To unlock or recover (with a new password):\n")
+	fmt.Fprintf(&sb, "  keys auth -type paper-key\n")
+	fmt.Fprintf(&sb, "  keys auth recover\n\n")
+	fmt.Fprintf(&sb, "Anyone with this paper key can unlock your vault, keep it somewhere safe.\n")
+	return sb.String(), nil
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthPaperKey(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	paperKey, err := generatePaperKey()
+	require.NoError(t, err)
+	require.Equal(t, 24, len(strings.Fields(paperKey)))
+
+	resp, err := service.AuthProvision(ctx, &AuthProvisionRequest{
+		Secret: paperKey,
+		Type:   PaperKeyAuth,
+	})
+	require.NoError(t, err)
+	require.Equal(t, PaperKeyAuth, resp.Provision.Type)
+
+	testAuthLock(t, service)
+
+	// Unlock (with extra whitespace and case)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: "  " + strings.ToUpper(paperKey) + "\n",
+		Type:   PaperKeyAuth,
+		Client: "test",
+	})
+	require.NoError(t, err)
+	testAuthLock(t, service)
+
+	other, err := generatePaperKey()
+	require.NoError(t, err)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: other,
+		Type:   PaperKeyAuth,
+		Client: "test",
+	})
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = invalid auth")
+
+	// Recover
+	recover, err := service.AuthRecover(ctx, &AuthRecoverRequest{
+		PaperKey:    paperKey,
+		NewPassword: "newpassword123",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, recover.AuthToken)
+	testAuthLock(t, service)
+	_, err = service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: "newpassword123",
+		Type:   PasswordAuth,
+		Client: "test",
+	})
+	require.NoError(t, err)
+}
+
+func TestPaperKeyRecoveryKit(t *testing.T) {
+	paperKey := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"
+	_, err := paperKeyChecksum(paperKey)
+	require.Error(t, err)
+
+	paperKey, err = generatePaperKey()
+	require.NoError(t, err)
+	checksum, err := paperKeyChecksum(paperKey)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(checksum))
+	checksum2, err := paperKeyChecksum(strings.ToUpper(paperKey))
+	require.NoError(t, err)
+	require.Equal(t, checksum, checksum2)
+
+	ts := time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC)
+	kit, err := paperKeyRecoveryKit(paperKey, &AuthProvision{ID: "testid"}, ts)
+	require.NoError(t, err)
+	require.Contains(t, kit, "Provision: testid\n")
+	require.Contains(t, kit, "Created: 2020-11-01T12:00:00Z\n")
+	require.Contains(t, kit, "Checksum: "+checksum+"\n")
+	words := strings.Fields(paperKey)
+	require.Contains(t, kit, " 24. "+words[23])
+}
+
+func TestConfirmPaperKey(t *testing.T) {
+	paperKey, err := generatePaperKey()
+	require.NoError(t, err)
+	words := strings.Fields(paperKey)
+
+	var out bytes.Buffer
+	in := strings.NewReader(words[1] + "\n" + strings.ToUpper(words[20]) + "\n")
+	err = confirmPaperKey(in, &out, paperKey, []int{1, 20})
+	require.NoError(t, err)
+	require.Equal(t, "Enter word #2: Enter word #21: ", out.String())
+
+	in = strings.NewReader("notaword\n")
+	err = confirmPaperKey(in, &out, paperKey, []int{5})
+	require.EqualError(t, err, "word #6 doesn't match")
+
+	positions := randPositions(24, 3)
+	require.Equal(t, 3, len(positions))
+	for _, pos := range positions {
+		require.True(t, pos >= 0 && pos < 24)
+	}
+}
@@ -132,6 +132,52 @@ func (s *service) AuthDeprovision(ctx context.Context, req *AuthDeprovisionReque
 	return &AuthDeprovisionResponse{}, nil
 }
 
+// AuthRotate (RPC) rotates the vault master key.
+func (s *service) AuthRotate(ctx context.Context, req *AuthRotateRequest) (*AuthRotateResponse, error) {
+	salt, err := s.vault.Salt()
+	if err != nil {
+		return nil, err
+	}
+	authKeys := make([]*[32]byte, 0, len(req.Secrets))
+	for _, secret := range req.Secrets {
+		switch secret.Type {
+		case PasswordAuth:
+			key, err := keys.KeyForPassword(secret.Secret, salt)
+			if err != nil {
+				return nil, err
+			}
+			authKeys = append(authKeys, key)
+		case PaperKeyAuth:
+			key, err := encoding.PhraseToBytes(secret.Secret, true)
+			if err != nil {
+				return nil, err
+			}
+			authKeys = append(authKeys, key)
+		case KeyFileAuth:
+			key, err := keyFileSecretToKey(secret.Secret)
+			if err != nil {
+				return nil, err
+			}
+			authKeys = append(authKeys, key)
+		default:
+			return nil, errors.Errorf("unsupported auth type for rotate")
+		}
+	}
+	// The sdb key is derived from the master key, so the sdb is re-keyed (by
+	// the vault rotate hook, see rotateDB), which requires unlockMtx.
+	s.unlockMtx.Lock()
+	err = s.vault.RotateMasterKey(ctx, authKeys, req.Force)
+	s.unlockMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	kid := ""
+	if remote := s.vault.Remote(); remote != nil {
+		kid = remote.Key.ID().String()
+	}
+	return &AuthRotateResponse{KID: kid}, nil
+}
+
 // AuthProvisions (RPC) ...
 func (s *service) AuthProvisions(ctx context.Context, req *AuthProvisionsRequest) (*AuthProvisionsResponse, error) {
 	provisions, err := s.vault.Provisions()
@@ -177,6 +223,7 @@ func provisionToRPC(p *vault.Provision) *AuthProvision {
 		Type:      authTypeToRPC(p.Type),
 		AAGUID:    p.AAGUID,
 		NoPin:     p.NoPin,
+		Path:      p.Path,
 		CreatedAt: tsutil.Millis(p.CreatedAt),
 	}
 }
@@ -189,11 +236,70 @@ func authTypeToRPC(t vault.AuthType) AuthType {
 		return PaperKeyAuth
 	case vault.FIDO2HMACSecretAuth:
 		return FIDO2HMACSecretAuth
+	case vault.KeyFileAuth:
+		return KeyFileAuth
 	default:
 		return UnknownAuth
 	}
 }
 
+func authTypeFromRPC(t AuthType) vault.AuthType {
+	switch t {
+	case PasswordAuth:
+		return vault.PasswordAuth
+	case PaperKeyAuth:
+		return vault.PaperKeyAuth
+	case FIDO2HMACSecretAuth:
+		return vault.FIDO2HMACSecretAuth
+	case KeyFileAuth:
+		return vault.KeyFileAuth
+	default:
+		return vault.UnknownAuth
+	}
+}
+
+// AuthLog (RPC) returns the auth (audit) log.
+func (s *service) AuthLog(ctx context.Context, req *AuthLogRequest) (*AuthLogResponse, error) {
+	entries, err := s.vault.AuthLog(int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*AuthLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		out = append(out, &AuthLogEntry{
+			Index:     entry.Index,
+			Type:      string(entry.Type),
+			Provision: entry.Provision,
+			AuthType:  authTypeToRPC(entry.AuthType),
+			Client:    entry.Client,
+			Timestamp: tsutil.Millis(entry.Timestamp),
+		})
+	}
+	return &AuthLogResponse{Entries: out}, nil
+}
+
+// AuthTokens (RPC) lists issued tokens.
+func (s *service) AuthTokens(ctx context.Context, req *AuthTokensRequest) (*AuthTokensResponse, error) {
+	tokens := s.auth.authTokens()
+	out := make([]*AuthToken, 0, len(tokens))
+	for _, t := range tokens {
+		out = append(out, &AuthToken{
+			Client:    t.client,
+			Scopes:    t.scopes,
+			CreatedAt: tsutil.Millis(t.createdAt),
+		})
+	}
+	return &AuthTokensResponse{Tokens: out}, nil
+}
+
+// AuthTokenRevoke (RPC) revokes the token for a client.
+func (s *service) AuthTokenRevoke(ctx context.Context, req *AuthTokenRevokeRequest) (*AuthTokenRevokeResponse, error) {
+	if !s.auth.revokeToken(req.Client) {
+		return nil, keys.NewErrNotFound(req.Client)
+	}
+	return &AuthTokenRevokeResponse{}, nil
+}
+
 func matchAAGUID(provisions []*vault.Provision, aaguid string) *vault.Provision {
 	for _, provision := range provisions {
 		if provision.AAGUID == aaguid {
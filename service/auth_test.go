@@ -2,10 +2,16 @@ package service
 
 import (
 	"context"
+	"net/http"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/sdb"
 	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 )
@@ -241,18 +247,18 @@ func TestUnlockMultipleClients(t *testing.T) {
 	require.NoError(t, err)
 
 	// Check tokens
-	err = service.auth.checkToken(app.AuthToken)
+	_, err = service.auth.checkToken(app.AuthToken)
 	require.NoError(t, err)
-	err = service.auth.checkToken(cli.AuthToken)
+	_, err = service.auth.checkToken(cli.AuthToken)
 	require.NoError(t, err)
 
 	// Lock
 	_, err = service.AuthLock(ctx, &AuthLockRequest{})
 	require.NoError(t, err)
 
-	err = service.auth.checkToken(app.AuthToken)
+	_, err = service.auth.checkToken(app.AuthToken)
 	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = invalid token")
-	err = service.auth.checkToken(cli.AuthToken)
+	_, err = service.auth.checkToken(cli.AuthToken)
 	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = invalid token")
 
 	require.False(t, service.db.IsOpen())
@@ -302,6 +308,132 @@ func TestAuthReset(t *testing.T) {
 	require.Equal(t, 1, len(keysResp.Keys))
 }
 
+func TestAuthRotate(t *testing.T) {
+	var err error
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	lock := &Config_Lock{IdleTimeout: int64(time.Minute / time.Millisecond)}
+	_, err = service.ConfigSet(ctx, &ConfigSetRequest{Name: "lock", Config: &Config{Lock: lock}})
+	require.NoError(t, err)
+
+	_, err = service.AuthRotate(ctx, &AuthRotateRequest{
+		Secrets: []*AuthRotateSecret{{Type: PasswordAuth, Secret: authPassword}},
+	})
+	require.NoError(t, err)
+
+	configResp, err := service.ConfigGet(ctx, &ConfigGetRequest{Name: "lock"})
+	require.NoError(t, err)
+	require.Equal(t, lock.IdleTimeout, configResp.Config.Lock.IdleTimeout)
+
+	_, err = service.AuthLock(ctx, &AuthLockRequest{})
+	require.NoError(t, err)
+	testAuthUnlock(t, service)
+
+	configResp, err = service.ConfigGet(ctx, &ConfigGetRequest{Name: "lock"})
+	require.NoError(t, err)
+	require.Equal(t, lock.IdleTimeout, configResp.Config.Lock.IdleTimeout)
+	err = service.loadAutoLock(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, service.autoLock.idleTimeout)
+}
+
+// testFailDeleteTransport fails DELETE requests.
+type testFailDeleteTransport struct{}
+
+func (t testFailDeleteTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == "DELETE" {
+		return nil, errors.Errorf("delete failed (test)")
+	}
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestAuthRotateRemoteFail(t *testing.T) {
+	var err error
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	lock := &Config_Lock{IdleTimeout: int64(time.Minute / time.Millisecond)}
+	_, err = service.ConfigSet(ctx, &ConfigSetRequest{Name: "lock", Config: &Config{Lock: lock}})
+	require.NoError(t, err)
+	_, err = service.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+
+	// Fail deleting the vault from the (old) remote
+	service.client.SetHTTPClient(&http.Client{Transport: testFailDeleteTransport{}})
+	_, err = service.AuthRotate(ctx, &AuthRotateRequest{
+		Secrets: []*AuthRotateSecret{{Type: PasswordAuth, Secret: authPassword}},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "delete failed (test)")
+
+	configResp, err := service.ConfigGet(ctx, &ConfigGetRequest{Name: "lock"})
+	require.NoError(t, err)
+	require.Equal(t, lock.IdleTimeout, configResp.Config.Lock.IdleTimeout)
+
+	// Unlock (resumes rotation)
+	testAuthLock(t, service)
+	service.client.SetHTTPClient(&http.Client{})
+	testAuthUnlock(t, service)
+
+	configResp, err = service.ConfigGet(ctx, &ConfigGetRequest{Name: "lock"})
+	require.NoError(t, err)
+	require.Equal(t, lock.IdleTimeout, configResp.Config.Lock.IdleTimeout)
+
+	_, err = service.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+	testAuthLock(t, service)
+	testAuthUnlock(t, service)
+	configResp, err = service.ConfigGet(ctx, &ConfigGetRequest{Name: "lock"})
+	require.NoError(t, err)
+	require.Equal(t, lock.IdleTimeout, configResp.Config.Lock.IdleTimeout)
+}
+
+func TestOpenDB(t *testing.T) {
+	var err error
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	lock := &Config_Lock{IdleTimeout: int64(time.Minute / time.Millisecond)}
+	_, err = service.ConfigSet(ctx, &ConfigSetRequest{Name: "lock", Config: &Config{Lock: lock}})
+	require.NoError(t, err)
+	path, err := service.env.AppPath(cdbPath, false)
+	require.NoError(t, err)
+
+	// Interrupted re-key (after re-keying, before replacing)
+	testAuthLock(t, service)
+	err = os.Rename(path, path+".rekey")
+	require.NoError(t, err)
+	testAuthUnlock(t, service)
+	configResp, err := service.ConfigGet(ctx, &ConfigGetRequest{Name: "lock"})
+	require.NoError(t, err)
+	require.Equal(t, lock.IdleTimeout, configResp.Config.Lock.IdleTimeout)
+
+	// Wrong key
+	testAuthLock(t, service)
+	err = os.RemoveAll(path)
+	require.NoError(t, err)
+	db := sdb.New()
+	err = db.OpenAtPath(ctx, path, keys.Rand32())
+	require.NoError(t, err)
+	err = db.Set(ctx, dstore.Path("config", "lock"), dstore.From(&Config{Lock: lock}))
+	require.NoError(t, err)
+	db.Close()
+	testAuthUnlock(t, service)
+	configResp, err = service.ConfigGet(ctx, &ConfigGetRequest{Name: "lock"})
+	require.NoError(t, err)
+	require.Nil(t, configResp.Config)
+}
+
 func TestAuthSetupLocked(t *testing.T) {
 	var err error
 	env := newTestEnv(t)
@@ -315,3 +447,119 @@ func TestAuthSetupLocked(t *testing.T) {
 	_, err = service.KeyGenerate(ctx, &KeyGenerateRequest{Type: string(keys.EdX25519)})
 	require.EqualError(t, err, "vault is locked")
 }
+
+func TestAuthLog(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+
+	testAuthSetup(t, service)
+	testAuthLock(t, service)
+	_, err := service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: "invalidpassword",
+		Type:   PasswordAuth,
+		Client: "test",
+	})
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = invalid password")
+	testAuthUnlock(t, service)
+
+	resp, err := service.AuthLog(ctx, &AuthLogRequest{})
+	require.NoError(t, err)
+	types := []string{}
+	for _, entry := range resp.Entries {
+		types = append(types, entry.Type)
+	}
+	require.Equal(t, []string{"token", "unlock", "unlock-failed", "lock", "token", "unlock", "setup"}, types)
+	require.Equal(t, "test", resp.Entries[0].Client)
+	require.Equal(t, PasswordAuth, resp.Entries[0].AuthType)
+	require.Equal(t, PasswordAuth, resp.Entries[1].AuthType)
+
+	resp, err = service.AuthLog(ctx, &AuthLogRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Entries))
+}
+
+func TestAuthScopes(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	_, err := service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: authPassword,
+		Type:   PasswordAuth,
+		Client: "ext",
+		Scopes: []string{"secrets:invalid"},
+	})
+	require.EqualError(t, err, `invalid scope "secrets:invalid"`)
+
+	unlock, err := service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: authPassword,
+		Type:   PasswordAuth,
+		Client: "ext",
+		Scopes: []string{SecretsReadScope, SecretsWriteScope},
+	})
+	require.NoError(t, err)
+	authCtx := metadata.NewIncomingContext(ctx, metadata.MD{
+		"authorization": []string{unlock.AuthToken},
+	})
+
+	err = service.auth.authorize(authCtx, "/service.Keys/Secrets")
+	require.NoError(t, err)
+	err = service.auth.authorize(authCtx, "/service.Keys/SecretSave")
+	require.NoError(t, err)
+	err = service.auth.authorize(authCtx, "/service.Keys/KeyExport")
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = token doesn't have scope admin")
+	err = service.auth.authorize(authCtx, "/service.Keys/Sign")
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = token doesn't have scope sign")
+	err = service.auth.authorize(authCtx, "/service.Keys/SecretOTP")
+	require.NoError(t, err)
+
+	// Read only
+	readOnly, err := service.AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: authPassword,
+		Type:   PasswordAuth,
+		Client: "readonly",
+		Scopes: []string{SecretsReadScope},
+	})
+	require.NoError(t, err)
+	readOnlyCtx := metadata.NewIncomingContext(ctx, metadata.MD{
+		"authorization": []string{readOnly.AuthToken},
+	})
+	err = service.auth.authorize(readOnlyCtx, "/service.Keys/Secrets")
+	require.NoError(t, err)
+	// SecretOTP (HOTP) changes the secret
+	err = service.auth.authorize(readOnlyCtx, "/service.Keys/SecretOTP")
+	require.EqualError(t, err, "rpc error: code = PermissionDenied desc = token doesn't have scope secrets:write")
+	_, err = service.AuthTokenRevoke(ctx, &AuthTokenRevokeRequest{Client: "readonly"})
+	require.NoError(t, err)
+
+	// Fresh unlock
+	err = service.auth.authorize(authCtx, "/service.Keys/SecretRemove")
+	require.NoError(t, err)
+	env.clock.Add(time.Minute * 10)
+	err = service.auth.authorize(authCtx, "/service.Keys/SecretRemove")
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = unlock required (token isn't recent)")
+	err = service.auth.authorize(authCtx, "/service.Keys/SecretSave")
+	require.NoError(t, err)
+
+	// Unscoped (full access)
+	testAuthUnlock(t, service)
+	tokens, err := service.AuthTokens(ctx, &AuthTokensRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tokens.Tokens))
+	require.Equal(t, "ext", tokens.Tokens[0].Client)
+	require.Equal(t, []string{SecretsReadScope, SecretsWriteScope}, tokens.Tokens[0].Scopes)
+	require.Equal(t, "test", tokens.Tokens[1].Client)
+	require.Empty(t, tokens.Tokens[1].Scopes)
+
+	// Revoke
+	_, err = service.AuthTokenRevoke(ctx, &AuthTokenRevokeRequest{Client: "ext"})
+	require.NoError(t, err)
+	err = service.auth.authorize(authCtx, "/service.Keys/Secrets")
+	require.EqualError(t, err, "rpc error: code = Unauthenticated desc = invalid token")
+	_, err = service.AuthTokenRevoke(ctx, &AuthTokenRevokeRequest{Client: "ext"})
+	require.EqualError(t, err, "ext not found")
+}
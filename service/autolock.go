@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/keys-pub/keys/dstore"
+)
+
+// Auto-lock policy.
+//
+// The policy is stored in the (sdb) config as "lock", see Config.Lock:
+// - idleTimeout: lock if there were no (authorized) requests for this long.
+// - maxUnlocked: lock after being unlocked for this long.
+// - lockOnSuspend: lock if the system was suspended (sleep).
+//
+// When auto-locked, the service calls lock, which closes the sdb cache and
+// invalidates issued tokens.
+
+// autoLockCheckInterval is how often we check if we should auto-lock.
+const autoLockCheckInterval = time.Second * 5
+
+// suspendThreshold is how much the wall clock can jump (past the check
+// interval) before we assume the system was suspended.
+const suspendThreshold = time.Second * 30
+
+type autoLock struct {
+	sync.Mutex
+	idleTimeout   time.Duration
+	maxUnlocked   time.Duration
+	lockOnSuspend bool
+
+	unlockedAt   time.Time
+	lastActivity time.Time
+
+	cancelFn func()
+}
+
+func (a *autoLock) enabled() bool {
+	return a.idleTimeout > 0 || a.maxUnlocked > 0 || a.lockOnSuspend
+}
+
+// touch records (authorized) activity, from the auth interceptors.
+func (s *service) touch() {
+	s.autoLock.Lock()
+	defer s.autoLock.Unlock()
+	s.autoLock.lastActivity = s.clock.Now()
+}
+
+// loadAutoLock loads the auto-lock policy from the config.
+// Requires the db to be open.
+func (s *service) loadAutoLock(ctx context.Context) error {
+	doc, err := s.db.Get(ctx, dstore.Path("config", "lock"))
+	if err != nil {
+		return err
+	}
+	lock := &Config_Lock{}
+	if doc != nil {
+		var config Config
+		if err := doc.To(&config); err != nil {
+			return err
+		}
+		if config.Lock != nil {
+			lock = config.Lock
+		}
+	}
+	s.autoLock.Lock()
+	defer s.autoLock.Unlock()
+	s.autoLock.idleTimeout = time.Duration(lock.IdleTimeout) * time.Millisecond
+	s.autoLock.maxUnlocked = time.Duration(lock.MaxUnlocked) * time.Millisecond
+	s.autoLock.lockOnSuspend = lock.LockOnSuspend
+	return nil
+}
+
+// startAutoLock starts the auto-lock check, on unlock.
+// If we were already unlocked (unlocked again by a client), we keep the
+// original unlock time, so it doesn't extend maxUnlocked.
+func (s *service) startAutoLock(ctx context.Context) {
+	s.autoLock.Lock()
+	now := s.clock.Now()
+	if s.autoLock.unlockedAt.IsZero() {
+		s.autoLock.unlockedAt = now
+	}
+	s.autoLock.lastActivity = now
+	s.autoLock.Unlock()
+	s.updateAutoLock(ctx)
+}
+
+// updateAutoLock (re)loads the policy and starts or stops the auto-lock check.
+func (s *service) updateAutoLock(ctx context.Context) {
+	if err := s.loadAutoLock(ctx); err != nil {
+		logger.Errorf("Failed to load auto-lock config: %v", err)
+	}
+
+	s.autoLock.Lock()
+	defer s.autoLock.Unlock()
+	if !s.autoLock.enabled() {
+		if s.autoLock.cancelFn != nil {
+			s.autoLock.cancelFn()
+			s.autoLock.cancelFn = nil
+		}
+		return
+	}
+	if s.autoLock.cancelFn != nil {
+		return
+	}
+
+	logger.Debugf("Start auto-lock...")
+	ticker := time.NewTicker(autoLockCheckInterval)
+	cctx, cancel := context.WithCancel(context.Background())
+	s.autoLock.cancelFn = cancel
+
+	go func() {
+		// Round(0) strips the monotonic reading, so we can compare with the
+		// wall clock, which (unlike the monotonic clock) includes time suspended.
+		last := time.Now()
+		for {
+			select {
+			case <-ticker.C:
+				now := time.Now()
+				suspended := now.Round(0).Sub(last.Round(0))-now.Sub(last) > suspendThreshold
+				last = now
+				if s.checkAutoLock(suspended) {
+					ticker.Stop()
+					return
+				}
+			case <-cctx.Done():
+				logger.Debugf("Auto-lock canceled")
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// stopAutoLock stops the auto-lock check, on lock.
+func (s *service) stopAutoLock() {
+	s.autoLock.Lock()
+	defer s.autoLock.Unlock()
+	if s.autoLock.cancelFn != nil {
+		s.autoLock.cancelFn()
+		s.autoLock.cancelFn = nil
+	}
+	s.autoLock.unlockedAt = time.Time{}
+}
+
+// checkAutoLock locks if the policy says we should.
+// Returns true if locked.
+func (s *service) checkAutoLock(suspended bool) bool {
+	s.autoLock.Lock()
+	lockOnSuspend := s.autoLock.lockOnSuspend
+	s.autoLock.Unlock()
+
+	reason := ""
+	switch {
+	case suspended && lockOnSuspend:
+		reason = "suspended"
+	case s.autoLockRemaining() < 0:
+		reason = "timeout"
+	default:
+		return false
+	}
+	logger.Infof("Auto-lock (%s)", reason)
+	s.lock()
+	return true
+}
+
+// autoLockRemaining returns how long until auto-lock, 0 if auto-lock by
+// timeout isn't enabled (or we're locked), or negative if we should be locked.
+func (s *service) autoLockRemaining() time.Duration {
+	s.autoLock.Lock()
+	defer s.autoLock.Unlock()
+	if s.autoLock.unlockedAt.IsZero() {
+		return 0
+	}
+	now := s.clock.Now()
+	var remaining time.Duration
+	set := false
+	if s.autoLock.idleTimeout > 0 {
+		remaining = s.autoLock.lastActivity.Add(s.autoLock.idleTimeout).Sub(now)
+		set = true
+	}
+	if s.autoLock.maxUnlocked > 0 {
+		max := s.autoLock.unlockedAt.Add(s.autoLock.maxUnlocked).Sub(now)
+		if !set || max < remaining {
+			remaining = max
+		}
+		set = true
+	}
+	if set && remaining == 0 {
+		// Distinguish from not enabled
+		remaining = -1
+	}
+	return remaining
+}
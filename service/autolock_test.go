@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoLock(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	// Not enabled
+	require.False(t, service.checkAutoLock(false))
+	require.Equal(t, time.Duration(0), service.autoLockRemaining())
+	status, err := service.RuntimeStatus(ctx, &RuntimeStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), status.LockIn)
+
+	// Idle
+	_, err = service.ConfigSet(ctx, &ConfigSetRequest{
+		Name: "lock",
+		Config: &Config{
+			Lock: &Config_Lock{IdleTimeout: int64(time.Minute / time.Millisecond)},
+		},
+	})
+	require.NoError(t, err)
+	status, err = service.RuntimeStatus(ctx, &RuntimeStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, status.LockIn > 0)
+	require.True(t, status.LockIn <= int64(time.Minute/time.Millisecond))
+
+	env.clock.Add(time.Second * 50)
+	service.touch()
+	env.clock.Add(time.Second * 50)
+	require.False(t, service.checkAutoLock(false))
+	env.clock.Add(time.Second * 20)
+	require.True(t, service.checkAutoLock(false))
+	require.False(t, service.unlocked)
+	require.Empty(t, service.auth.tokens)
+	require.False(t, service.db.IsOpen())
+	status, err = service.RuntimeStatus(ctx, &RuntimeStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, AuthLocked, status.AuthStatus)
+	require.Equal(t, int64(0), status.LockIn)
+
+	// Max unlocked (with activity)
+	testAuthUnlock(t, service)
+	_, err = service.ConfigSet(ctx, &ConfigSetRequest{
+		Name: "lock",
+		Config: &Config{
+			Lock: &Config_Lock{
+				IdleTimeout: int64(time.Minute / time.Millisecond),
+				MaxUnlocked: int64(time.Hour / time.Millisecond),
+			},
+		},
+	})
+	require.NoError(t, err)
+	for i := 0; i < 71; i++ {
+		env.clock.Add(time.Second * 50)
+		service.touch()
+		require.False(t, service.checkAutoLock(false))
+		if i == 36 {
+			// Unlock (again) while unlocked doesn't extend max unlocked
+			testAuthUnlock(t, service)
+		}
+	}
+	env.clock.Add(time.Second * 50)
+	service.touch()
+	require.True(t, service.checkAutoLock(false))
+	require.False(t, service.unlocked)
+
+	// Suspend
+	testAuthUnlock(t, service)
+	require.False(t, service.checkAutoLock(true))
+	_, err = service.ConfigSet(ctx, &ConfigSetRequest{
+		Name:   "lock",
+		Config: &Config{Lock: &Config_Lock{LockOnSuspend: true}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), service.autoLockRemaining())
+	require.True(t, service.checkAutoLock(true))
+	require.False(t, service.unlocked)
+}
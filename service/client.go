@@ -189,6 +189,9 @@ func runClient(build Build, args []string, client *Client, errorFn func(err erro
 	cmds = append(cmds, fido2Commands(client)...)
 	cmds = append(cmds, adminCommands(client)...)
 	cmds = append(cmds, vaultCommands(client)...)
+	cmds = append(cmds, secretCommands(client)...)
+	cmds = append(cmds, runCommands(client)...)
+	cmds = append(cmds, sharedCommands(client)...)
 	cmds = append(cmds, messageCommands(client)...)
 
 	sort.Slice(cmds, func(i, j int) bool {
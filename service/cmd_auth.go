@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys-ext/auth/fido2"
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"google.golang.org/grpc/codes"
@@ -21,13 +23,15 @@ func authCommands(client *Client) []cli.Command {
 		cli.Command{
 			Name:  "auth",
 			Usage: "Authorize",
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				cli.StringFlag{Name: "password, pin", Usage: "password or pin"},
 				cli.BoolFlag{Name: "token", Usage: "output token only"},
-				cli.StringFlag{Name: "type, t", Usage: "auth type: password, fido2-hmac-secret", Value: "password"},
+				cli.StringFlag{Name: "type, t", Usage: "auth type: password, fido2-hmac-secret, paper-key, key-file", Value: "password"},
+				cli.StringFlag{Name: "paper-key", Usage: "paper key"},
 				cli.StringFlag{Name: "client", Value: "cli", Hidden: true},
+				cli.StringSliceFlag{Name: "scope", Usage: "token scope: keys:read, sign, encrypt, secrets:read, secrets:write, admin (default is full access)"},
 				cli.StringFlag{Name: "device", Value: "device path or product name (for FIDO2)"},
-			},
+			}, keyFileFlags...),
 			Aliases: []string{"unlock"},
 			Subcommands: []cli.Command{
 				authProvisionCommand(client),
@@ -35,8 +39,14 @@ func authCommands(client *Client) []cli.Command {
 				authDeprovisionCommand(client),
 				authVaultCommand(client),
 				changePasswordCommand(client),
+				authRotateCommand(client),
 				authDevicesCommand(client),
 				authResetCommand(client),
+				authRecoverCommand(client),
+				authLogCommand(client),
+				authAutoLockCommand(client),
+				authTokensCommand(client),
+				authRevokeCommand(client),
 			},
 			Action: func(c *cli.Context) error {
 				if !c.GlobalBool("test") {
@@ -56,6 +66,7 @@ func authCommands(client *Client) []cli.Command {
 				if clientName == "" {
 					return errors.Errorf("no client name")
 				}
+				scopes := c.StringSlice("scope")
 
 				authType, err := chooseAuth("How do you want to authorize?", c.String("type"))
 				if err != nil {
@@ -68,17 +79,25 @@ func authCommands(client *Client) []cli.Command {
 					logger.Infof("Auth setup...")
 					switch authType {
 					case PasswordAuth:
-						authToken, authErr = passwordAuthSetup(context.TODO(), client, clientName, c.String("password"))
+						authToken, authErr = passwordAuthSetup(context.TODO(), client, clientName, scopes, c.String("password"))
 					case FIDO2HMACSecretAuth:
-						authToken, authErr = fido2AuthSetup(context.TODO(), client, clientName, c.String("device"), c.String("pin"))
+						authToken, authErr = fido2AuthSetup(context.TODO(), client, clientName, scopes, c.String("device"), c.String("pin"))
+					case KeyFileAuth:
+						authToken, authErr = keyFileAuthSetup(context.TODO(), client, clientName, scopes, c)
+					case PaperKeyAuth:
+						authErr = errors.Errorf("setup with paper key not supported, setup with a password and then provision a paper key")
 					}
 				} else {
 					logger.Infof("Auth unlock...")
 					switch authType {
 					case PasswordAuth:
-						authToken, authErr = passwordAuthUnlock(context.TODO(), client, clientName, c.String("password"))
+						authToken, authErr = passwordAuthUnlock(context.TODO(), client, clientName, scopes, c.String("password"))
 					case FIDO2HMACSecretAuth:
-						authToken, authErr = fido2AuthUnlock(context.TODO(), client, clientName, c.String("pin"))
+						authToken, authErr = fido2AuthUnlock(context.TODO(), client, clientName, scopes, c.String("pin"))
+					case PaperKeyAuth:
+						authToken, authErr = paperKeyAuthUnlock(context.TODO(), client, clientName, scopes, c.String("paper-key"))
+					case KeyFileAuth:
+						authToken, authErr = keyFileAuthUnlock(context.TODO(), client, clientName, scopes, c)
 					}
 				}
 				if authErr != nil {
@@ -167,15 +186,52 @@ func changePasswordCommand(client *Client) cli.Command {
 	}
 }
 
+func authRotateCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "rotate",
+		Usage: "Rotate vault master key",
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{Name: "paper-key", Usage: "paper key (for each paper key auth)"},
+			cli.BoolFlag{Name: "force", Usage: "remove auths that aren't specified"},
+		},
+		Action: func(c *cli.Context) error {
+			password, err := readPassword("Password:", true)
+			if err != nil {
+				return err
+			}
+			secrets := []*AuthRotateSecret{}
+			if password != "" {
+				secrets = append(secrets, &AuthRotateSecret{Type: PasswordAuth, Secret: password})
+			}
+			for _, pk := range c.StringSlice("paper-key") {
+				secrets = append(secrets, &AuthRotateSecret{Type: PaperKeyAuth, Secret: pk})
+			}
+			resp, err := client.KeysClient().AuthRotate(context.TODO(), &AuthRotateRequest{
+				Secrets: secrets,
+				Force:   c.Bool("force"),
+			})
+			if err != nil {
+				return err
+			}
+			if resp.KID != "" {
+				fmt.Println(resp.KID)
+			}
+			return nil
+		},
+	}
+}
+
 func authProvisionCommand(client *Client) cli.Command {
 	return cli.Command{
 		Name:  "provision",
 		Usage: "Provision",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			cli.StringFlag{Name: "password, pin, p", Usage: "password or pin"},
-			cli.StringFlag{Name: "type", Usage: "auth type: password, fido2-hmac-secret"},
+			cli.StringFlag{Name: "type", Usage: "auth type: password, fido2-hmac-secret, paper-key, key-file"},
 			cli.StringFlag{Name: "device", Value: "", Usage: "device path or product name"},
-		},
+			cli.StringFlag{Name: "out, o", Usage: "file to write recovery kit to (for paper key)"},
+			cli.BoolFlag{Name: "generate", Usage: "generate the key file (for key file)"},
+		}, keyFileFlags...),
 		Action: func(c *cli.Context) error {
 			rts, err := client.KeysClient().RuntimeStatus(context.TODO(), &RuntimeStatusRequest{})
 			if err != nil {
@@ -203,6 +259,14 @@ func authProvisionCommand(client *Client) cli.Command {
 				if err := fido2AuthProvision(context.TODO(), client, c.String("device"), c.String("pin")); err != nil {
 					return err
 				}
+			case PaperKeyAuth:
+				if err := paperKeyAuthProvision(context.TODO(), client, c.String("out")); err != nil {
+					return err
+				}
+			case KeyFileAuth:
+				if err := keyFileAuthProvision(context.TODO(), client, c); err != nil {
+					return err
+				}
 			}
 
 			return nil
@@ -288,6 +352,169 @@ func authResetCommand(client *Client) cli.Command {
 	}
 }
 
+func authRecoverCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "recover",
+		Usage: "Recover with a paper key (and set a new password)",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "paper-key", Usage: "paper key"},
+			cli.BoolFlag{Name: "token", Usage: "output token only"},
+		},
+		Action: func(c *cli.Context) error {
+			paperKey := c.String("paper-key")
+			if paperKey == "" {
+				p, err := readPaperKey(os.Stdin, os.Stderr)
+				if err != nil {
+					return err
+				}
+				paperKey = p
+			}
+			password, err := readVerifyPassword("New password:")
+			if err != nil {
+				return err
+			}
+			resp, err := client.KeysClient().AuthRecover(context.TODO(), &AuthRecoverRequest{
+				PaperKey:    paperKey,
+				NewPassword: password,
+			})
+			if err != nil {
+				return err
+			}
+			if c.Bool("token") {
+				fmt.Println(resp.AuthToken)
+				return nil
+			}
+			fmt.Printf("export KEYS_AUTH=\"%s\"\n", resp.AuthToken)
+			return nil
+		},
+	}
+}
+
+func authLogCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "log",
+		Usage: "Auth log (unlocks, locks, provisions, tokens)",
+		Flags: []cli.Flag{
+			cli.IntFlag{Name: "limit, n", Usage: "limit to most recent"},
+		},
+		Action: func(c *cli.Context) error {
+			resp, err := client.KeysClient().AuthLog(context.TODO(), &AuthLogRequest{
+				Limit: int32(c.Int("limit")),
+			})
+			if err != nil {
+				return err
+			}
+			for _, entry := range resp.Entries {
+				ts := tsutil.ConvertMillis(entry.Timestamp).Format(time.RFC3339)
+				out := []string{ts, entry.Type}
+				if entry.AuthType != UnknownAuth {
+					out = append(out, authTypeString(entry.AuthType))
+				}
+				if entry.Provision != "" {
+					out = append(out, entry.Provision)
+				}
+				if entry.Client != "" {
+					out = append(out, "client="+entry.Client)
+				}
+				fmt.Println(strings.Join(out, " "))
+			}
+			return nil
+		},
+	}
+}
+
+func authAutoLockCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "auto-lock",
+		Usage: "Auto-lock policy",
+		Flags: []cli.Flag{
+			cli.DurationFlag{Name: "idle", Usage: "lock after idle for duration, e.g. 15m (0 to disable)"},
+			cli.DurationFlag{Name: "max", Usage: "lock after unlocked for duration, e.g. 8h (0 to disable)"},
+			cli.BoolFlag{Name: "on-suspend", Usage: "lock if the system was suspended"},
+		},
+		Action: func(c *cli.Context) error {
+			ctx := context.TODO()
+			if c.NumFlags() == 0 {
+				resp, err := client.KeysClient().ConfigGet(ctx, &ConfigGetRequest{Name: "lock"})
+				if err != nil {
+					return err
+				}
+				lock := &Config_Lock{}
+				if resp.Config != nil && resp.Config.Lock != nil {
+					lock = resp.Config.Lock
+				}
+				fmt.Printf("idle: %s\n", time.Duration(lock.IdleTimeout)*time.Millisecond)
+				fmt.Printf("max: %s\n", time.Duration(lock.MaxUnlocked)*time.Millisecond)
+				fmt.Printf("on-suspend: %t\n", lock.LockOnSuspend)
+				return nil
+			}
+			_, err := client.KeysClient().ConfigSet(ctx, &ConfigSetRequest{
+				Name: "lock",
+				Config: &Config{
+					Lock: &Config_Lock{
+						IdleTimeout:   int64(c.Duration("idle") / time.Millisecond),
+						MaxUnlocked:   int64(c.Duration("max") / time.Millisecond),
+						LockOnSuspend: c.Bool("on-suspend"),
+					},
+				},
+			})
+			return err
+		},
+	}
+}
+
+func authTokensCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "tokens",
+		Usage: "List issued tokens",
+		Action: func(c *cli.Context) error {
+			resp, err := client.KeysClient().AuthTokens(context.TODO(), &AuthTokensRequest{})
+			if err != nil {
+				return err
+			}
+			for _, t := range resp.Tokens {
+				scopes := "(all)"
+				if len(t.Scopes) > 0 {
+					scopes = strings.Join(t.Scopes, ",")
+				}
+				fmt.Printf("%s %s %s\n", t.Client, scopes, tsutil.ConvertMillis(t.CreatedAt).Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+}
+
+func authRevokeCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:      "revoke",
+		Usage:     "Revoke token (for client)",
+		ArgsUsage: "client",
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if name == "" {
+				return errors.Errorf("specify a client")
+			}
+			_, err := client.KeysClient().AuthTokenRevoke(context.TODO(), &AuthTokenRevokeRequest{Client: name})
+			return err
+		},
+	}
+}
+
+func authTypeString(t AuthType) string {
+	switch t {
+	case PasswordAuth:
+		return "password"
+	case PaperKeyAuth:
+		return "paper-key"
+	case FIDO2HMACSecretAuth:
+		return "fido2-hmac-secret"
+	case KeyFileAuth:
+		return "key-file"
+	default:
+		return "unknown"
+	}
+}
+
 func chooseAuth(title string, arg string) (AuthType, error) {
 	if arg != "" {
 		return authTypeFromString(arg)
@@ -298,6 +525,7 @@ func chooseAuth(title string, arg string) (AuthType, error) {
 		fmt.Fprintln(os.Stderr, title)
 		fmt.Fprintln(os.Stderr, "(p) Password")
 		fmt.Fprintln(os.Stderr, "(f) FIDO2 hmac-secret")
+		fmt.Fprintln(os.Stderr, "(k) Paper key")
 		input, err := reader.ReadString('\n')
 		if err != nil {
 			return UnknownAuth, err
@@ -318,6 +546,10 @@ func authTypeFromString(s string) (AuthType, error) {
 		return PasswordAuth, nil
 	case "f", "fido2-hmac-secret":
 		return FIDO2HMACSecretAuth, nil
+	case "k", "paper-key":
+		return PaperKeyAuth, nil
+	case "key-file":
+		return KeyFileAuth, nil
 	default:
 		return UnknownAuth, errors.Errorf("unknown auth type: %s", s)
 	}
@@ -12,7 +12,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func fido2AuthSetup(ctx context.Context, client *Client, clientName string, device string, pin string) (string, error) {
+func fido2AuthSetup(ctx context.Context, client *Client, clientName string, scopes []string, device string, pin string) (string, error) {
 	if device == "" {
 		d, err := selectDevice(ctx, client)
 		if err != nil {
@@ -43,6 +43,7 @@ func fido2AuthSetup(ctx context.Context, client *Client, clientName string, devi
 		Secret: pin,
 		Type:   FIDO2HMACSecretAuth,
 		Client: clientName,
+		Scopes: scopes,
 	})
 	if err != nil {
 		return "", err
@@ -51,7 +52,7 @@ func fido2AuthSetup(ctx context.Context, client *Client, clientName string, devi
 	return unlockResp.AuthToken, nil
 }
 
-func fido2AuthUnlock(ctx context.Context, client *Client, clientName string, pin string) (string, error) {
+func fido2AuthUnlock(ctx context.Context, client *Client, clientName string, scopes []string, pin string) (string, error) {
 	if pin == "" {
 		p, err := readPassword("Enter your PIN:", true)
 		if err != nil {
@@ -65,6 +66,7 @@ func fido2AuthUnlock(ctx context.Context, client *Client, clientName string, pin
 		Secret: pin,
 		Type:   FIDO2HMACSecretAuth,
 		Client: clientName,
+		Scopes: scopes,
 	})
 	if err != nil {
 		return "", err
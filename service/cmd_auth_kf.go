@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var keyFileFlags = []cli.Flag{
+	cli.StringFlag{Name: "key-file", Usage: "key file (for key-file auth)"},
+	cli.IntFlag{Name: "key-fd", Usage: "file descriptor to read key from (for key-file auth)", Value: -1},
+}
+
+// keyFileArg returns the key (as auth secret) and path hint from the key-file
+// or key-fd flags.
+func keyFileArg(c *cli.Context) (string, string, error) {
+	if c.String("key-file") != "" {
+		path, err := filepath.Abs(c.String("key-file"))
+		if err != nil {
+			return "", "", err
+		}
+		key, err := readKeyFile(path)
+		if err != nil {
+			return "", "", err
+		}
+		return keyFileSecret(key), path, nil
+	}
+	if fd := c.Int("key-fd"); fd >= 0 {
+		key, err := readKeyFileFD(uintptr(fd))
+		if err != nil {
+			return "", "", err
+		}
+		return keyFileSecret(key), fmt.Sprintf("fd:%d", fd), nil
+	}
+	return "", "", errors.Errorf("specify -key-file or -key-fd")
+}
+
+func keyFileAuthSetup(ctx context.Context, client *Client, clientName string, scopes []string, c *cli.Context) (string, error) {
+	secret, path, err := keyFileArg(c)
+	if err != nil {
+		return "", err
+	}
+	if _, err := client.KeysClient().AuthSetup(ctx, &AuthSetupRequest{
+		Secret: secret,
+		Type:   KeyFileAuth,
+		Path:   path,
+	}); err != nil {
+		return "", err
+	}
+	return keyFileAuthUnlockWithSecret(ctx, client, clientName, scopes, secret)
+}
+
+func keyFileAuthUnlock(ctx context.Context, client *Client, clientName string, scopes []string, c *cli.Context) (string, error) {
+	secret, _, err := keyFileArg(c)
+	if err != nil {
+		return "", err
+	}
+	return keyFileAuthUnlockWithSecret(ctx, client, clientName, scopes, secret)
+}
+
+func keyFileAuthUnlockWithSecret(ctx context.Context, client *Client, clientName string, scopes []string, secret string) (string, error) {
+	unlock, err := client.KeysClient().AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: secret,
+		Type:   KeyFileAuth,
+		Client: clientName,
+		Scopes: scopes,
+	})
+	if err != nil {
+		return "", err
+	}
+	return unlock.AuthToken, nil
+}
+
+func keyFileAuthProvision(ctx context.Context, client *Client, c *cli.Context) error {
+	if c.Bool("generate") {
+		if c.String("key-file") == "" {
+			return errors.Errorf("specify -key-file to generate")
+		}
+		path, err := filepath.Abs(c.String("key-file"))
+		if err != nil {
+			return err
+		}
+		if _, err := generateKeyFile(path); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Created key file %s\n", path)
+	}
+	secret, path, err := keyFileArg(c)
+	if err != nil {
+		return err
+	}
+	resp, err := client.KeysClient().AuthProvision(ctx, &AuthProvisionRequest{
+		Secret: secret,
+		Type:   KeyFileAuth,
+		Path:   path,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(resp.Provision.ID)
+	return nil
+}
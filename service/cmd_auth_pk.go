@@ -0,0 +1,128 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys/encoding"
+	"github.com/pkg/errors"
+)
+
+// paperKeyConfirmWords is the number of words to confirm when provisioning a
+// paper key.
+const paperKeyConfirmWords = 3
+
+func paperKeyAuthUnlock(ctx context.Context, client *Client, clientName string, scopes []string, paperKey string) (string, error) {
+	if paperKey == "" {
+		p, err := readPaperKey(os.Stdin, os.Stderr)
+		if err != nil {
+			return "", err
+		}
+		paperKey = p
+	}
+
+	unlock, err := client.KeysClient().AuthUnlock(ctx, &AuthUnlockRequest{
+		Secret: paperKey,
+		Type:   PaperKeyAuth,
+		Client: clientName,
+		Scopes: scopes,
+	})
+	if err != nil {
+		return "", err
+	}
+	return unlock.AuthToken, nil
+}
+
+func paperKeyAuthProvision(ctx context.Context, client *Client, out string) error {
+	paperKey, err := generatePaperKey()
+	if err != nil {
+		return err
+	}
+	checksum, err := paperKeyChecksum(paperKey)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Write down your paper key:\n\n")
+	fmt.Fprintf(os.Stderr, "%s\n\n", paperKey)
+	fmt.Fprintf(os.Stderr, "Checksum: %s\n\n", checksum)
+
+	if err := confirmPaperKey(os.Stdin, os.Stderr, paperKey, randPositions(len(strings.Fields(paperKey)), paperKeyConfirmWords)); err != nil {
+		return err
+	}
+
+	resp, err := client.KeysClient().AuthProvision(ctx, &AuthProvisionRequest{
+		Secret: paperKey,
+		Type:   PaperKeyAuth,
+	})
+	if err != nil {
+		return err
+	}
+
+	kit, err := paperKeyRecoveryKit(paperKey, resp.Provision, time.Now())
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		fmt.Println(kit)
+		return nil
+	}
+	if err := ioutil.WriteFile(out, []byte(kit), 0600); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Saved recovery kit to %s\n", out)
+	return nil
+}
+
+// readPaperKey reads a paper key, checking it's a valid phrase.
+func readPaperKey(r io.Reader, w io.Writer) (string, error) {
+	fmt.Fprintf(w, "Enter your paper key: ")
+	reader := bufio.NewReader(r)
+	paperKey, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	if !encoding.IsValidPhrase(paperKey, true) {
+		return "", errors.Errorf("invalid paper key, check the words (and checksum) with your recovery kit")
+	}
+	return paperKey, nil
+}
+
+// confirmPaperKey asks for the words at positions (0-indexed) of the paper key.
+func confirmPaperKey(r io.Reader, w io.Writer, paperKey string, positions []int) error {
+	words := strings.Fields(paperKey)
+	reader := bufio.NewReader(r)
+	for _, pos := range positions {
+		if pos < 0 || pos >= len(words) {
+			return errors.Errorf("invalid word position")
+		}
+		fmt.Fprintf(w, "Enter word #%d: ", pos+1)
+		word, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if strings.TrimSpace(strings.ToLower(word)) != words[pos] {
+			return errors.Errorf("word #%d doesn't match", pos+1)
+		}
+	}
+	return nil
+}
+
+// randPositions returns n (sorted) random positions from [0, max).
+func randPositions(max int, n int) []int {
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano())) // #nosec
+	positions := rnd.Perm(max)
+	if n < max {
+		positions = positions[:n]
+	}
+	sort.Ints(positions)
+	return positions
+}
@@ -6,7 +6,7 @@ import (
 	"os"
 )
 
-func passwordAuthSetup(ctx context.Context, client *Client, clientName string, password string) (string, error) {
+func passwordAuthSetup(ctx context.Context, client *Client, clientName string, scopes []string, password string) (string, error) {
 	if len(password) == 0 {
 		fmt.Fprintf(os.Stderr, "OK, let's create a password.\n")
 		p, err := readVerifyPassword("Create a password:")
@@ -27,6 +27,7 @@ func passwordAuthSetup(ctx context.Context, client *Client, clientName string, p
 		Secret: password,
 		Type:   PasswordAuth,
 		Client: clientName,
+		Scopes: scopes,
 	})
 	if err != nil {
 		return "", err
@@ -35,7 +36,7 @@ func passwordAuthSetup(ctx context.Context, client *Client, clientName string, p
 	return unlockResp.AuthToken, nil
 }
 
-func passwordAuthUnlock(ctx context.Context, client *Client, clientName string, password string) (string, error) {
+func passwordAuthUnlock(ctx context.Context, client *Client, clientName string, scopes []string, password string) (string, error) {
 	if len(password) == 0 {
 		p, err := readPassword("Enter your password:", false)
 		if err != nil {
@@ -48,6 +49,7 @@ func passwordAuthUnlock(ctx context.Context, client *Client, clientName string,
 		Secret: password,
 		Type:   PasswordAuth,
 		Client: clientName,
+		Scopes: scopes,
 	})
 	if err != nil {
 		return "", err
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func runCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:      "run",
+			Usage:     "Run a command with secrets in the environment",
+			ArgsUsage: "-- command args",
+			Description: "Values can reference secrets, secret://name/field, where field is\n" +
+				"   password (default), username, url, notes, otp or a custom field name.\n" +
+				"   For example: keys run --env TOKEN=secret://github/password -- ./deploy.sh\n" +
+				"   Secret values are only in the command environment, and are masked in its output.\n" +
+				"   Keys client env vars, like KEYS_AUTH, aren't passed to the command.",
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{Name: "env, e", Usage: "env NAME=value (or secret reference)"},
+				cli.StringFlag{Name: "env-file", Usage: "env file (.env) with NAME=value (or secret reference) lines"},
+				cli.StringFlag{Name: "collection", Usage: "shared collection"},
+				cli.BoolFlag{Name: "no-mask", Usage: "don't mask secret values in output"},
+			},
+			Action: func(c *cli.Context) error {
+				if c.NArg() == 0 {
+					return errors.Errorf("no command specified")
+				}
+				envs := []string{}
+				if path := c.String("env-file"); path != "" {
+					f, err := os.Open(path)
+					if err != nil {
+						return err
+					}
+					fenvs, err := parseEnvFile(f)
+					f.Close()
+					if err != nil {
+						return err
+					}
+					envs = append(envs, fenvs...)
+				}
+				envs = append(envs, c.StringSlice("env")...)
+
+				resolver := &secretResolver{client: client.KeysClient(), collection: c.String("collection")}
+				resolved, masks, err := resolver.resolveEnv(context.TODO(), envs)
+				if err != nil {
+					return err
+				}
+				if c.Bool("no-mask") {
+					masks = nil
+				}
+
+				stdout := newMaskWriter(os.Stdout, masks)
+				stderr := newMaskWriter(os.Stderr, masks)
+				cmd := exec.Command(c.Args().First(), c.Args().Tail()...)
+				cmd.Env = append(runEnviron(os.Environ()), resolved...)
+				cmd.Stdin = os.Stdin
+				cmd.Stdout = stdout
+				cmd.Stderr = stderr
+				runErr := cmd.Run()
+				if err := stdout.Flush(); err != nil {
+					return err
+				}
+				if err := stderr.Flush(); err != nil {
+					return err
+				}
+				if exitErr, ok := runErr.(*exec.ExitError); ok {
+					return cli.NewExitError("", exitErr.ExitCode())
+				}
+				return runErr
+			},
+		},
+	}
+}
+
+// clientEnvPrefix is the prefix for keys client env vars, like KEYS_AUTH.
+const clientEnvPrefix = "KEYS_"
+
+// runEnviron returns the env for a command, without keys client env vars, so
+// the command can't use our auth token to get other secrets.
+func runEnviron(environ []string) []string {
+	out := make([]string, 0, len(environ))
+	for _, env := range environ {
+		if strings.HasPrefix(env, clientEnvPrefix) {
+			continue
+		}
+		out = append(out, env)
+	}
+	return out
+}
+
+// secretRefScheme is the prefix for a secret reference.
+const secretRefScheme = "secret://"
+
+// secretRef is a reference to a secret field, secret://name/field.
+type secretRef struct {
+	Name  string
+	Field string
+}
+
+// parseSecretRef parses a secret reference, for example,
+// "secret://github/password". The field defaults to password.
+// Returns nil if not a secret reference.
+func parseSecretRef(s string) (*secretRef, error) {
+	if !strings.HasPrefix(s, secretRefScheme) {
+		return nil, nil
+	}
+	path := strings.TrimPrefix(s, secretRefScheme)
+	name, field := path, "password"
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		name, field = path[:i], path[i+1:]
+	}
+	name, err := url.PathUnescape(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid secret reference %q", s)
+	}
+	field, err = url.PathUnescape(field)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid secret reference %q", s)
+	}
+	if name == "" || field == "" {
+		return nil, errors.Errorf("invalid secret reference %q", s)
+	}
+	return &secretRef{Name: name, Field: field}, nil
+}
+
+// parseEnvFile parses NAME=value lines, skipping blank lines and # comments.
+// Values can be quoted, and lines can have an "export " prefix.
+func parseEnvFile(r io.Reader) ([]string, error) {
+	envs := []string{}
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		i := strings.Index(line, "=")
+		if i <= 0 {
+			return nil, errors.Errorf("invalid env file, line %d", n)
+		}
+		name, value := strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		envs = append(envs, name+"="+value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return envs, nil
+}
+
+type secretResolver struct {
+	client     KeysClient
+	collection string
+}
+
+// resolveEnv resolves secret references in NAME=value envs.
+// Returns the envs and the (secret) values to mask.
+func (r *secretResolver) resolveEnv(ctx context.Context, envs []string) ([]string, []string, error) {
+	out := make([]string, 0, len(envs))
+	masks := []string{}
+	for _, env := range envs {
+		i := strings.Index(env, "=")
+		if i <= 0 {
+			return nil, nil, errors.Errorf("invalid env %q, should be NAME=value", env)
+		}
+		name, value := env[:i], env[i+1:]
+		ref, err := parseSecretRef(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ref != nil {
+			value, err = r.resolve(ctx, ref)
+			if err != nil {
+				return nil, nil, err
+			}
+			if value != "" {
+				masks = append(masks, value)
+			}
+		}
+		out = append(out, name+"="+value)
+	}
+	return out, masks, nil
+}
+
+func (r *secretResolver) resolve(ctx context.Context, ref *secretRef) (string, error) {
+	resp, err := r.client.Secret(ctx, &SecretRequest{Name: ref.Name, Collection: r.collection})
+	if err != nil {
+		return "", err
+	}
+	secret := resp.Secret
+	switch ref.Field {
+	case "password":
+		return secret.Password, nil
+	case "username":
+		return secret.Username, nil
+	case "url":
+		return secret.URL, nil
+	case "notes":
+		return secret.Notes, nil
+	case "otp":
+		otp, err := r.client.SecretOTP(ctx, &SecretOTPRequest{ID: secret.ID, Collection: r.collection})
+		if err != nil {
+			return "", err
+		}
+		return otp.Code, nil
+	}
+	for _, f := range secret.Fields {
+		if f.Name == ref.Field {
+			return f.Value, nil
+		}
+	}
+	return "", errors.Errorf("secret %q has no field %q", ref.Name, ref.Field)
+}
+
+// maskedValue replaces secret values in output.
+const maskedValue = "*****"
+
+// maskWriter replaces secret values written to w.
+// Output that might be the start of a secret value is held back until it
+// can't be, or until Flush.
+type maskWriter struct {
+	sync.Mutex
+	w     io.Writer
+	masks [][]byte
+	buf   []byte
+}
+
+func newMaskWriter(w io.Writer, masks []string) *maskWriter {
+	mw := &maskWriter{w: w}
+	for _, m := range masks {
+		if m != "" {
+			mw.masks = append(mw.masks, []byte(m))
+		}
+	}
+	// Replace longer values first, in case a value contains another.
+	sort.SliceStable(mw.masks, func(i, j int) bool { return len(mw.masks[i]) > len(mw.masks[j]) })
+	return mw
+}
+
+func (m *maskWriter) Write(p []byte) (int, error) {
+	m.Lock()
+	defer m.Unlock()
+	if len(m.masks) == 0 {
+		return m.w.Write(p)
+	}
+	m.buf = append(m.buf, p...)
+	for _, mask := range m.masks {
+		m.buf = bytes.ReplaceAll(m.buf, mask, []byte(maskedValue))
+	}
+	n := len(m.buf) - m.partial()
+	if n > 0 {
+		if _, err := m.w.Write(m.buf[:n]); err != nil {
+			return 0, err
+		}
+		m.buf = append(m.buf[:0], m.buf[n:]...)
+	}
+	return len(p), nil
+}
+
+// partial returns the length of the longest suffix of buf that is the start
+// of a secret value.
+func (m *maskWriter) partial() int {
+	max := 0
+	for _, mask := range m.masks {
+		for l := len(mask) - 1; l > max; l-- {
+			if l <= len(m.buf) && bytes.HasSuffix(m.buf, mask[:l]) {
+				max = l
+				break
+			}
+		}
+	}
+	return max
+}
+
+// Flush writes any held back output.
+func (m *maskWriter) Flush() error {
+	m.Lock()
+	defer m.Unlock()
+	if len(m.buf) == 0 {
+		return nil
+	}
+	_, err := m.w.Write(m.buf)
+	m.buf = m.buf[:0]
+	return err
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecretRef(t *testing.T) {
+	ref, err := parseSecretRef("secret://github/password")
+	require.NoError(t, err)
+	require.Equal(t, &secretRef{Name: "github", Field: "password"}, ref)
+
+	ref, err = parseSecretRef("secret://keys.pub")
+	require.NoError(t, err)
+	require.Equal(t, &secretRef{Name: "keys.pub", Field: "password"}, ref)
+
+	ref, err = parseSecretRef("secret://My%20Server/API%20Key")
+	require.NoError(t, err)
+	require.Equal(t, &secretRef{Name: "My Server", Field: "API Key"}, ref)
+
+	ref, err = parseSecretRef("plainvalue")
+	require.NoError(t, err)
+	require.Nil(t, ref)
+
+	_, err = parseSecretRef("secret:///password")
+	require.EqualError(t, err, `invalid secret reference "secret:///password"`)
+}
+
+func TestParseEnvFile(t *testing.T) {
+	in := "# Comment\n" +
+		"\n" +
+		"TOKEN=secret://github/password\n" +
+		"export DEBUG=1\n" +
+		"NAME=\"Alice Smith\"\n"
+	envs, err := parseEnvFile(strings.NewReader(in))
+	require.NoError(t, err)
+	require.Equal(t, []string{"TOKEN=secret://github/password", "DEBUG=1", "NAME=Alice Smith"}, envs)
+
+	_, err = parseEnvFile(strings.NewReader("TOKEN\n"))
+	require.EqualError(t, err, "invalid env file, line 1")
+}
+
+func TestRunEnviron(t *testing.T) {
+	environ := []string{"PATH=/usr/bin", "KEYS_AUTH=token", "KEYS_PORT=22405", "HOME=/home/alice", "MY_KEYS_DIR=/tmp"}
+	envs := runEnviron(environ)
+	require.Equal(t, []string{"PATH=/usr/bin", "HOME=/home/alice", "MY_KEYS_DIR=/tmp"}, envs)
+}
+
+func TestMaskWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := newMaskWriter(&out, []string{"secretvalue"})
+	_, err := w.Write([]byte("token: secr"))
+	require.NoError(t, err)
+	require.Equal(t, "token: ", out.String())
+	_, err = w.Write([]byte("etvalue\nsecret"))
+	require.NoError(t, err)
+	require.Equal(t, "token: *****\n", out.String())
+	err = w.Flush()
+	require.NoError(t, err)
+	require.Equal(t, "token: *****\nsecret", out.String())
+
+	// Longer values are masked first
+	out.Reset()
+	w = newMaskWriter(&out, []string{"pass", "password1"})
+	_, err = w.Write([]byte("password1 pass\n"))
+	require.NoError(t, err)
+	err = w.Flush()
+	require.NoError(t, err)
+	require.Equal(t, "***** *****\n", out.String())
+}
+
+func TestSecretResolver(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	client, closeClFn := newTestRPCClient(t, service, env, "", nil)
+	defer closeClFn()
+
+	testAuthSetup(t, service)
+
+	_, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{
+			Name:     "github",
+			Type:     PasswordSecret,
+			Username: "alice",
+			Password: "ghpassword",
+			Fields:   []*SecretField{{Name: "token", Type: HiddenField, Value: "ghtoken"}},
+		},
+	})
+	require.NoError(t, err)
+
+	resolver := &secretResolver{client: client.KeysClient()}
+	envs, masks, err := resolver.resolveEnv(ctx, []string{
+		"PASSWORD=secret://github",
+		"USER=secret://github/username",
+		"TOKEN=secret://github/token",
+		"DEBUG=1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"PASSWORD=ghpassword", "USER=alice", "TOKEN=ghtoken", "DEBUG=1"}, envs)
+	require.Equal(t, []string{"ghpassword", "alice", "ghtoken"}, masks)
+
+	_, _, err = resolver.resolveEnv(ctx, []string{"TOKEN=secret://github/apikey"})
+	require.EqualError(t, err, `secret "github" has no field "apikey"`)
+	_, _, err = resolver.resolveEnv(ctx, []string{"TOKEN=secret://gitlab"})
+	require.Error(t, err)
+}
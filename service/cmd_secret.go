@@ -0,0 +1,104 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func secretCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "secret",
+			Usage: "Secrets",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:      "history",
+					Usage:     "Show secret history",
+					ArgsUsage: "id",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "id"},
+						cli.StringFlag{Name: "collection", Usage: "shared collection"},
+					},
+					Action: func(c *cli.Context) error {
+						id, err := argString(c, "id", false)
+						if err != nil {
+							return err
+						}
+						resp, err := client.KeysClient().SecretHistory(context.TODO(), &SecretHistoryRequest{
+							ID:         id,
+							Collection: c.String("collection"),
+						})
+						if err != nil {
+							return err
+						}
+						fmtSecretVersions(resp.Versions)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:      "restore",
+					Usage:     "Restore secret to a version",
+					ArgsUsage: "id",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "id"},
+						cli.Int64Flag{Name: "version, v", Usage: "version (from history)"},
+						cli.StringFlag{Name: "collection", Usage: "shared collection"},
+					},
+					Action: func(c *cli.Context) error {
+						id, err := argString(c, "id", false)
+						if err != nil {
+							return err
+						}
+						if !c.IsSet("version") {
+							return errors.Errorf("no version specified")
+						}
+						resp, err := client.KeysClient().SecretRestore(context.TODO(), &SecretRestoreRequest{
+							ID:         id,
+							Version:    c.Int64("version"),
+							Collection: c.String("collection"),
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp.Secret)
+						return nil
+					},
+				},
+				secretOTPCommand(client),
+				secretImportCommand(client),
+				secretExportCommand(client),
+				secretAuditCommand(client),
+				secretShareCommand(client),
+				secretReceiveCommand(client),
+			},
+		},
+	}
+}
+
+func fmtSecretVersions(versions []*SecretVersion) {
+	out := &bytes.Buffer{}
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 8, 1, ' ', 0)
+	for _, v := range versions {
+		updated := ""
+		if v.UpdatedAt != 0 {
+			updated = tsutil.ConvertMillis(v.UpdatedAt).Format(time.RFC3339)
+		}
+		name := "(deleted)"
+		if v.Secret != nil {
+			name = v.Secret.Name
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", v.Version, updated, name)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+	fmt.Print(out.String())
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/urfave/cli"
+)
+
+func secretAuditCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "audit",
+		Usage: "Check passwords (breached, reused, weak, stale or missing URL)",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "breached", Usage: "file with breached password SHA-1 hashes"},
+			cli.StringFlag{Name: "breached-prefix", Usage: "hash prefix, if the breached file is a range of hash suffixes"},
+			cli.IntFlag{Name: "stale-days", Usage: "days since updated a password is stale", Value: 365},
+			cli.IntFlag{Name: "min-entropy", Usage: "minimum password entropy (bits)", Value: 60},
+			cli.StringFlag{Name: "collection", Usage: "shared collection"},
+		},
+		Action: func(c *cli.Context) error {
+			breached := c.String("breached")
+			if breached != "" {
+				// The service may have a different working directory.
+				path, err := filepath.Abs(breached)
+				if err != nil {
+					return err
+				}
+				breached = path
+			}
+			resp, err := client.KeysClient().SecretsAudit(context.TODO(), &SecretsAuditRequest{
+				Breached:       breached,
+				BreachedPrefix: c.String("breached-prefix"),
+				StaleDays:      int32(c.Int("stale-days")),
+				MinEntropy:     int32(c.Int("min-entropy")),
+				Collection:     c.String("collection"),
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.Findings) == 0 {
+				fmt.Println("No issues found.")
+				return nil
+			}
+			fmtSecretsAudit(resp.Findings)
+			return nil
+		},
+	}
+}
+
+func fmtSecretsAudit(findings []*SecretsAuditFinding) {
+	out := &bytes.Buffer{}
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 8, 1, ' ', 0)
+	severity := ""
+	for _, f := range findings {
+		if f.Severity != severity {
+			if severity != "" {
+				fmt.Fprintln(w)
+			}
+			severity = f.Severity
+			fmt.Fprintf(w, "%s:\n", severity)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", f.Name, f.Issue, f.Detail)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+	fmt.Print(out.String())
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func secretExportCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "export",
+		Usage: "Export secrets",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "format, f", Usage: "format: json (encrypted) or csv (plaintext)", Value: "json"},
+			cli.StringSliceFlag{Name: "recipient, r", Usage: "recipients (json)"},
+			cli.StringFlag{Name: "sender, signer, s", Usage: "signer (or anonymous)"},
+			cli.BoolFlag{Name: "armor, a", Usage: "armored"},
+			cli.StringSliceFlag{Name: "type, t", Usage: "types to export: password, note, otp"},
+			cli.StringFlag{Name: "name, n", Usage: "only export secrets with name matching"},
+			cli.StringFlag{Name: "collection", Usage: "shared collection"},
+			cli.StringFlag{Name: "out, o", Usage: "file to write (or stdout)"},
+			cli.BoolFlag{Name: "plaintext", Usage: "confirm plaintext export (csv)"},
+		},
+		Action: func(c *cli.Context) error {
+			types := []SecretType{}
+			for _, s := range c.StringSlice("type") {
+				typ, err := secretTypeFromString(s)
+				if err != nil {
+					return err
+				}
+				types = append(types, typ)
+			}
+			resp, err := client.KeysClient().SecretsExport(context.TODO(), &SecretsExportRequest{
+				Format:     c.String("format"),
+				Recipients: c.StringSlice("recipient"),
+				Sender:     c.String("sender"),
+				Options:    &EncryptOptions{Armored: c.Bool("armor")},
+				Types:      types,
+				Name:       c.String("name"),
+				Collection: c.String("collection"),
+				Plaintext:  c.Bool("plaintext"),
+			})
+			if err != nil {
+				return err
+			}
+			if out := c.String("out"); out != "" {
+				if err := ioutil.WriteFile(out, resp.Data, 0600); err != nil {
+					return err
+				}
+				fmt.Fprintf(os.Stderr, "Exported %d secrets.\n", resp.Count)
+				return nil
+			}
+			_, err = os.Stdout.Write(resp.Data)
+			return err
+		},
+	}
+}
+
+func secretTypeFromString(s string) (SecretType, error) {
+	switch s {
+	case "password":
+		return PasswordSecret, nil
+	case "note":
+		return NoteSecret, nil
+	case "otp":
+		return OTPSecret, nil
+	default:
+		return UnknownSecret, errors.Errorf("invalid secret type %q", s)
+	}
+}
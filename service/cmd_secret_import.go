@@ -0,0 +1,90 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func secretImportCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "import",
+		Usage: "Import secrets from another password manager",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "format, f", Usage: "format: bitwarden (json), 1password (csv), keepass (xml), csv"},
+			cli.StringFlag{Name: "in, i", Usage: "file to read"},
+			cli.StringFlag{Name: "collection", Usage: "shared collection"},
+			cli.BoolFlag{Name: "dry-run", Usage: "show what would be imported"},
+			cli.BoolFlag{Name: "force", Usage: "import without confirming"},
+		},
+		Action: func(c *cli.Context) error {
+			format := c.String("format")
+			if format == "" {
+				return errors.Errorf("no format specified")
+			}
+			if c.String("in") == "" {
+				return errors.Errorf("no file specified")
+			}
+			path, err := filepath.Abs(c.String("in"))
+			if err != nil {
+				return err
+			}
+			b, err := ioutil.ReadFile(path) // #nosec
+			if err != nil {
+				return err
+			}
+
+			req := &SecretImportRequest{
+				Format:     format,
+				In:         b,
+				DryRun:     true,
+				Collection: c.String("collection"),
+			}
+			check, err := client.KeysClient().SecretImport(context.TODO(), req)
+			if err != nil {
+				return err
+			}
+			for _, secret := range check.Skipped {
+				fmt.Printf("skip %s (already exists)\n", secretDisplayName(secret))
+			}
+			for _, secret := range check.Added {
+				fmt.Printf("add  %s\n", secretDisplayName(secret))
+			}
+			fmt.Printf("%d to import, %d skipped\n", len(check.Added), len(check.Skipped))
+			if c.Bool("dry-run") || len(check.Added) == 0 {
+				return nil
+			}
+
+			if !c.Bool("force") {
+				fmt.Printf("Import %d secrets? [y/N] ", len(check.Added))
+				text, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+				if strings.ToLower(strings.TrimSpace(text)) != "y" {
+					fmt.Println("Import cancelled.")
+					return nil
+				}
+			}
+
+			req.DryRun = false
+			resp, err := client.KeysClient().SecretImport(context.TODO(), req)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Imported %d secrets.\n", len(resp.Added))
+			return nil
+		},
+	}
+}
+
+func secretDisplayName(secret *Secret) string {
+	if secret.URL == "" {
+		return secret.Name
+	}
+	return fmt.Sprintf("%s (%s)", secret.Name, secret.URL)
+}
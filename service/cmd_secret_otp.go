@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg" // JPEG QR codes
+	_ "image/png"  // PNG QR codes
+	"os"
+	"strings"
+	"time"
+
+	"github.com/makiuchi-d/gozxing"
+	"github.com/makiuchi-d/gozxing/qrcode"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func secretOTPCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:      "otp",
+		Usage:     "Show one time password (TOTP/HOTP) code",
+		ArgsUsage: "name",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "id", Usage: "secret id"},
+			cli.StringFlag{Name: "collection", Usage: "shared collection"},
+		},
+		Subcommands: []cli.Command{
+			cli.Command{
+				Name:  "add",
+				Usage: "Add a one time password secret",
+				Flags: []cli.Flag{
+					cli.StringFlag{Name: "name, n", Usage: "name"},
+					cli.StringFlag{Name: "uri", Usage: "otpauth:// URI"},
+					cli.StringFlag{Name: "qr", Usage: "QR code image (PNG or JPEG) with an otpauth:// URI"},
+					cli.StringFlag{Name: "collection", Usage: "shared collection"},
+				},
+				Action: func(c *cli.Context) error {
+					uri := c.String("uri")
+					if path := c.String("qr"); path != "" {
+						if uri != "" {
+							return errors.Errorf("specify --uri or --qr, not both")
+						}
+						text, err := readQRCode(path)
+						if err != nil {
+							return err
+						}
+						uri = text
+					}
+					if uri == "" {
+						return errors.Errorf("no uri or qr specified")
+					}
+					name := c.String("name")
+					if name == "" {
+						return errors.Errorf("no name specified")
+					}
+					resp, err := client.KeysClient().SecretSave(context.TODO(), &SecretSaveRequest{
+						Secret: &Secret{
+							Name: name,
+							Type: OTPSecret,
+							OTP:  strings.TrimSpace(uri),
+						},
+						Collection: c.String("collection"),
+					})
+					if err != nil {
+						return err
+					}
+					fmt.Println(resp.Secret.ID)
+					return nil
+				},
+			},
+		},
+		Action: func(c *cli.Context) error {
+			req := &SecretOTPRequest{
+				ID:         c.String("id"),
+				Name:       c.Args().First(),
+				Collection: c.String("collection"),
+			}
+			if req.ID == "" && req.Name == "" {
+				return errors.Errorf("no name specified")
+			}
+			resp, err := client.KeysClient().SecretOTP(context.TODO(), req)
+			if err != nil {
+				return err
+			}
+			if resp.Remaining > 0 {
+				remaining := time.Duration(resp.Remaining) * time.Millisecond
+				fmt.Printf("%s (%s)\n", resp.Code, remaining.Round(time.Second))
+				return nil
+			}
+			fmt.Println(resp.Code)
+			return nil
+		},
+	}
+}
+
+// readQRCode reads text from a QR code image.
+func readQRCode(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read image")
+	}
+	bmp, err := gozxing.NewBinaryBitmapFromImage(img)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read image")
+	}
+	result, err := qrcode.NewQRCodeReader().Decode(bmp, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read QR code")
+	}
+	return result.GetText(), nil
+}
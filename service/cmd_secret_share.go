@@ -0,0 +1,81 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func secretShareCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:      "share",
+		Usage:     "Share a secret (single use, expiring)",
+		ArgsUsage: "name",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "id", Usage: "secret id"},
+			cli.StringFlag{Name: "expire", Usage: "expire, for example, 10m (max 15m)", Value: "15m"},
+			cli.StringFlag{Name: "collection", Usage: "shared collection"},
+		},
+		Action: func(c *cli.Context) error {
+			req := &SecretShareRequest{
+				ID:         c.String("id"),
+				Name:       c.Args().First(),
+				Collection: c.String("collection"),
+				Expire:     c.String("expire"),
+			}
+			if req.ID == "" && req.Name == "" {
+				return errors.Errorf("no name specified")
+			}
+			resp, err := client.KeysClient().SecretShare(context.TODO(), req)
+			if err != nil {
+				return err
+			}
+			fmt.Println(resp.Phrase)
+			expire := time.Until(tsutil.ConvertMillis(resp.Expire)).Round(time.Second)
+			fmt.Fprintf(os.Stderr, "The phrase can be used once, and expires in %s.\n", expire)
+			fmt.Fprintf(os.Stderr, "To receive: keys secret receive\n")
+			return nil
+		},
+	}
+}
+
+func secretReceiveCommand(client *Client) cli.Command {
+	return cli.Command{
+		Name:  "receive",
+		Usage: "Receive a shared secret",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "phrase", Usage: "phrase from secret share"},
+			cli.BoolFlag{Name: "save", Usage: "save to vault"},
+			cli.StringFlag{Name: "collection", Usage: "shared collection (to save to)"},
+		},
+		Action: func(c *cli.Context) error {
+			phrase := c.String("phrase")
+			if phrase == "" {
+				reader := bufio.NewReader(os.Stdin)
+				fmt.Fprintf(os.Stderr, "Share phrase: ")
+				in, err := reader.ReadString('\n')
+				if err != nil {
+					return err
+				}
+				phrase = in
+			}
+			resp, err := client.KeysClient().SecretReceive(context.TODO(), &SecretReceiveRequest{
+				Phrase:     strings.TrimSpace(phrase),
+				Save:       c.Bool("save"),
+				Collection: c.String("collection"),
+			})
+			if err != nil {
+				return err
+			}
+			printMessage(resp.Secret)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func sharedCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "shared",
+			Usage: "Shared collections",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:  "list",
+					Usage: "List shared collections",
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().SharedCollections(context.TODO(), &SharedCollectionsRequest{})
+						if err != nil {
+							return err
+						}
+						printMessage(resp)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "create",
+					Usage: "Create a shared collection",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "name, n", Usage: "name"},
+						cli.StringFlag{Name: "user, u", Usage: "user (key or user@service)"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().SharedCollectionCreate(context.TODO(), &SharedCollectionCreateRequest{
+							Name: c.String("name"),
+							User: c.String("user"),
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp.Collection)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "invite",
+					Usage: "Invite users to a shared collection",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "collection, c", Usage: "collection"},
+						cli.StringFlag{Name: "sender, s", Usage: "sender (key or user@service)"},
+						cli.StringSliceFlag{Name: "recipient, r", Usage: "recipients (key or user@service)"},
+					},
+					Action: func(c *cli.Context) error {
+						_, err := client.KeysClient().SharedCollectionInvite(context.TODO(), &SharedCollectionInviteRequest{
+							Collection: c.String("collection"),
+							Sender:     c.String("sender"),
+							Recipients: c.StringSlice("recipient"),
+						})
+						return err
+					},
+				},
+				cli.Command{
+					Name:  "invites",
+					Usage: "List invites to shared collections",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "user, u", Usage: "user (key or user@service)"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().SharedCollectionInvites(context.TODO(), &SharedCollectionInvitesRequest{
+							User: c.String("user"),
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "accept",
+					Usage: "Accept an invite to a shared collection",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "collection, c", Usage: "collection"},
+						cli.StringFlag{Name: "user, u", Usage: "user (key or user@service)"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().SharedCollectionAccept(context.TODO(), &SharedCollectionAcceptRequest{
+							Collection: c.String("collection"),
+							User:       c.String("user"),
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp.Collection)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "members",
+					Usage: "List members of a shared collection",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "collection, c", Usage: "collection"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().SharedCollectionMembers(context.TODO(), &SharedCollectionMembersRequest{
+							Collection: c.String("collection"),
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "remove",
+					Usage: "Remove a member from a shared collection (rotates the collection key)",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "collection, c", Usage: "collection"},
+						cli.StringFlag{Name: "sender, s", Usage: "sender (key or user@service)"},
+						cli.StringFlag{Name: "member, m", Usage: "member (key or user@service)"},
+					},
+					Action: func(c *cli.Context) error {
+						if c.String("member") == "" {
+							return errors.Errorf("specify -member")
+						}
+						resp, err := client.KeysClient().SharedCollectionRemoveMember(context.TODO(), &SharedCollectionRemoveMemberRequest{
+							Collection: c.String("collection"),
+							Sender:     c.String("sender"),
+							Member:     c.String("member"),
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp.Collection)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "leave",
+					Usage: "Leave a shared collection",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "collection, c", Usage: "collection"},
+						cli.StringFlag{Name: "user, u", Usage: "user (key or user@service)"},
+					},
+					Action: func(c *cli.Context) error {
+						_, err := client.KeysClient().SharedCollectionLeave(context.TODO(), &SharedCollectionLeaveRequest{
+							Collection: c.String("collection"),
+							User:       c.String("user"),
+						})
+						return err
+					},
+				},
+				cli.Command{
+					Name:  "sync",
+					Usage: "Sync a shared collection",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "collection, c", Usage: "collection"},
+					},
+					Action: func(c *cli.Context) error {
+						_, err := client.KeysClient().SharedCollectionSync(context.TODO(), &SharedCollectionSyncRequest{
+							Collection: c.String("collection"),
+						})
+						return err
+					},
+				},
+				cli.Command{
+					Name:  "secrets",
+					Usage: "List secrets in a shared collection",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "collection, c", Usage: "collection"},
+						cli.StringFlag{Name: "query, q", Usage: "query"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.KeysClient().Secrets(context.TODO(), &SecretsRequest{
+							Collection: c.String("collection"),
+							Query:      c.String("query"),
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp)
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
@@ -3,7 +3,13 @@ package service
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 )
 
@@ -16,14 +22,89 @@ func vaultCommands(client *Client) []cli.Command {
 				cli.Command{
 					Name:  "sync",
 					Usage: "Sync vault",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "url", Usage: "keys server to sync to (if not synced yet)"},
+					},
+					Action: func(c *cli.Context) error {
+						syncClient, err := client.KeysClient().VaultSyncProgress(context.TODO(), &VaultSyncProgressRequest{URL: c.String("url")})
+						if err != nil {
+							return err
+						}
+						for {
+							resp, err := syncClient.Recv()
+							if err != nil {
+								return err
+							}
+							if resp.Done {
+								return nil
+							}
+							fmt.Fprintln(os.Stderr, vaultSyncProgressString(resp))
+						}
+					},
+				},
+				cli.Command{
+					Name:  "status",
+					Usage: "Vault sync status",
 					Action: func(c *cli.Context) error {
-						_, err := client.KeysClient().VaultSync(context.TODO(), &VaultSyncRequest{})
+						resp, err := client.KeysClient().VaultStatus(context.TODO(), &VaultStatusRequest{})
 						if err != nil {
 							return err
 						}
+						for _, remote := range resp.Remotes {
+							name := remote.Name
+							if name == "" {
+								name = "(primary)"
+							}
+							synced := "never"
+							if remote.SyncedAt != 0 {
+								synced = tsutil.ConvertMillis(remote.SyncedAt).Format(time.RFC3339)
+							}
+							fmt.Printf("%s %s %s %s\n", name, remote.URL, remote.KID, synced)
+							if remote.Error != "" {
+								fmt.Printf("  error: %s\n", remote.Error)
+							}
+						}
 						return nil
 					},
 				},
+				cli.Command{
+					Name:  "remote",
+					Usage: "Additional remotes",
+					Subcommands: []cli.Command{
+						cli.Command{
+							Name:  "add",
+							Usage: "Add a remote",
+							Flags: []cli.Flag{
+								cli.StringFlag{Name: "name, n", Usage: "name"},
+								cli.StringFlag{Name: "url", Usage: "keys server URL"},
+							},
+							Action: func(c *cli.Context) error {
+								resp, err := client.KeysClient().VaultRemoteAdd(context.TODO(), &VaultRemoteAddRequest{
+									Name: c.String("name"),
+									URL:  c.String("url"),
+								})
+								if err != nil {
+									return err
+								}
+								fmt.Printf("%s %s %s\n", resp.Remote.Name, resp.Remote.URL, resp.Remote.KID)
+								return nil
+							},
+						},
+						cli.Command{
+							Name:  "remove",
+							Usage: "Remove a remote",
+							Flags: []cli.Flag{
+								cli.StringFlag{Name: "name, n", Usage: "name"},
+							},
+							Action: func(c *cli.Context) error {
+								_, err := client.KeysClient().VaultRemoteRemove(context.TODO(), &VaultRemoteRemoveRequest{
+									Name: c.String("name"),
+								})
+								return err
+							},
+						},
+					},
+				},
 				cli.Command{
 					Name:  "auth",
 					Usage: "Vault auth (single use, expiring)",
@@ -36,7 +117,122 @@ func vaultCommands(client *Client) []cli.Command {
 						return nil
 					},
 				},
+				cli.Command{
+					Name:  "backup",
+					Usage: "Backup vault to an (encrypted) archive",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "out, o", Usage: "file to write to"},
+					},
+					Action: func(c *cli.Context) error {
+						out := c.String("out")
+						if out == "" {
+							return errors.Errorf("specify -out")
+						}
+						path, err := filepath.Abs(out)
+						if err != nil {
+							return err
+						}
+						resp, err := client.KeysClient().VaultBackup(context.TODO(), &VaultBackupRequest{Path: path})
+						if err != nil {
+							return err
+						}
+						fmt.Println(resp.Path)
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "restore",
+					Usage: "Restore vault from a backup archive (into an empty vault)",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "in, i", Usage: "backup archive"},
+						cli.StringFlag{Name: "password", Usage: "password (from when the backup was made)"},
+						cli.StringFlag{Name: "paper-key", Usage: "paper key"},
+						cli.BoolFlag{Name: "dry-run", Usage: "show what would be restored"},
+					},
+					Action: func(c *cli.Context) error {
+						in := c.String("in")
+						if in == "" {
+							return errors.Errorf("specify -in")
+						}
+						path, err := filepath.Abs(in)
+						if err != nil {
+							return err
+						}
+						req := &VaultRestoreRequest{Path: path, DryRun: c.Bool("dry-run")}
+						if c.String("paper-key") != "" {
+							req.Type = PaperKeyAuth
+							req.Secret = c.String("paper-key")
+						} else {
+							password := c.String("password")
+							if password == "" {
+								p, err := readPassword("Password:", false)
+								if err != nil {
+									return err
+								}
+								password = p
+							}
+							req.Type = PasswordAuth
+							req.Secret = password
+						}
+						resp, err := client.KeysClient().VaultRestore(context.TODO(), req)
+						if err != nil {
+							return err
+						}
+						for _, p := range resp.Paths {
+							fmt.Println(p)
+						}
+						return nil
+					},
+				},
+				cli.Command{
+					Name:  "events",
+					Usage: "Stream vault events",
+					Action: func(c *cli.Context) error {
+						stream, err := client.KeysClient().VaultEvents(context.TODO(), &VaultEventsRequest{})
+						if err != nil {
+							return err
+						}
+						for {
+							event, err := stream.Recv()
+							if err == io.EOF {
+								return nil
+							}
+							if err != nil {
+								return err
+							}
+							switch {
+							case event.Error != "":
+								fmt.Printf("%s %s\n", event.Type, event.Error)
+							case event.ID != "":
+								fmt.Printf("%s %s\n", event.Type, event.ID)
+							default:
+								fmt.Println(event.Type)
+							}
+						}
+					},
+				},
 			},
 		},
 	}
 }
+
+func vaultSyncProgressString(p *VaultSyncProgressOutput) string {
+	s := fmt.Sprintf("Pulled %d events (%s), %d/%d", p.Events, bytesString(p.Bytes), p.Index, p.Max)
+	if p.Remaining > 0 {
+		s += fmt.Sprintf(", about %s remaining", (time.Duration(p.Remaining) * time.Millisecond).Round(time.Second))
+	}
+	return s
+}
+
+func bytesString(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -32,5 +32,8 @@ func (s *service) ConfigSet(ctx context.Context, req *ConfigSetRequest) (*Config
 	if err := s.db.Set(ctx, path, dstore.From(req.Config)); err != nil {
 		return nil, err
 	}
+	if req.Name == "lock" {
+		s.updateAutoLock(ctx)
+	}
 	return &ConfigSetResponse{}, nil
 }
@@ -80,34 +80,32 @@ func (s *service) Encrypt(ctx context.Context, req *EncryptRequest) (*EncryptRes
 	if err != nil {
 		return nil, err
 	}
+	out, err := s.encryptData(req.Data, enc)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptResponse{
+		Data: out,
+	}, nil
+}
 
-	var out []byte
+func (s *service) encryptData(b []byte, enc *encrypt) ([]byte, error) {
 	switch enc.mode {
 	case SaltpackEncrypt:
 		sbk, err := s.x25519Key(enc.sender)
 		if err != nil {
 			return nil, err
 		}
-		out, err = saltpack.Encrypt(req.Data, enc.armored, sbk, enc.recipients...)
-		if err != nil {
-			return nil, err
-		}
+		return saltpack.Encrypt(b, enc.armored, sbk, enc.recipients...)
 	case SaltpackSigncrypt:
 		sk, err := s.edx25519Key(enc.sender)
 		if err != nil {
 			return nil, err
 		}
-		out, err = saltpack.Signcrypt(req.Data, enc.armored, sk, enc.recipients...)
-		if err != nil {
-			return nil, err
-		}
+		return saltpack.Signcrypt(b, enc.armored, sk, enc.recipients...)
 	default:
 		return nil, errors.Errorf("unsupported mode %s", enc.mode)
 	}
-
-	return &EncryptResponse{
-		Data: out,
-	}, nil
 }
 
 func (s *service) encryptWriteInOut(ctx context.Context, in string, out string, enc *encrypt) error {
@@ -23,6 +23,7 @@ require (
 	github.com/keys-pub/keys-ext/ws/api v0.0.0-20201124171340-f41427119d82
 	github.com/keys-pub/keys-ext/ws/client v0.0.0-20201124173412-72095c733b73
 	github.com/konsorten/go-windows-terminal-sequences v1.0.3 // indirect
+	github.com/makiuchi-d/gozxing v0.0.0-20190830103442-eaff64b1ceb7
 	github.com/mercari/go-grpc-interceptor v0.0.0-20180110035004-b8ad3827e82a
 	github.com/mitchellh/go-ps v1.0.0
 	github.com/pion/sctp v1.7.11 // indirect
@@ -50,16 +51,16 @@ require (
 
 // replace github.com/keys-pub/keys-ext/auth/mock => ../auth/mock
 
-// replace github.com/keys-pub/keys-ext/http/api => ../http/api
+replace github.com/keys-pub/keys-ext/http/api => ../http/api
 
-// replace github.com/keys-pub/keys-ext/http/client => ../http/client
+replace github.com/keys-pub/keys-ext/http/client => ../http/client
 
-// replace github.com/keys-pub/keys-ext/http/server => ../http/server
+replace github.com/keys-pub/keys-ext/http/server => ../http/server
 
-// replace github.com/keys-pub/keys-ext/vault => ../vault
+replace github.com/keys-pub/keys-ext/vault => ../vault
 
 // replace github.com/keys-pub/keys-ext/wormhole => ../wormhole
 
-// replace github.com/keys-pub/keys-ext/ws/api => ../ws/api
+replace github.com/keys-pub/keys-ext/ws/api => ../ws/api
 
 // replace github.com/keys-pub/keys-ext/ws/client => ../ws/client
@@ -141,6 +141,8 @@ const (
 	PasswordAuth AuthType = 10
 	// PaperKeyAuth uses a BIP39 phrase representing a key.
 	PaperKeyAuth AuthType = 11
+	// KeyFileAuth uses a key (32 bytes) from a file.
+	KeyFileAuth AuthType = 12
 	// FIDO2HMACSecretAuth uses a FIDO2 HMAC-Secret extension.
 	FIDO2HMACSecretAuth AuthType = 20
 )
@@ -151,12 +153,14 @@ var (
 		0:  "UNKNOWN_AUTH",
 		10: "PASSWORD_AUTH",
 		11: "PAPER_KEY_AUTH",
+		12: "KEY_FILE_AUTH",
 		20: "FIDO2_HMAC_SECRET_AUTH",
 	}
 	AuthType_value = map[string]int32{
 		"UNKNOWN_AUTH":           0,
 		"PASSWORD_AUTH":          10,
 		"PAPER_KEY_AUTH":         11,
+		"KEY_FILE_AUTH":          12,
 		"FIDO2_HMAC_SECRET_AUTH": 20,
 	}
 )
@@ -291,6 +295,7 @@ const (
 	ContactSecret  SecretType = 11
 	CardSecret     SecretType = 12
 	NoteSecret     SecretType = 13
+	OTPSecret      SecretType = 14
 )
 
 // Enum value maps for SecretType.
@@ -301,6 +306,7 @@ var (
 		11: "CONTACT_SECRET",
 		12: "CARD_SECRET",
 		13: "NOTE_SECRET",
+		14: "OTP_SECRET",
 	}
 	SecretType_value = map[string]int32{
 		"UNKNOWN_SECRET_TYPE": 0,
@@ -308,6 +314,7 @@ var (
 		"CONTACT_SECRET":      11,
 		"CARD_SECRET":         12,
 		"NOTE_SECRET":         13,
+		"OTP_SECRET":          14,
 	}
 )
 
@@ -338,6 +345,58 @@ func (SecretType) EnumDescriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{5}
 }
 
+type SecretFieldType int32
+
+const (
+	TextField   SecretFieldType = 0
+	HiddenField SecretFieldType = 1
+	URLField    SecretFieldType = 2
+	DateField   SecretFieldType = 3
+)
+
+// Enum value maps for SecretFieldType.
+var (
+	SecretFieldType_name = map[int32]string{
+		0: "TEXT_FIELD",
+		1: "HIDDEN_FIELD",
+		2: "URL_FIELD",
+		3: "DATE_FIELD",
+	}
+	SecretFieldType_value = map[string]int32{
+		"TEXT_FIELD":   0,
+		"HIDDEN_FIELD": 1,
+		"URL_FIELD":    2,
+		"DATE_FIELD":   3,
+	}
+)
+
+func (x SecretFieldType) Enum() *SecretFieldType {
+	p := new(SecretFieldType)
+	*p = x
+	return p
+}
+
+func (x SecretFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecretFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_keys_proto_enumTypes[6].Descriptor()
+}
+
+func (SecretFieldType) Type() protoreflect.EnumType {
+	return &file_keys_proto_enumTypes[6]
+}
+
+func (x SecretFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecretFieldType.Descriptor instead.
+func (SecretFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{6}
+}
+
 type Encoding int32
 
 const (
@@ -386,11 +445,11 @@ func (x Encoding) String() string {
 }
 
 func (Encoding) Descriptor() protoreflect.EnumDescriptor {
-	return file_keys_proto_enumTypes[6].Descriptor()
+	return file_keys_proto_enumTypes[7].Descriptor()
 }
 
 func (Encoding) Type() protoreflect.EnumType {
-	return &file_keys_proto_enumTypes[6]
+	return &file_keys_proto_enumTypes[7]
 }
 
 func (x Encoding) Number() protoreflect.EnumNumber {
@@ -399,7 +458,7 @@ func (x Encoding) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Encoding.Descriptor instead.
 func (Encoding) EnumDescriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{6}
+	return file_keys_proto_rawDescGZIP(), []int{7}
 }
 
 type UserStatus int32
@@ -447,11 +506,11 @@ func (x UserStatus) String() string {
 }
 
 func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_keys_proto_enumTypes[7].Descriptor()
+	return file_keys_proto_enumTypes[8].Descriptor()
 }
 
 func (UserStatus) Type() protoreflect.EnumType {
-	return &file_keys_proto_enumTypes[7]
+	return &file_keys_proto_enumTypes[8]
 }
 
 func (x UserStatus) Number() protoreflect.EnumNumber {
@@ -460,7 +519,122 @@ func (x UserStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserStatus.Descriptor instead.
 func (UserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{7}
+	return file_keys_proto_rawDescGZIP(), []int{8}
+}
+
+type ConflictResolution int32
+
+const (
+	LastWriterWins ConflictResolution = 0
+	KeepBoth       ConflictResolution = 1
+	// MERGE fields (secrets only).
+	Merge ConflictResolution = 2
+)
+
+// Enum value maps for ConflictResolution.
+var (
+	ConflictResolution_name = map[int32]string{
+		0: "LAST_WRITER_WINS",
+		1: "KEEP_BOTH",
+		2: "MERGE",
+	}
+	ConflictResolution_value = map[string]int32{
+		"LAST_WRITER_WINS": 0,
+		"KEEP_BOTH":        1,
+		"MERGE":            2,
+	}
+)
+
+func (x ConflictResolution) Enum() *ConflictResolution {
+	p := new(ConflictResolution)
+	*p = x
+	return p
+}
+
+func (x ConflictResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_keys_proto_enumTypes[9].Descriptor()
+}
+
+func (ConflictResolution) Type() protoreflect.EnumType {
+	return &file_keys_proto_enumTypes[9]
+}
+
+func (x ConflictResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictResolution.Descriptor instead.
+func (ConflictResolution) EnumDescriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{9}
+}
+
+type VaultEventType int32
+
+const (
+	UnspecifiedVaultEvent VaultEventType = 0
+	UnlockVaultEvent      VaultEventType = 1
+	LockVaultEvent        VaultEventType = 2
+	SetVaultEvent         VaultEventType = 3
+	DeleteVaultEvent      VaultEventType = 4
+	SyncStartVaultEvent   VaultEventType = 5
+	SyncFinishVaultEvent  VaultEventType = 6
+	// VAULT_EVENT_PULL is an item change pulled from the remote.
+	PullVaultEvent VaultEventType = 7
+)
+
+// Enum value maps for VaultEventType.
+var (
+	VaultEventType_name = map[int32]string{
+		0: "VAULT_EVENT_UNSPECIFIED",
+		1: "VAULT_EVENT_UNLOCK",
+		2: "VAULT_EVENT_LOCK",
+		3: "VAULT_EVENT_SET",
+		4: "VAULT_EVENT_DELETE",
+		5: "VAULT_EVENT_SYNC_START",
+		6: "VAULT_EVENT_SYNC_FINISH",
+		7: "VAULT_EVENT_PULL",
+	}
+	VaultEventType_value = map[string]int32{
+		"VAULT_EVENT_UNSPECIFIED": 0,
+		"VAULT_EVENT_UNLOCK":      1,
+		"VAULT_EVENT_LOCK":        2,
+		"VAULT_EVENT_SET":         3,
+		"VAULT_EVENT_DELETE":      4,
+		"VAULT_EVENT_SYNC_START":  5,
+		"VAULT_EVENT_SYNC_FINISH": 6,
+		"VAULT_EVENT_PULL":        7,
+	}
+)
+
+func (x VaultEventType) Enum() *VaultEventType {
+	p := new(VaultEventType)
+	*p = x
+	return p
+}
+
+func (x VaultEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VaultEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_keys_proto_enumTypes[10].Descriptor()
+}
+
+func (VaultEventType) Type() protoreflect.EnumType {
+	return &file_keys_proto_enumTypes[10]
+}
+
+func (x VaultEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VaultEventType.Descriptor instead.
+func (VaultEventType) EnumDescriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{10}
 }
 
 type MessageStatus int32
@@ -493,11 +667,11 @@ func (x MessageStatus) String() string {
 }
 
 func (MessageStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_keys_proto_enumTypes[8].Descriptor()
+	return file_keys_proto_enumTypes[11].Descriptor()
 }
 
 func (MessageStatus) Type() protoreflect.EnumType {
-	return &file_keys_proto_enumTypes[8]
+	return &file_keys_proto_enumTypes[11]
 }
 
 func (x MessageStatus) Number() protoreflect.EnumNumber {
@@ -506,7 +680,7 @@ func (x MessageStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageStatus.Descriptor instead.
 func (MessageStatus) EnumDescriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{8}
+	return file_keys_proto_rawDescGZIP(), []int{11}
 }
 
 type NotificationType int32
@@ -515,6 +689,7 @@ const (
 	UnspecifiedNotification    NotificationType = 0
 	ChannelCreatedNotification NotificationType = 1
 	ChannelMessageNotification NotificationType = 2
+	SecretExpiringNotification NotificationType = 3
 )
 
 // Enum value maps for NotificationType.
@@ -523,11 +698,13 @@ var (
 		0: "NOTIFICATION_UNSPECIFIED",
 		1: "NOTIFICATION_CHANNEL_CREATED",
 		2: "NOTIFICATION_CHANNEL_MESSAGE",
+		3: "NOTIFICATION_SECRET_EXPIRING",
 	}
 	NotificationType_value = map[string]int32{
 		"NOTIFICATION_UNSPECIFIED":     0,
 		"NOTIFICATION_CHANNEL_CREATED": 1,
 		"NOTIFICATION_CHANNEL_MESSAGE": 2,
+		"NOTIFICATION_SECRET_EXPIRING": 3,
 	}
 )
 
@@ -542,11 +719,11 @@ func (x NotificationType) String() string {
 }
 
 func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_keys_proto_enumTypes[9].Descriptor()
+	return file_keys_proto_enumTypes[12].Descriptor()
 }
 
 func (NotificationType) Type() protoreflect.EnumType {
-	return &file_keys_proto_enumTypes[9]
+	return &file_keys_proto_enumTypes[12]
 }
 
 func (x NotificationType) Number() protoreflect.EnumNumber {
@@ -555,7 +732,7 @@ func (x NotificationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NotificationType.Descriptor instead.
 func (NotificationType) EnumDescriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{9}
+	return file_keys_proto_rawDescGZIP(), []int{12}
 }
 
 type WormholeStatus int32
@@ -609,11 +786,11 @@ func (x WormholeStatus) String() string {
 }
 
 func (WormholeStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_keys_proto_enumTypes[10].Descriptor()
+	return file_keys_proto_enumTypes[13].Descriptor()
 }
 
 func (WormholeStatus) Type() protoreflect.EnumType {
-	return &file_keys_proto_enumTypes[10]
+	return &file_keys_proto_enumTypes[13]
 }
 
 func (x WormholeStatus) Number() protoreflect.EnumNumber {
@@ -622,7 +799,7 @@ func (x WormholeStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WormholeStatus.Descriptor instead.
 func (WormholeStatus) EnumDescriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{10}
+	return file_keys_proto_rawDescGZIP(), []int{13}
 }
 
 type WormholeMessageStatus int32
@@ -658,11 +835,11 @@ func (x WormholeMessageStatus) String() string {
 }
 
 func (WormholeMessageStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_keys_proto_enumTypes[11].Descriptor()
+	return file_keys_proto_enumTypes[14].Descriptor()
 }
 
 func (WormholeMessageStatus) Type() protoreflect.EnumType {
-	return &file_keys_proto_enumTypes[11]
+	return &file_keys_proto_enumTypes[14]
 }
 
 func (x WormholeMessageStatus) Number() protoreflect.EnumNumber {
@@ -671,7 +848,7 @@ func (x WormholeMessageStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WormholeMessageStatus.Descriptor instead.
 func (WormholeMessageStatus) EnumDescriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{11}
+	return file_keys_proto_rawDescGZIP(), []int{14}
 }
 
 type SignRequest struct {
@@ -3076,6 +3253,9 @@ type RuntimeStatusResponse struct {
 	Sync bool `protobuf:"varint,6,opt,name=sync,proto3" json:"sync,omitempty"`
 	// FIDO2 available.
 	FIDO2 bool `protobuf:"varint,20,opt,name=fido2,proto3" json:"fido2,omitempty"`
+	// LockIn is the time (ms) until auto-lock, or 0 if there is no auto-lock
+	// timeout (or locked).
+	LockIn int64 `protobuf:"varint,21,opt,name=lockIn,proto3" json:"lockIn,omitempty"`
 }
 
 func (x *RuntimeStatusResponse) Reset() {
@@ -3152,6 +3332,13 @@ func (x *RuntimeStatusResponse) GetFIDO2() bool {
 	return false
 }
 
+func (x *RuntimeStatusResponse) GetLockIn() int64 {
+	if x != nil {
+		return x.LockIn
+	}
+	return 0
+}
+
 type AuthSetupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3163,6 +3350,8 @@ type AuthSetupRequest struct {
 	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=keys.AuthType" json:"type,omitempty"`
 	// Device path (for FIDO2).
 	Device string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	// Path hint (for key file).
+	Path string `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
 }
 
 func (x *AuthSetupRequest) Reset() {
@@ -3218,6 +3407,13 @@ func (x *AuthSetupRequest) GetDevice() string {
 	return ""
 }
 
+func (x *AuthSetupRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type AuthSetupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3352,6 +3548,9 @@ type AuthUnlockRequest struct {
 	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=keys.AuthType" json:"type,omitempty"`
 	// Client name.
 	Client string `protobuf:"bytes,6,opt,name=client,proto3" json:"client,omitempty"`
+	// Scopes for the token (keys:read, sign, encrypt, secrets:read,
+	// secrets:write, admin), or empty for full access.
+	Scopes []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
 func (x *AuthUnlockRequest) Reset() {
@@ -3407,6 +3606,13 @@ func (x *AuthUnlockRequest) GetClient() string {
 	return ""
 }
 
+func (x *AuthUnlockRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type AuthUnlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3468,6 +3674,8 @@ type AuthProvisionRequest struct {
 	Device string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
 	// Generate (for FIDO2 make credential).
 	Generate bool `protobuf:"varint,7,opt,name=generate,proto3" json:"generate,omitempty"`
+	// Path hint (for key file).
+	Path string `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
 }
 
 func (x *AuthProvisionRequest) Reset() {
@@ -3530,6 +3738,13 @@ func (x *AuthProvisionRequest) GetGenerate() bool {
 	return false
 }
 
+func (x *AuthProvisionRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type AuthProvisionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3755,22 +3970,18 @@ func (*AuthPasswordChangeResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{51}
 }
 
-type AuthProvision struct {
+type AuthRotateSecret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type      AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=keys.AuthType" json:"type,omitempty"`
-	CreatedAt int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	// For FIDO2
-	// AAGUID is a device "identifier" (only unique across batches for privacy reasons).
-	AAGUID string `protobuf:"bytes,100,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
-	NoPin  bool   `protobuf:"varint,101,opt,name=noPin,proto3" json:"noPin,omitempty"`
+	Type AuthType `protobuf:"varint,1,opt,name=type,proto3,enum=keys.AuthType" json:"type,omitempty"`
+	// Secret (password or paper key).
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *AuthProvision) Reset() {
-	*x = AuthProvision{}
+func (x *AuthRotateSecret) Reset() {
+	*x = AuthRotateSecret{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3778,13 +3989,13 @@ func (x *AuthProvision) Reset() {
 	}
 }
 
-func (x *AuthProvision) String() string {
+func (x *AuthRotateSecret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthProvision) ProtoMessage() {}
+func (*AuthRotateSecret) ProtoMessage() {}
 
-func (x *AuthProvision) ProtoReflect() protoreflect.Message {
+func (x *AuthRotateSecret) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3796,54 +4007,38 @@ func (x *AuthProvision) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthProvision.ProtoReflect.Descriptor instead.
-func (*AuthProvision) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthRotateSecret.ProtoReflect.Descriptor instead.
+func (*AuthRotateSecret) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AuthProvision) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *AuthProvision) GetType() AuthType {
+func (x *AuthRotateSecret) GetType() AuthType {
 	if x != nil {
 		return x.Type
 	}
 	return UnknownAuth
 }
 
-func (x *AuthProvision) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *AuthProvision) GetAAGUID() string {
+func (x *AuthRotateSecret) GetSecret() string {
 	if x != nil {
-		return x.AAGUID
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *AuthProvision) GetNoPin() bool {
-	if x != nil {
-		return x.NoPin
-	}
-	return false
-}
-
-type AuthProvisionsRequest struct {
+type AuthRotateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Secrets for each auth (password, paper keys).
+	Secrets []*AuthRotateSecret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Force to remove auths we don't have a secret for.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *AuthProvisionsRequest) Reset() {
-	*x = AuthProvisionsRequest{}
+func (x *AuthRotateRequest) Reset() {
+	*x = AuthRotateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3851,13 +4046,13 @@ func (x *AuthProvisionsRequest) Reset() {
 	}
 }
 
-func (x *AuthProvisionsRequest) String() string {
+func (x *AuthRotateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthProvisionsRequest) ProtoMessage() {}
+func (*AuthRotateRequest) ProtoMessage() {}
 
-func (x *AuthProvisionsRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthRotateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3869,21 +4064,36 @@ func (x *AuthProvisionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthProvisionsRequest.ProtoReflect.Descriptor instead.
-func (*AuthProvisionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthRotateRequest.ProtoReflect.Descriptor instead.
+func (*AuthRotateRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{53}
 }
 
-type AuthProvisionsResponse struct {
+func (x *AuthRotateRequest) GetSecrets() []*AuthRotateSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *AuthRotateRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type AuthRotateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provisions []*AuthProvision `protobuf:"bytes,1,rep,name=provisions,proto3" json:"provisions,omitempty"`
+	// KID for the (new) remote vault.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *AuthProvisionsResponse) Reset() {
-	*x = AuthProvisionsResponse{}
+func (x *AuthRotateResponse) Reset() {
+	*x = AuthRotateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3891,13 +4101,13 @@ func (x *AuthProvisionsResponse) Reset() {
 	}
 }
 
-func (x *AuthProvisionsResponse) String() string {
+func (x *AuthRotateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthProvisionsResponse) ProtoMessage() {}
+func (*AuthRotateResponse) ProtoMessage() {}
 
-func (x *AuthProvisionsResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthRotateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3909,26 +4119,37 @@ func (x *AuthProvisionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthProvisionsResponse.ProtoReflect.Descriptor instead.
-func (*AuthProvisionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthRotateResponse.ProtoReflect.Descriptor instead.
+func (*AuthRotateResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AuthProvisionsResponse) GetProvisions() []*AuthProvision {
+func (x *AuthRotateResponse) GetKID() string {
 	if x != nil {
-		return x.Provisions
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-type AuthLockRequest struct {
+type AuthProvision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ID        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=keys.AuthType" json:"type,omitempty"`
+	CreatedAt int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// For FIDO2
+	// AAGUID is a device "identifier" (only unique across batches for privacy reasons).
+	AAGUID string `protobuf:"bytes,100,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	NoPin  bool   `protobuf:"varint,101,opt,name=noPin,proto3" json:"noPin,omitempty"`
+	// For key file
+	// Path (hint) to the key file.
+	Path string `protobuf:"bytes,110,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *AuthLockRequest) Reset() {
-	*x = AuthLockRequest{}
+func (x *AuthProvision) Reset() {
+	*x = AuthProvision{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3936,13 +4157,13 @@ func (x *AuthLockRequest) Reset() {
 	}
 }
 
-func (x *AuthLockRequest) String() string {
+func (x *AuthProvision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthLockRequest) ProtoMessage() {}
+func (*AuthProvision) ProtoMessage() {}
 
-func (x *AuthLockRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthProvision) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3954,33 +4175,75 @@ func (x *AuthLockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthLockRequest.ProtoReflect.Descriptor instead.
-func (*AuthLockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthProvision.ProtoReflect.Descriptor instead.
+func (*AuthProvision) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{55}
 }
 
-type AuthLockResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *AuthLockResponse) Reset() {
-	*x = AuthLockResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_keys_proto_msgTypes[56]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AuthProvision) GetID() string {
+	if x != nil {
+		return x.ID
 	}
+	return ""
 }
 
-func (x *AuthLockResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuthProvision) GetType() AuthType {
+	if x != nil {
+		return x.Type
+	}
+	return UnknownAuth
 }
 
-func (*AuthLockResponse) ProtoMessage() {}
+func (x *AuthProvision) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
 
-func (x *AuthLockResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthProvision) GetAAGUID() string {
+	if x != nil {
+		return x.AAGUID
+	}
+	return ""
+}
+
+func (x *AuthProvision) GetNoPin() bool {
+	if x != nil {
+		return x.NoPin
+	}
+	return false
+}
+
+func (x *AuthProvision) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type AuthProvisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuthProvisionsRequest) Reset() {
+	*x = AuthProvisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthProvisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthProvisionsRequest) ProtoMessage() {}
+
+func (x *AuthProvisionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3992,21 +4255,21 @@ func (x *AuthLockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthLockResponse.ProtoReflect.Descriptor instead.
-func (*AuthLockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthProvisionsRequest.ProtoReflect.Descriptor instead.
+func (*AuthProvisionsRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{56}
 }
 
-type AuthResetRequest struct {
+type AuthProvisionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppName string `protobuf:"bytes,1,opt,name=appName,proto3" json:"appName,omitempty"`
+	Provisions []*AuthProvision `protobuf:"bytes,1,rep,name=provisions,proto3" json:"provisions,omitempty"`
 }
 
-func (x *AuthResetRequest) Reset() {
-	*x = AuthResetRequest{}
+func (x *AuthProvisionsResponse) Reset() {
+	*x = AuthProvisionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4014,13 +4277,13 @@ func (x *AuthResetRequest) Reset() {
 	}
 }
 
-func (x *AuthResetRequest) String() string {
+func (x *AuthProvisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResetRequest) ProtoMessage() {}
+func (*AuthProvisionsResponse) ProtoMessage() {}
 
-func (x *AuthResetRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthProvisionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4032,26 +4295,29 @@ func (x *AuthResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResetRequest.ProtoReflect.Descriptor instead.
-func (*AuthResetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthProvisionsResponse.ProtoReflect.Descriptor instead.
+func (*AuthProvisionsResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AuthResetRequest) GetAppName() string {
+func (x *AuthProvisionsResponse) GetProvisions() []*AuthProvision {
 	if x != nil {
-		return x.AppName
+		return x.Provisions
 	}
-	return ""
+	return nil
 }
 
-type AuthResetResponse struct {
+type AuthLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Limit (most recent), 0 for all.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *AuthResetResponse) Reset() {
-	*x = AuthResetResponse{}
+func (x *AuthLogRequest) Reset() {
+	*x = AuthLogRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4059,13 +4325,13 @@ func (x *AuthResetResponse) Reset() {
 	}
 }
 
-func (x *AuthResetResponse) String() string {
+func (x *AuthLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResetResponse) ProtoMessage() {}
+func (*AuthLogRequest) ProtoMessage() {}
 
-func (x *AuthResetResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthLogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4077,22 +4343,29 @@ func (x *AuthResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResetResponse.ProtoReflect.Descriptor instead.
-func (*AuthResetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthLogRequest.ProtoReflect.Descriptor instead.
+func (*AuthLogRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{58}
 }
 
-type AuthRecoverRequest struct {
+func (x *AuthLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuthLogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PaperKey    string `protobuf:"bytes,1,opt,name=paperKey,proto3" json:"paperKey,omitempty"`
-	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	// Entries, most recent first.
+	Entries []*AuthLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *AuthRecoverRequest) Reset() {
-	*x = AuthRecoverRequest{}
+func (x *AuthLogResponse) Reset() {
+	*x = AuthLogResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4100,13 +4373,13 @@ func (x *AuthRecoverRequest) Reset() {
 	}
 }
 
-func (x *AuthRecoverRequest) String() string {
+func (x *AuthLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthRecoverRequest) ProtoMessage() {}
+func (*AuthLogResponse) ProtoMessage() {}
 
-func (x *AuthRecoverRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthLogResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4118,35 +4391,37 @@ func (x *AuthRecoverRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthRecoverRequest.ProtoReflect.Descriptor instead.
-func (*AuthRecoverRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthLogResponse.ProtoReflect.Descriptor instead.
+func (*AuthLogResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AuthRecoverRequest) GetPaperKey() string {
-	if x != nil {
-		return x.PaperKey
-	}
-	return ""
-}
-
-func (x *AuthRecoverRequest) GetNewPassword() string {
+func (x *AuthLogResponse) GetEntries() []*AuthLogEntry {
 	if x != nil {
-		return x.NewPassword
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type AuthRecoverResponse struct {
+type AuthLogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthToken string `protobuf:"bytes,1,opt,name=authToken,proto3" json:"authToken,omitempty"`
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Type is setup, unlock, unlock-failed, unlock-throttled, lock, provision,
+	// deprovision or token.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Provision ID, if known.
+	Provision string   `protobuf:"bytes,3,opt,name=provision,proto3" json:"provision,omitempty"`
+	AuthType  AuthType `protobuf:"varint,4,opt,name=authType,proto3,enum=keys.AuthType" json:"authType,omitempty"`
+	// Client name (for token).
+	Client    string `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
+	Timestamp int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *AuthRecoverResponse) Reset() {
-	*x = AuthRecoverResponse{}
+func (x *AuthLogEntry) Reset() {
+	*x = AuthLogEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4154,13 +4429,13 @@ func (x *AuthRecoverResponse) Reset() {
 	}
 }
 
-func (x *AuthRecoverResponse) String() string {
+func (x *AuthLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthRecoverResponse) ProtoMessage() {}
+func (*AuthLogEntry) ProtoMessage() {}
 
-func (x *AuthRecoverResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthLogEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4172,28 +4447,61 @@ func (x *AuthRecoverResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthRecoverResponse.ProtoReflect.Descriptor instead.
-func (*AuthRecoverResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthLogEntry.ProtoReflect.Descriptor instead.
+func (*AuthLogEntry) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *AuthRecoverResponse) GetAuthToken() string {
+func (x *AuthLogEntry) GetIndex() int64 {
 	if x != nil {
-		return x.AuthToken
+		return x.Index
+	}
+	return 0
+}
+
+func (x *AuthLogEntry) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-type KeyGenerateRequest struct {
+func (x *AuthLogEntry) GetProvision() string {
+	if x != nil {
+		return x.Provision
+	}
+	return ""
+}
+
+func (x *AuthLogEntry) GetAuthType() AuthType {
+	if x != nil {
+		return x.AuthType
+	}
+	return UnknownAuth
+}
+
+func (x *AuthLogEntry) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *AuthLogEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type AuthTokensRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *KeyGenerateRequest) Reset() {
-	*x = KeyGenerateRequest{}
+func (x *AuthTokensRequest) Reset() {
+	*x = AuthTokensRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4201,13 +4509,13 @@ func (x *KeyGenerateRequest) Reset() {
 	}
 }
 
-func (x *KeyGenerateRequest) String() string {
+func (x *AuthTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyGenerateRequest) ProtoMessage() {}
+func (*AuthTokensRequest) ProtoMessage() {}
 
-func (x *KeyGenerateRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthTokensRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4219,28 +4527,21 @@ func (x *KeyGenerateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyGenerateRequest.ProtoReflect.Descriptor instead.
-func (*KeyGenerateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthTokensRequest.ProtoReflect.Descriptor instead.
+func (*AuthTokensRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *KeyGenerateRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-type KeyGenerateResponse struct {
+type AuthTokensResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Tokens []*AuthToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-func (x *KeyGenerateResponse) Reset() {
-	*x = KeyGenerateResponse{}
+func (x *AuthTokensResponse) Reset() {
+	*x = AuthTokensResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4248,13 +4549,13 @@ func (x *KeyGenerateResponse) Reset() {
 	}
 }
 
-func (x *KeyGenerateResponse) String() string {
+func (x *AuthTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyGenerateResponse) ProtoMessage() {}
+func (*AuthTokensResponse) ProtoMessage() {}
 
-func (x *KeyGenerateResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthTokensResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4266,31 +4567,30 @@ func (x *KeyGenerateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyGenerateResponse.ProtoReflect.Descriptor instead.
-func (*KeyGenerateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthTokensResponse.ProtoReflect.Descriptor instead.
+func (*AuthTokensResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *KeyGenerateResponse) GetKID() string {
+func (x *AuthTokensResponse) GetTokens() []*AuthToken {
 	if x != nil {
-		return x.KID
+		return x.Tokens
 	}
-	return ""
+	return nil
 }
 
-type UserServiceRequest struct {
+type AuthToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// KID to use, or if empty the current key.
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Service such as twitter, github.
-	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Client    string   `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Scopes    []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *UserServiceRequest) Reset() {
-	*x = UserServiceRequest{}
+func (x *AuthToken) Reset() {
+	*x = AuthToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4298,13 +4598,13 @@ func (x *UserServiceRequest) Reset() {
 	}
 }
 
-func (x *UserServiceRequest) String() string {
+func (x *AuthToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserServiceRequest) ProtoMessage() {}
+func (*AuthToken) ProtoMessage() {}
 
-func (x *UserServiceRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthToken) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4316,36 +4616,42 @@ func (x *UserServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserServiceRequest.ProtoReflect.Descriptor instead.
-func (*UserServiceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthToken.ProtoReflect.Descriptor instead.
+func (*AuthToken) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UserServiceRequest) GetKID() string {
+func (x *AuthToken) GetClient() string {
 	if x != nil {
-		return x.KID
+		return x.Client
 	}
 	return ""
 }
 
-func (x *UserServiceRequest) GetService() string {
+func (x *AuthToken) GetScopes() []string {
 	if x != nil {
-		return x.Service
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-type UserServiceResponse struct {
+func (x *AuthToken) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AuthTokenRevokeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Service such as twitter, github.
-	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Client string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
 }
 
-func (x *UserServiceResponse) Reset() {
-	*x = UserServiceResponse{}
+func (x *AuthTokenRevokeRequest) Reset() {
+	*x = AuthTokenRevokeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4353,13 +4659,13 @@ func (x *UserServiceResponse) Reset() {
 	}
 }
 
-func (x *UserServiceResponse) String() string {
+func (x *AuthTokenRevokeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserServiceResponse) ProtoMessage() {}
+func (*AuthTokenRevokeRequest) ProtoMessage() {}
 
-func (x *UserServiceResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthTokenRevokeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4371,33 +4677,26 @@ func (x *UserServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserServiceResponse.ProtoReflect.Descriptor instead.
-func (*UserServiceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthTokenRevokeRequest.ProtoReflect.Descriptor instead.
+func (*AuthTokenRevokeRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *UserServiceResponse) GetService() string {
+func (x *AuthTokenRevokeRequest) GetClient() string {
 	if x != nil {
-		return x.Service
+		return x.Client
 	}
 	return ""
 }
 
-type UserSignRequest struct {
+type AuthTokenRevokeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// KID to use, or if empty the current key.
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Service such as twitter, github.
-	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	// Name is username on the service.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *UserSignRequest) Reset() {
-	*x = UserSignRequest{}
+func (x *AuthTokenRevokeResponse) Reset() {
+	*x = AuthTokenRevokeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4405,13 +4704,13 @@ func (x *UserSignRequest) Reset() {
 	}
 }
 
-func (x *UserSignRequest) String() string {
+func (x *AuthTokenRevokeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSignRequest) ProtoMessage() {}
+func (*AuthTokenRevokeResponse) ProtoMessage() {}
 
-func (x *UserSignRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthTokenRevokeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4423,59 +4722,33 @@ func (x *UserSignRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSignRequest.ProtoReflect.Descriptor instead.
-func (*UserSignRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthTokenRevokeResponse.ProtoReflect.Descriptor instead.
+func (*AuthTokenRevokeResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *UserSignRequest) GetKID() string {
-	if x != nil {
-		return x.KID
+type AuthLockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuthLockRequest) Reset() {
+	*x = AuthLockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserSignRequest) GetService() string {
-	if x != nil {
-		return x.Service
-	}
-	return ""
-}
-
-func (x *UserSignRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type UserSignResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Message is signed message.
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	// Name in request.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *UserSignResponse) Reset() {
-	*x = UserSignResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_keys_proto_msgTypes[66]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *UserSignResponse) String() string {
+func (x *AuthLockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSignResponse) ProtoMessage() {}
+func (*AuthLockRequest) ProtoMessage() {}
 
-func (x *UserSignResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthLockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4487,44 +4760,19 @@ func (x *UserSignResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSignResponse.ProtoReflect.Descriptor instead.
-func (*UserSignResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthLockRequest.ProtoReflect.Descriptor instead.
+func (*AuthLockRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *UserSignResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *UserSignResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type UserAddRequest struct {
+type AuthLockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// KID to use, or if empty the current key.
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Service such as twitter, github.
-	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	// Name is username on the service.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// URL is location of signed message on the service.
-	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	// Local, if true, won't save to the current key server.
-	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *UserAddRequest) Reset() {
-	*x = UserAddRequest{}
+func (x *AuthLockResponse) Reset() {
+	*x = AuthLockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4532,13 +4780,13 @@ func (x *UserAddRequest) Reset() {
 	}
 }
 
-func (x *UserAddRequest) String() string {
+func (x *AuthLockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAddRequest) ProtoMessage() {}
+func (*AuthLockResponse) ProtoMessage() {}
 
-func (x *UserAddRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthLockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4550,57 +4798,21 @@ func (x *UserAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAddRequest.ProtoReflect.Descriptor instead.
-func (*UserAddRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthLockResponse.ProtoReflect.Descriptor instead.
+func (*AuthLockResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UserAddRequest) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *UserAddRequest) GetService() string {
-	if x != nil {
-		return x.Service
-	}
-	return ""
-}
-
-func (x *UserAddRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UserAddRequest) GetURL() string {
-	if x != nil {
-		return x.URL
-	}
-	return ""
-}
-
-func (x *UserAddRequest) GetLocal() bool {
-	if x != nil {
-		return x.Local
-	}
-	return false
-}
-
-type UserAddResponse struct {
+type AuthResetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User      *User      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Statement *Statement `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	AppName string `protobuf:"bytes,1,opt,name=appName,proto3" json:"appName,omitempty"`
 }
 
-func (x *UserAddResponse) Reset() {
-	*x = UserAddResponse{}
+func (x *AuthResetRequest) Reset() {
+	*x = AuthResetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4608,13 +4820,13 @@ func (x *UserAddResponse) Reset() {
 	}
 }
 
-func (x *UserAddResponse) String() string {
+func (x *AuthResetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAddResponse) ProtoMessage() {}
+func (*AuthResetRequest) ProtoMessage() {}
 
-func (x *UserAddResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthResetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4626,39 +4838,26 @@ func (x *UserAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAddResponse.ProtoReflect.Descriptor instead.
-func (*UserAddResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthResetRequest.ProtoReflect.Descriptor instead.
+func (*AuthResetRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UserAddResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-func (x *UserAddResponse) GetStatement() *Statement {
+func (x *AuthResetRequest) GetAppName() string {
 	if x != nil {
-		return x.Statement
+		return x.AppName
 	}
-	return nil
+	return ""
 }
 
-type KeyExportRequest struct {
+type AuthResetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	KID        string     `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	Password   string     `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Type       ExportType `protobuf:"varint,3,opt,name=type,proto3,enum=keys.ExportType" json:"type,omitempty"`
-	Public     bool       `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
-	NoPassword bool       `protobuf:"varint,5,opt,name=noPassword,proto3" json:"noPassword,omitempty"`
 }
 
-func (x *KeyExportRequest) Reset() {
-	*x = KeyExportRequest{}
+func (x *AuthResetResponse) Reset() {
+	*x = AuthResetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4666,13 +4865,13 @@ func (x *KeyExportRequest) Reset() {
 	}
 }
 
-func (x *KeyExportRequest) String() string {
+func (x *AuthResetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyExportRequest) ProtoMessage() {}
+func (*AuthResetResponse) ProtoMessage() {}
 
-func (x *KeyExportRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthResetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4684,56 +4883,22 @@ func (x *KeyExportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyExportRequest.ProtoReflect.Descriptor instead.
-func (*KeyExportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthResetResponse.ProtoReflect.Descriptor instead.
+func (*AuthResetResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *KeyExportRequest) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *KeyExportRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-func (x *KeyExportRequest) GetType() ExportType {
-	if x != nil {
-		return x.Type
-	}
-	return DefaultExport
-}
-
-func (x *KeyExportRequest) GetPublic() bool {
-	if x != nil {
-		return x.Public
-	}
-	return false
-}
-
-func (x *KeyExportRequest) GetNoPassword() bool {
-	if x != nil {
-		return x.NoPassword
-	}
-	return false
-}
-
-type KeyExportResponse struct {
+type AuthRecoverRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Export []byte `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	PaperKey    string `protobuf:"bytes,1,opt,name=paperKey,proto3" json:"paperKey,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 }
 
-func (x *KeyExportResponse) Reset() {
-	*x = KeyExportResponse{}
+func (x *AuthRecoverRequest) Reset() {
+	*x = AuthRecoverRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4741,13 +4906,13 @@ func (x *KeyExportResponse) Reset() {
 	}
 }
 
-func (x *KeyExportResponse) String() string {
+func (x *AuthRecoverRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyExportResponse) ProtoMessage() {}
+func (*AuthRecoverRequest) ProtoMessage() {}
 
-func (x *KeyExportResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthRecoverRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4759,29 +4924,35 @@ func (x *KeyExportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyExportResponse.ProtoReflect.Descriptor instead.
-func (*KeyExportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthRecoverRequest.ProtoReflect.Descriptor instead.
+func (*AuthRecoverRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *KeyExportResponse) GetExport() []byte {
+func (x *AuthRecoverRequest) GetPaperKey() string {
 	if x != nil {
-		return x.Export
+		return x.PaperKey
 	}
-	return nil
+	return ""
 }
 
-type KeyImportRequest struct {
+func (x *AuthRecoverRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type AuthRecoverResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	In       []byte `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	AuthToken string `protobuf:"bytes,1,opt,name=authToken,proto3" json:"authToken,omitempty"`
 }
 
-func (x *KeyImportRequest) Reset() {
-	*x = KeyImportRequest{}
+func (x *AuthRecoverResponse) Reset() {
+	*x = AuthRecoverResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4789,13 +4960,13 @@ func (x *KeyImportRequest) Reset() {
 	}
 }
 
-func (x *KeyImportRequest) String() string {
+func (x *AuthRecoverResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyImportRequest) ProtoMessage() {}
+func (*AuthRecoverResponse) ProtoMessage() {}
 
-func (x *KeyImportRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthRecoverResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4807,35 +4978,28 @@ func (x *KeyImportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyImportRequest.ProtoReflect.Descriptor instead.
-func (*KeyImportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthRecoverResponse.ProtoReflect.Descriptor instead.
+func (*AuthRecoverResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *KeyImportRequest) GetIn() []byte {
-	if x != nil {
-		return x.In
-	}
-	return nil
-}
-
-func (x *KeyImportRequest) GetPassword() string {
+func (x *AuthRecoverResponse) GetAuthToken() string {
 	if x != nil {
-		return x.Password
+		return x.AuthToken
 	}
 	return ""
 }
 
-type KeyImportResponse struct {
+type KeyGenerateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *KeyImportResponse) Reset() {
-	*x = KeyImportResponse{}
+func (x *KeyGenerateRequest) Reset() {
+	*x = KeyGenerateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4843,13 +5007,13 @@ func (x *KeyImportResponse) Reset() {
 	}
 }
 
-func (x *KeyImportResponse) String() string {
+func (x *KeyGenerateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyImportResponse) ProtoMessage() {}
+func (*KeyGenerateRequest) ProtoMessage() {}
 
-func (x *KeyImportResponse) ProtoReflect() protoreflect.Message {
+func (x *KeyGenerateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4861,29 +5025,28 @@ func (x *KeyImportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyImportResponse.ProtoReflect.Descriptor instead.
-func (*KeyImportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyGenerateRequest.ProtoReflect.Descriptor instead.
+func (*KeyGenerateRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *KeyImportResponse) GetKID() string {
+func (x *KeyGenerateRequest) GetType() string {
 	if x != nil {
-		return x.KID
+		return x.Type
 	}
 	return ""
 }
 
-type KeyRemoveRequest struct {
+type KeyGenerateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// KID of key to remove.
 	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *KeyRemoveRequest) Reset() {
-	*x = KeyRemoveRequest{}
+func (x *KeyGenerateResponse) Reset() {
+	*x = KeyGenerateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4891,13 +5054,13 @@ func (x *KeyRemoveRequest) Reset() {
 	}
 }
 
-func (x *KeyRemoveRequest) String() string {
+func (x *KeyGenerateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyRemoveRequest) ProtoMessage() {}
+func (*KeyGenerateResponse) ProtoMessage() {}
 
-func (x *KeyRemoveRequest) ProtoReflect() protoreflect.Message {
+func (x *KeyGenerateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4909,26 +5072,31 @@ func (x *KeyRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyRemoveRequest.ProtoReflect.Descriptor instead.
-func (*KeyRemoveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyGenerateResponse.ProtoReflect.Descriptor instead.
+func (*KeyGenerateResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *KeyRemoveRequest) GetKID() string {
+func (x *KeyGenerateResponse) GetKID() string {
 	if x != nil {
 		return x.KID
 	}
 	return ""
 }
 
-type KeyRemoveResponse struct {
+type UserServiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// KID to use, or if empty the current key.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Service such as twitter, github.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (x *KeyRemoveResponse) Reset() {
-	*x = KeyRemoveResponse{}
+func (x *UserServiceRequest) Reset() {
+	*x = UserServiceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4936,13 +5104,13 @@ func (x *KeyRemoveResponse) Reset() {
 	}
 }
 
-func (x *KeyRemoveResponse) String() string {
+func (x *UserServiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyRemoveResponse) ProtoMessage() {}
+func (*UserServiceRequest) ProtoMessage() {}
 
-func (x *KeyRemoveResponse) ProtoReflect() protoreflect.Message {
+func (x *UserServiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4954,31 +5122,36 @@ func (x *KeyRemoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyRemoveResponse.ProtoReflect.Descriptor instead.
-func (*KeyRemoveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserServiceRequest.ProtoReflect.Descriptor instead.
+func (*UserServiceRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{74}
 }
 
-type Key struct {
+func (x *UserServiceRequest) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
+}
+
+func (x *UserServiceRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type UserServiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID identifier.
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Type of key.
-	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	// User associated with this key.
-	User *User `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
-	// Saved if saved locally.
-	Saved bool `protobuf:"varint,10,opt,name=saved,proto3" json:"saved,omitempty"`
-	// SigchainLength is length of sigchain (if any).
-	SigchainLength    int32 `protobuf:"varint,40,opt,name=sigchainLength,proto3" json:"sigchainLength,omitempty"`
-	SigchainUpdatedAt int64 `protobuf:"varint,41,opt,name=sigchainUpdatedAt,proto3" json:"sigchainUpdatedAt,omitempty"`
+	// Service such as twitter, github.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (x *Key) Reset() {
-	*x = Key{}
+func (x *UserServiceResponse) Reset() {
+	*x = UserServiceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4986,13 +5159,13 @@ func (x *Key) Reset() {
 	}
 }
 
-func (x *Key) String() string {
+func (x *UserServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Key) ProtoMessage() {}
+func (*UserServiceResponse) ProtoMessage() {}
 
-func (x *Key) ProtoReflect() protoreflect.Message {
+func (x *UserServiceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5004,68 +5177,33 @@ func (x *Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Key.ProtoReflect.Descriptor instead.
-func (*Key) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserServiceResponse.ProtoReflect.Descriptor instead.
+func (*UserServiceResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *Key) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *Key) GetType() string {
+func (x *UserServiceResponse) GetService() string {
 	if x != nil {
-		return x.Type
+		return x.Service
 	}
 	return ""
 }
 
-func (x *Key) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-func (x *Key) GetSaved() bool {
-	if x != nil {
-		return x.Saved
-	}
-	return false
-}
-
-func (x *Key) GetSigchainLength() int32 {
-	if x != nil {
-		return x.SigchainLength
-	}
-	return 0
-}
-
-func (x *Key) GetSigchainUpdatedAt() int64 {
-	if x != nil {
-		return x.SigchainUpdatedAt
-	}
-	return 0
-}
-
-type KeyRequest struct {
+type UserSignRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Key can be kid or user@service.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// Search, if true, will search for the key on the server.
-	Search bool `protobuf:"varint,4,opt,name=search,proto3" json:"search,omitempty"`
-	// Update, if true, will update the sigchain from the server.
-	Update bool `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
+	// KID to use, or if empty the current key.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Service such as twitter, github.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Name is username on the service.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *KeyRequest) Reset() {
-	*x = KeyRequest{}
+func (x *UserSignRequest) Reset() {
+	*x = UserSignRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5073,13 +5211,13 @@ func (x *KeyRequest) Reset() {
 	}
 }
 
-func (x *KeyRequest) String() string {
+func (x *UserSignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyRequest) ProtoMessage() {}
+func (*UserSignRequest) ProtoMessage() {}
 
-func (x *KeyRequest) ProtoReflect() protoreflect.Message {
+func (x *UserSignRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5091,42 +5229,45 @@ func (x *KeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyRequest.ProtoReflect.Descriptor instead.
-func (*KeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserSignRequest.ProtoReflect.Descriptor instead.
+func (*UserSignRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *KeyRequest) GetKey() string {
+func (x *UserSignRequest) GetKID() string {
 	if x != nil {
-		return x.Key
+		return x.KID
 	}
 	return ""
 }
 
-func (x *KeyRequest) GetSearch() bool {
+func (x *UserSignRequest) GetService() string {
 	if x != nil {
-		return x.Search
+		return x.Service
 	}
-	return false
+	return ""
 }
 
-func (x *KeyRequest) GetUpdate() bool {
+func (x *UserSignRequest) GetName() string {
 	if x != nil {
-		return x.Update
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-type KeyResponse struct {
+type UserSignResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key *Key `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Message is signed message.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Name in request.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *KeyResponse) Reset() {
-	*x = KeyResponse{}
+func (x *UserSignResponse) Reset() {
+	*x = UserSignResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5134,13 +5275,13 @@ func (x *KeyResponse) Reset() {
 	}
 }
 
-func (x *KeyResponse) String() string {
+func (x *UserSignResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyResponse) ProtoMessage() {}
+func (*UserSignResponse) ProtoMessage() {}
 
-func (x *KeyResponse) ProtoReflect() protoreflect.Message {
+func (x *UserSignResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5152,31 +5293,44 @@ func (x *KeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyResponse.ProtoReflect.Descriptor instead.
-func (*KeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserSignResponse.ProtoReflect.Descriptor instead.
+func (*UserSignResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *KeyResponse) GetKey() *Key {
+func (x *UserSignResponse) GetMessage() string {
 	if x != nil {
-		return x.Key
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type KeysRequest struct {
+func (x *UserSignResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserAddRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query         string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Types         []string      `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
-	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=keys.SortDirection" json:"sortDirection,omitempty"`
+	// KID to use, or if empty the current key.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Service such as twitter, github.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Name is username on the service.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// URL is location of signed message on the service.
+	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Local, if true, won't save to the current key server.
+	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *KeysRequest) Reset() {
-	*x = KeysRequest{}
+func (x *UserAddRequest) Reset() {
+	*x = UserAddRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5184,13 +5338,13 @@ func (x *KeysRequest) Reset() {
 	}
 }
 
-func (x *KeysRequest) String() string {
+func (x *UserAddRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeysRequest) ProtoMessage() {}
+func (*UserAddRequest) ProtoMessage() {}
 
-func (x *KeysRequest) ProtoReflect() protoreflect.Message {
+func (x *UserAddRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5202,51 +5356,57 @@ func (x *KeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeysRequest.ProtoReflect.Descriptor instead.
-func (*KeysRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserAddRequest.ProtoReflect.Descriptor instead.
+func (*UserAddRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *KeysRequest) GetQuery() string {
+func (x *UserAddRequest) GetKID() string {
 	if x != nil {
-		return x.Query
+		return x.KID
 	}
 	return ""
 }
 
-func (x *KeysRequest) GetTypes() []string {
+func (x *UserAddRequest) GetService() string {
 	if x != nil {
-		return x.Types
+		return x.Service
 	}
-	return nil
+	return ""
 }
 
-func (x *KeysRequest) GetSortField() string {
+func (x *UserAddRequest) GetName() string {
 	if x != nil {
-		return x.SortField
+		return x.Name
 	}
 	return ""
 }
 
-func (x *KeysRequest) GetSortDirection() SortDirection {
+func (x *UserAddRequest) GetURL() string {
 	if x != nil {
-		return x.SortDirection
+		return x.URL
 	}
-	return SortAsc
+	return ""
 }
 
-type KeysResponse struct {
+func (x *UserAddRequest) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+type UserAddResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keys          []*Key        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=keys.SortDirection" json:"sortDirection,omitempty"`
+	User      *User      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Statement *Statement `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *KeysResponse) Reset() {
-	*x = KeysResponse{}
+func (x *UserAddResponse) Reset() {
+	*x = UserAddResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5254,13 +5414,13 @@ func (x *KeysResponse) Reset() {
 	}
 }
 
-func (x *KeysResponse) String() string {
+func (x *UserAddResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeysResponse) ProtoMessage() {}
+func (*UserAddResponse) ProtoMessage() {}
 
-func (x *KeysResponse) ProtoReflect() protoreflect.Message {
+func (x *UserAddResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5272,50 +5432,39 @@ func (x *KeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeysResponse.ProtoReflect.Descriptor instead.
-func (*KeysResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserAddResponse.ProtoReflect.Descriptor instead.
+func (*UserAddResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *KeysResponse) GetKeys() []*Key {
+func (x *UserAddResponse) GetUser() *User {
 	if x != nil {
-		return x.Keys
+		return x.User
 	}
 	return nil
 }
 
-func (x *KeysResponse) GetSortField() string {
-	if x != nil {
-		return x.SortField
-	}
-	return ""
-}
-
-func (x *KeysResponse) GetSortDirection() SortDirection {
+func (x *UserAddResponse) GetStatement() *Statement {
 	if x != nil {
-		return x.SortDirection
+		return x.Statement
 	}
-	return SortAsc
+	return nil
 }
 
-type Secret struct {
+type KeyExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type      SecretType `protobuf:"varint,3,opt,name=type,proto3,enum=keys.SecretType" json:"type,omitempty"`
-	Username  string     `protobuf:"bytes,10,opt,name=username,proto3" json:"username,omitempty"`
-	Password  string     `protobuf:"bytes,11,opt,name=password,proto3" json:"password,omitempty"`
-	URL       string     `protobuf:"bytes,20,opt,name=url,proto3" json:"url,omitempty"`
-	Notes     string     `protobuf:"bytes,30,opt,name=notes,proto3" json:"notes,omitempty"`
-	CreatedAt int64      `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt int64      `protobuf:"varint,101,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	KID        string     `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Password   string     `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Type       ExportType `protobuf:"varint,3,opt,name=type,proto3,enum=keys.ExportType" json:"type,omitempty"`
+	Public     bool       `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	NoPassword bool       `protobuf:"varint,5,opt,name=noPassword,proto3" json:"noPassword,omitempty"`
 }
 
-func (x *Secret) Reset() {
-	*x = Secret{}
+func (x *KeyExportRequest) Reset() {
+	*x = KeyExportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5323,13 +5472,13 @@ func (x *Secret) Reset() {
 	}
 }
 
-func (x *Secret) String() string {
+func (x *KeyExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Secret) ProtoMessage() {}
+func (*KeyExportRequest) ProtoMessage() {}
 
-func (x *Secret) ProtoReflect() protoreflect.Message {
+func (x *KeyExportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5341,84 +5490,56 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
-func (*Secret) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyExportRequest.ProtoReflect.Descriptor instead.
+func (*KeyExportRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *Secret) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *Secret) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Secret) GetType() SecretType {
-	if x != nil {
-		return x.Type
-	}
-	return UnknownSecret
-}
-
-func (x *Secret) GetUsername() string {
+func (x *KeyExportRequest) GetKID() string {
 	if x != nil {
-		return x.Username
+		return x.KID
 	}
 	return ""
 }
 
-func (x *Secret) GetPassword() string {
+func (x *KeyExportRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *Secret) GetURL() string {
-	if x != nil {
-		return x.URL
-	}
-	return ""
-}
-
-func (x *Secret) GetNotes() string {
+func (x *KeyExportRequest) GetType() ExportType {
 	if x != nil {
-		return x.Notes
+		return x.Type
 	}
-	return ""
+	return DefaultExport
 }
 
-func (x *Secret) GetCreatedAt() int64 {
+func (x *KeyExportRequest) GetPublic() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Public
 	}
-	return 0
+	return false
 }
 
-func (x *Secret) GetUpdatedAt() int64 {
+func (x *KeyExportRequest) GetNoPassword() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.NoPassword
 	}
-	return 0
+	return false
 }
 
-type SecretRequest struct {
+type KeyExportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Export []byte `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
 }
 
-func (x *SecretRequest) Reset() {
-	*x = SecretRequest{}
+func (x *KeyExportResponse) Reset() {
+	*x = KeyExportResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5426,13 +5547,13 @@ func (x *SecretRequest) Reset() {
 	}
 }
 
-func (x *SecretRequest) String() string {
+func (x *KeyExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretRequest) ProtoMessage() {}
+func (*KeyExportResponse) ProtoMessage() {}
 
-func (x *SecretRequest) ProtoReflect() protoreflect.Message {
+func (x *KeyExportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5444,28 +5565,29 @@ func (x *SecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
-func (*SecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyExportResponse.ProtoReflect.Descriptor instead.
+func (*KeyExportResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *SecretRequest) GetID() string {
+func (x *KeyExportResponse) GetExport() []byte {
 	if x != nil {
-		return x.ID
+		return x.Export
 	}
-	return ""
+	return nil
 }
 
-type SecretResponse struct {
+type KeyImportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	In       []byte `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *SecretResponse) Reset() {
-	*x = SecretResponse{}
+func (x *KeyImportRequest) Reset() {
+	*x = KeyImportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5473,13 +5595,13 @@ func (x *SecretResponse) Reset() {
 	}
 }
 
-func (x *SecretResponse) String() string {
+func (x *KeyImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretResponse) ProtoMessage() {}
+func (*KeyImportRequest) ProtoMessage() {}
 
-func (x *SecretResponse) ProtoReflect() protoreflect.Message {
+func (x *KeyImportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5491,28 +5613,35 @@ func (x *SecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretResponse.ProtoReflect.Descriptor instead.
-func (*SecretResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyImportRequest.ProtoReflect.Descriptor instead.
+func (*KeyImportRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *SecretResponse) GetSecret() *Secret {
+func (x *KeyImportRequest) GetIn() []byte {
 	if x != nil {
-		return x.Secret
+		return x.In
 	}
 	return nil
 }
 
-type SecretSaveRequest struct {
+func (x *KeyImportRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type KeyImportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *SecretSaveRequest) Reset() {
-	*x = SecretSaveRequest{}
+func (x *KeyImportResponse) Reset() {
+	*x = KeyImportResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5520,13 +5649,13 @@ func (x *SecretSaveRequest) Reset() {
 	}
 }
 
-func (x *SecretSaveRequest) String() string {
+func (x *KeyImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretSaveRequest) ProtoMessage() {}
+func (*KeyImportResponse) ProtoMessage() {}
 
-func (x *SecretSaveRequest) ProtoReflect() protoreflect.Message {
+func (x *KeyImportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5538,28 +5667,29 @@ func (x *SecretSaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretSaveRequest.ProtoReflect.Descriptor instead.
-func (*SecretSaveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyImportResponse.ProtoReflect.Descriptor instead.
+func (*KeyImportResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *SecretSaveRequest) GetSecret() *Secret {
+func (x *KeyImportResponse) GetKID() string {
 	if x != nil {
-		return x.Secret
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-type SecretSaveResponse struct {
+type KeyRemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// KID of key to remove.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *SecretSaveResponse) Reset() {
-	*x = SecretSaveResponse{}
+func (x *KeyRemoveRequest) Reset() {
+	*x = KeyRemoveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5567,13 +5697,13 @@ func (x *SecretSaveResponse) Reset() {
 	}
 }
 
-func (x *SecretSaveResponse) String() string {
+func (x *KeyRemoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretSaveResponse) ProtoMessage() {}
+func (*KeyRemoveRequest) ProtoMessage() {}
 
-func (x *SecretSaveResponse) ProtoReflect() protoreflect.Message {
+func (x *KeyRemoveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5585,28 +5715,26 @@ func (x *SecretSaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretSaveResponse.ProtoReflect.Descriptor instead.
-func (*SecretSaveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyRemoveRequest.ProtoReflect.Descriptor instead.
+func (*KeyRemoveRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *SecretSaveResponse) GetSecret() *Secret {
+func (x *KeyRemoveRequest) GetKID() string {
 	if x != nil {
-		return x.Secret
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-type SecretRemoveRequest struct {
+type KeyRemoveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SecretRemoveRequest) Reset() {
-	*x = SecretRemoveRequest{}
+func (x *KeyRemoveResponse) Reset() {
+	*x = KeyRemoveResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5614,13 +5742,13 @@ func (x *SecretRemoveRequest) Reset() {
 	}
 }
 
-func (x *SecretRemoveRequest) String() string {
+func (x *KeyRemoveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretRemoveRequest) ProtoMessage() {}
+func (*KeyRemoveResponse) ProtoMessage() {}
 
-func (x *SecretRemoveRequest) ProtoReflect() protoreflect.Message {
+func (x *KeyRemoveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5632,26 +5760,31 @@ func (x *SecretRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretRemoveRequest.ProtoReflect.Descriptor instead.
-func (*SecretRemoveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyRemoveResponse.ProtoReflect.Descriptor instead.
+func (*KeyRemoveResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *SecretRemoveRequest) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-type SecretRemoveResponse struct {
+type Key struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID identifier.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Type of key.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// User associated with this key.
+	User *User `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	// Saved if saved locally.
+	Saved bool `protobuf:"varint,10,opt,name=saved,proto3" json:"saved,omitempty"`
+	// SigchainLength is length of sigchain (if any).
+	SigchainLength    int32 `protobuf:"varint,40,opt,name=sigchainLength,proto3" json:"sigchainLength,omitempty"`
+	SigchainUpdatedAt int64 `protobuf:"varint,41,opt,name=sigchainUpdatedAt,proto3" json:"sigchainUpdatedAt,omitempty"`
 }
 
-func (x *SecretRemoveResponse) Reset() {
-	*x = SecretRemoveResponse{}
+func (x *Key) Reset() {
+	*x = Key{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5659,13 +5792,13 @@ func (x *SecretRemoveResponse) Reset() {
 	}
 }
 
-func (x *SecretRemoveResponse) String() string {
+func (x *Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretRemoveResponse) ProtoMessage() {}
+func (*Key) ProtoMessage() {}
 
-func (x *SecretRemoveResponse) ProtoReflect() protoreflect.Message {
+func (x *Key) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5677,23 +5810,68 @@ func (x *SecretRemoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretRemoveResponse.ProtoReflect.Descriptor instead.
-func (*SecretRemoveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Key.ProtoReflect.Descriptor instead.
+func (*Key) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{86}
 }
 
-type SecretsRequest struct {
+func (x *Key) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Key) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Key) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Key) GetSaved() bool {
+	if x != nil {
+		return x.Saved
+	}
+	return false
+}
+
+func (x *Key) GetSigchainLength() int32 {
+	if x != nil {
+		return x.SigchainLength
+	}
+	return 0
+}
+
+func (x *Key) GetSigchainUpdatedAt() int64 {
+	if x != nil {
+		return x.SigchainUpdatedAt
+	}
+	return 0
+}
+
+type KeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query         string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=keys.SortDirection" json:"sortDirection,omitempty"`
+	// Key can be kid or user@service.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Search, if true, will search for the key on the server.
+	Search bool `protobuf:"varint,4,opt,name=search,proto3" json:"search,omitempty"`
+	// Update, if true, will update the sigchain from the server.
+	Update bool `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *SecretsRequest) Reset() {
-	*x = SecretsRequest{}
+func (x *KeyRequest) Reset() {
+	*x = KeyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5701,13 +5879,13 @@ func (x *SecretsRequest) Reset() {
 	}
 }
 
-func (x *SecretsRequest) String() string {
+func (x *KeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretsRequest) ProtoMessage() {}
+func (*KeyRequest) ProtoMessage() {}
 
-func (x *SecretsRequest) ProtoReflect() protoreflect.Message {
+func (x *KeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5719,44 +5897,42 @@ func (x *SecretsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretsRequest.ProtoReflect.Descriptor instead.
-func (*SecretsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyRequest.ProtoReflect.Descriptor instead.
+func (*KeyRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *SecretsRequest) GetQuery() string {
+func (x *KeyRequest) GetKey() string {
 	if x != nil {
-		return x.Query
+		return x.Key
 	}
 	return ""
 }
 
-func (x *SecretsRequest) GetSortField() string {
+func (x *KeyRequest) GetSearch() bool {
 	if x != nil {
-		return x.SortField
+		return x.Search
 	}
-	return ""
+	return false
 }
 
-func (x *SecretsRequest) GetSortDirection() SortDirection {
+func (x *KeyRequest) GetUpdate() bool {
 	if x != nil {
-		return x.SortDirection
+		return x.Update
 	}
-	return SortAsc
+	return false
 }
 
-type SecretsResponse struct {
+type KeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secrets       []*Secret     `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=keys.SortDirection" json:"sortDirection,omitempty"`
+	Key *Key `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *SecretsResponse) Reset() {
-	*x = SecretsResponse{}
+func (x *KeyResponse) Reset() {
+	*x = KeyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5764,13 +5940,13 @@ func (x *SecretsResponse) Reset() {
 	}
 }
 
-func (x *SecretsResponse) String() string {
+func (x *KeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretsResponse) ProtoMessage() {}
+func (*KeyResponse) ProtoMessage() {}
 
-func (x *SecretsResponse) ProtoReflect() protoreflect.Message {
+func (x *KeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5782,45 +5958,31 @@ func (x *SecretsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretsResponse.ProtoReflect.Descriptor instead.
-func (*SecretsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyResponse.ProtoReflect.Descriptor instead.
+func (*KeyResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *SecretsResponse) GetSecrets() []*Secret {
+func (x *KeyResponse) GetKey() *Key {
 	if x != nil {
-		return x.Secrets
+		return x.Key
 	}
 	return nil
 }
 
-func (x *SecretsResponse) GetSortField() string {
-	if x != nil {
-		return x.SortField
-	}
-	return ""
-}
-
-func (x *SecretsResponse) GetSortDirection() SortDirection {
-	if x != nil {
-		return x.SortDirection
-	}
-	return SortAsc
-}
-
-type RandRequest struct {
+type KeysRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumBytes  int32    `protobuf:"varint,1,opt,name=numBytes,proto3" json:"numBytes,omitempty"`
-	Encoding  Encoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=keys.Encoding" json:"encoding,omitempty"`
-	NoPadding bool     `protobuf:"varint,10,opt,name=noPadding,proto3" json:"noPadding,omitempty"`
-	Lowercase bool     `protobuf:"varint,11,opt,name=lowercase,proto3" json:"lowercase,omitempty"`
+	Query         string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Types         []string      `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=keys.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *RandRequest) Reset() {
-	*x = RandRequest{}
+func (x *KeysRequest) Reset() {
+	*x = KeysRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5828,13 +5990,13 @@ func (x *RandRequest) Reset() {
 	}
 }
 
-func (x *RandRequest) String() string {
+func (x *KeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandRequest) ProtoMessage() {}
+func (*KeysRequest) ProtoMessage() {}
 
-func (x *RandRequest) ProtoReflect() protoreflect.Message {
+func (x *KeysRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5846,49 +6008,51 @@ func (x *RandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandRequest.ProtoReflect.Descriptor instead.
-func (*RandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeysRequest.ProtoReflect.Descriptor instead.
+func (*KeysRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *RandRequest) GetNumBytes() int32 {
+func (x *KeysRequest) GetQuery() string {
 	if x != nil {
-		return x.NumBytes
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *RandRequest) GetEncoding() Encoding {
+func (x *KeysRequest) GetTypes() []string {
 	if x != nil {
-		return x.Encoding
+		return x.Types
 	}
-	return Hex
+	return nil
 }
 
-func (x *RandRequest) GetNoPadding() bool {
+func (x *KeysRequest) GetSortField() string {
 	if x != nil {
-		return x.NoPadding
+		return x.SortField
 	}
-	return false
+	return ""
 }
 
-func (x *RandRequest) GetLowercase() bool {
+func (x *KeysRequest) GetSortDirection() SortDirection {
 	if x != nil {
-		return x.Lowercase
+		return x.SortDirection
 	}
-	return false
+	return SortAsc
 }
 
-type RandResponse struct {
+type KeysResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Keys          []*Key        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=keys.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *RandResponse) Reset() {
-	*x = RandResponse{}
+func (x *KeysResponse) Reset() {
+	*x = KeysResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5896,13 +6060,13 @@ func (x *RandResponse) Reset() {
 	}
 }
 
-func (x *RandResponse) String() string {
+func (x *KeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandResponse) ProtoMessage() {}
+func (*KeysResponse) ProtoMessage() {}
 
-func (x *RandResponse) ProtoReflect() protoreflect.Message {
+func (x *KeysResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5914,28 +6078,45 @@ func (x *RandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandResponse.ProtoReflect.Descriptor instead.
-func (*RandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeysResponse.ProtoReflect.Descriptor instead.
+func (*KeysResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RandResponse) GetData() string {
+func (x *KeysResponse) GetKeys() []*Key {
 	if x != nil {
-		return x.Data
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *KeysResponse) GetSortField() string {
+	if x != nil {
+		return x.SortField
 	}
 	return ""
 }
 
-type RandPasswordRequest struct {
+func (x *KeysResponse) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortAsc
+}
+
+type SecretField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Length int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Name string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SecretFieldType `protobuf:"varint,2,opt,name=type,proto3,enum=keys.SecretFieldType" json:"type,omitempty"`
+	// Value (for DateField, YYYY-MM-DD).
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *RandPasswordRequest) Reset() {
-	*x = RandPasswordRequest{}
+func (x *SecretField) Reset() {
+	*x = SecretField{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5943,13 +6124,13 @@ func (x *RandPasswordRequest) Reset() {
 	}
 }
 
-func (x *RandPasswordRequest) String() string {
+func (x *SecretField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandPasswordRequest) ProtoMessage() {}
+func (*SecretField) ProtoMessage() {}
 
-func (x *RandPasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretField) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5961,28 +6142,60 @@ func (x *RandPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandPasswordRequest.ProtoReflect.Descriptor instead.
-func (*RandPasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretField.ProtoReflect.Descriptor instead.
+func (*SecretField) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RandPasswordRequest) GetLength() int32 {
+func (x *SecretField) GetName() string {
 	if x != nil {
-		return x.Length
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type RandPasswordResponse struct {
+func (x *SecretField) GetType() SecretFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return TextField
+}
+
+func (x *SecretField) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Secret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	ID       string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type     SecretType `protobuf:"varint,3,opt,name=type,proto3,enum=keys.SecretType" json:"type,omitempty"`
+	Username string     `protobuf:"bytes,10,opt,name=username,proto3" json:"username,omitempty"`
+	Password string     `protobuf:"bytes,11,opt,name=password,proto3" json:"password,omitempty"`
+	URL      string     `protobuf:"bytes,20,opt,name=url,proto3" json:"url,omitempty"`
+	Notes    string     `protobuf:"bytes,30,opt,name=notes,proto3" json:"notes,omitempty"`
+	// OTP is an otpauth:// URI (TOTP or HOTP).
+	OTP string `protobuf:"bytes,40,opt,name=otp,proto3" json:"otp,omitempty"`
+	// Fields are custom fields.
+	Fields []*SecretField `protobuf:"bytes,50,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Tags (lowercase, sorted).
+	Tags []string `protobuf:"bytes,51,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Folder path, for example, "Work/Servers".
+	Folder string `protobuf:"bytes,52,opt,name=folder,proto3" json:"folder,omitempty"`
+	// ExpireAt (optional) is when the secret expires, or should be rotated by.
+	ExpireAt  int64 `protobuf:"varint,60,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	CreatedAt int64 `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,101,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *RandPasswordResponse) Reset() {
-	*x = RandPasswordResponse{}
+func (x *Secret) Reset() {
+	*x = Secret{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5990,13 +6203,13 @@ func (x *RandPasswordResponse) Reset() {
 	}
 }
 
-func (x *RandPasswordResponse) String() string {
+func (x *Secret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandPasswordResponse) ProtoMessage() {}
+func (*Secret) ProtoMessage() {}
 
-func (x *RandPasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *Secret) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6008,28 +6221,123 @@ func (x *RandPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandPasswordResponse.ProtoReflect.Descriptor instead.
-func (*RandPasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *RandPasswordResponse) GetPassword() string {
+func (x *Secret) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Secret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Secret) GetType() SecretType {
+	if x != nil {
+		return x.Type
+	}
+	return UnknownSecret
+}
+
+func (x *Secret) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Secret) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type PullRequest struct {
+func (x *Secret) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *Secret) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Secret) GetOTP() string {
+	if x != nil {
+		return x.OTP
+	}
+	return ""
+}
+
+func (x *Secret) GetFields() []*SecretField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Secret) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Secret) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *Secret) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *Secret) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Secret) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SecretRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	// Name (if ID not specified).
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PullRequest) Reset() {
-	*x = PullRequest{}
+func (x *SecretRequest) Reset() {
+	*x = SecretRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6037,13 +6345,13 @@ func (x *PullRequest) Reset() {
 	}
 }
 
-func (x *PullRequest) String() string {
+func (x *SecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullRequest) ProtoMessage() {}
+func (*SecretRequest) ProtoMessage() {}
 
-func (x *PullRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6055,28 +6363,42 @@ func (x *PullRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
-func (*PullRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
+func (*SecretRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *PullRequest) GetKey() string {
+func (x *SecretRequest) GetID() string {
 	if x != nil {
-		return x.Key
+		return x.ID
 	}
 	return ""
 }
 
-type PullResponse struct {
+func (x *SecretRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SecretResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KIDs []string `protobuf:"bytes,1,rep,name=kids,proto3" json:"kids,omitempty"`
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *PullResponse) Reset() {
-	*x = PullResponse{}
+func (x *SecretResponse) Reset() {
+	*x = SecretResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6084,13 +6406,13 @@ func (x *PullResponse) Reset() {
 	}
 }
 
-func (x *PullResponse) String() string {
+func (x *SecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullResponse) ProtoMessage() {}
+func (*SecretResponse) ProtoMessage() {}
 
-func (x *PullResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6102,29 +6424,30 @@ func (x *PullResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullResponse.ProtoReflect.Descriptor instead.
-func (*PullResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretResponse.ProtoReflect.Descriptor instead.
+func (*SecretResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *PullResponse) GetKIDs() []string {
+func (x *SecretResponse) GetSecret() *Secret {
 	if x != nil {
-		return x.KIDs
+		return x.Secret
 	}
 	return nil
 }
 
-type PushRequest struct {
+type SecretSaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	RemoteCheck bool   `protobuf:"varint,2,opt,name=remoteCheck,proto3" json:"remoteCheck,omitempty"`
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *PushRequest) Reset() {
-	*x = PushRequest{}
+func (x *SecretSaveRequest) Reset() {
+	*x = SecretSaveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6132,13 +6455,13 @@ func (x *PushRequest) Reset() {
 	}
 }
 
-func (x *PushRequest) String() string {
+func (x *SecretSaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushRequest) ProtoMessage() {}
+func (*SecretSaveRequest) ProtoMessage() {}
 
-func (x *PushRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretSaveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6150,36 +6473,35 @@ func (x *PushRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushRequest.ProtoReflect.Descriptor instead.
-func (*PushRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretSaveRequest.ProtoReflect.Descriptor instead.
+func (*SecretSaveRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *PushRequest) GetKey() string {
+func (x *SecretSaveRequest) GetSecret() *Secret {
 	if x != nil {
-		return x.Key
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-func (x *PushRequest) GetRemoteCheck() bool {
+func (x *SecretSaveRequest) GetCollection() string {
 	if x != nil {
-		return x.RemoteCheck
+		return x.Collection
 	}
-	return false
+	return ""
 }
 
-type PushResponse struct {
+type SecretSaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID  string   `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	URLs []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *PushResponse) Reset() {
-	*x = PushResponse{}
+func (x *SecretSaveResponse) Reset() {
+	*x = SecretSaveResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6187,13 +6509,13 @@ func (x *PushResponse) Reset() {
 	}
 }
 
-func (x *PushResponse) String() string {
+func (x *SecretSaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushResponse) ProtoMessage() {}
+func (*SecretSaveResponse) ProtoMessage() {}
 
-func (x *PushResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretSaveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6205,35 +6527,30 @@ func (x *PushResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushResponse.ProtoReflect.Descriptor instead.
-func (*PushResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretSaveResponse.ProtoReflect.Descriptor instead.
+func (*SecretSaveResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *PushResponse) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *PushResponse) GetURLs() []string {
+func (x *SecretSaveResponse) GetSecret() *Secret {
 	if x != nil {
-		return x.URLs
+		return x.Secret
 	}
 	return nil
 }
 
-type Collection struct {
+type SecretRemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *Collection) Reset() {
-	*x = Collection{}
+func (x *SecretRemoveRequest) Reset() {
+	*x = SecretRemoveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6241,13 +6558,13 @@ func (x *Collection) Reset() {
 	}
 }
 
-func (x *Collection) String() string {
+func (x *SecretRemoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Collection) ProtoMessage() {}
+func (*SecretRemoveRequest) ProtoMessage() {}
 
-func (x *Collection) ProtoReflect() protoreflect.Message {
+func (x *SecretRemoveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6259,30 +6576,33 @@ func (x *Collection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
-func (*Collection) Descriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use SecretRemoveRequest.ProtoReflect.Descriptor instead.
+func (*SecretRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *Collection) GetPath() string {
+func (x *SecretRemoveRequest) GetID() string {
 	if x != nil {
-		return x.Path
+		return x.ID
 	}
 	return ""
 }
 
-type CollectionsRequest struct {
+func (x *SecretRemoveRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type SecretRemoveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// DB is "service" or "vault".
-	DB string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
 }
 
-func (x *CollectionsRequest) Reset() {
-	*x = CollectionsRequest{}
+func (x *SecretRemoveResponse) Reset() {
+	*x = SecretRemoveResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6290,13 +6610,13 @@ func (x *CollectionsRequest) Reset() {
 	}
 }
 
-func (x *CollectionsRequest) String() string {
+func (x *SecretRemoveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectionsRequest) ProtoMessage() {}
+func (*SecretRemoveResponse) ProtoMessage() {}
 
-func (x *CollectionsRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretRemoveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6308,35 +6628,33 @@ func (x *CollectionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectionsRequest.ProtoReflect.Descriptor instead.
-func (*CollectionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretRemoveResponse.ProtoReflect.Descriptor instead.
+func (*SecretRemoveResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *CollectionsRequest) GetParent() string {
-	if x != nil {
-		return x.Parent
-	}
-	return ""
-}
-
-func (x *CollectionsRequest) GetDB() string {
-	if x != nil {
-		return x.DB
-	}
-	return ""
-}
-
-type CollectionsResponse struct {
+type SecretsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	// Query, can include "tag:" and "folder:" terms, for example,
+	// "tag:work folder:Servers ssh".
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	// Tags to match (all).
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Folder to match (including sub folders).
+	Folder string `protobuf:"bytes,4,opt,name=folder,proto3" json:"folder,omitempty"`
+	// SkipExpired to skip expired secrets.
+	SkipExpired   bool          `protobuf:"varint,5,opt,name=skipExpired,proto3" json:"skipExpired,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=keys.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *CollectionsResponse) Reset() {
-	*x = CollectionsResponse{}
+func (x *SecretsRequest) Reset() {
+	*x = SecretsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6344,13 +6662,13 @@ func (x *CollectionsResponse) Reset() {
 	}
 }
 
-func (x *CollectionsResponse) String() string {
+func (x *SecretsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectionsResponse) ProtoMessage() {}
+func (*SecretsRequest) ProtoMessage() {}
 
-func (x *CollectionsResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6362,31 +6680,72 @@ func (x *CollectionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectionsResponse.ProtoReflect.Descriptor instead.
-func (*CollectionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretsRequest.ProtoReflect.Descriptor instead.
+func (*SecretsRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *CollectionsResponse) GetCollections() []*Collection {
+func (x *SecretsRequest) GetQuery() string {
 	if x != nil {
-		return x.Collections
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SecretsRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SecretsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-type Document struct {
+func (x *SecretsRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *SecretsRequest) GetSkipExpired() bool {
+	if x != nil {
+		return x.SkipExpired
+	}
+	return false
+}
+
+func (x *SecretsRequest) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *SecretsRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortAsc
+}
+
+type SecretsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Value     string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	CreatedAt int64  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt int64  `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Secrets       []*Secret     `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=keys.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *Document) Reset() {
-	*x = Document{}
+func (x *SecretsResponse) Reset() {
+	*x = SecretsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6394,13 +6753,13 @@ func (x *Document) Reset() {
 	}
 }
 
-func (x *Document) String() string {
+func (x *SecretsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Document) ProtoMessage() {}
+func (*SecretsResponse) ProtoMessage() {}
 
-func (x *Document) ProtoReflect() protoreflect.Message {
+func (x *SecretsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6412,51 +6771,46 @@ func (x *Document) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Document.ProtoReflect.Descriptor instead.
-func (*Document) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretsResponse.ProtoReflect.Descriptor instead.
+func (*SecretsResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *Document) GetPath() string {
+func (x *SecretsResponse) GetSecrets() []*Secret {
 	if x != nil {
-		return x.Path
+		return x.Secrets
 	}
-	return ""
+	return nil
 }
 
-func (x *Document) GetValue() string {
+func (x *SecretsResponse) GetSortField() string {
 	if x != nil {
-		return x.Value
+		return x.SortField
 	}
 	return ""
 }
 
-func (x *Document) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *Document) GetUpdatedAt() int64 {
+func (x *SecretsResponse) GetSortDirection() SortDirection {
 	if x != nil {
-		return x.UpdatedAt
+		return x.SortDirection
 	}
-	return 0
+	return SortAsc
 }
 
-type DocumentsRequest struct {
+type SecretVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	// DB is "service" or "vault".
-	DB string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Secret at this version, not set if it was deleted.
+	Secret    *Secret `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Deleted   bool    `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	UpdatedAt int64   `protobuf:"varint,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *DocumentsRequest) Reset() {
-	*x = DocumentsRequest{}
+func (x *SecretVersion) Reset() {
+	*x = SecretVersion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6464,13 +6818,13 @@ func (x *DocumentsRequest) Reset() {
 	}
 }
 
-func (x *DocumentsRequest) String() string {
+func (x *SecretVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentsRequest) ProtoMessage() {}
+func (*SecretVersion) ProtoMessage() {}
 
-func (x *DocumentsRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6482,35 +6836,51 @@ func (x *DocumentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentsRequest.ProtoReflect.Descriptor instead.
-func (*DocumentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretVersion.ProtoReflect.Descriptor instead.
+func (*SecretVersion) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *DocumentsRequest) GetPrefix() string {
+func (x *SecretVersion) GetVersion() int64 {
 	if x != nil {
-		return x.Prefix
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *DocumentsRequest) GetDB() string {
+func (x *SecretVersion) GetSecret() *Secret {
 	if x != nil {
-		return x.DB
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-type DocumentsResponse struct {
+func (x *SecretVersion) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *SecretVersion) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SecretHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Documents []*Document `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *DocumentsResponse) Reset() {
-	*x = DocumentsResponse{}
+func (x *SecretHistoryRequest) Reset() {
+	*x = SecretHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6518,13 +6888,13 @@ func (x *DocumentsResponse) Reset() {
 	}
 }
 
-func (x *DocumentsResponse) String() string {
+func (x *SecretHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentsResponse) ProtoMessage() {}
+func (*SecretHistoryRequest) ProtoMessage() {}
 
-func (x *DocumentsResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6536,29 +6906,35 @@ func (x *DocumentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentsResponse.ProtoReflect.Descriptor instead.
-func (*DocumentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretHistoryRequest.ProtoReflect.Descriptor instead.
+func (*SecretHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *DocumentsResponse) GetDocuments() []*Document {
+func (x *SecretHistoryRequest) GetID() string {
 	if x != nil {
-		return x.Documents
+		return x.ID
 	}
-	return nil
+	return ""
 }
 
-type DocumentDeleteRequest struct {
+func (x *SecretHistoryRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type SecretHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	DB   string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
+	Versions []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *DocumentDeleteRequest) Reset() {
-	*x = DocumentDeleteRequest{}
+func (x *SecretHistoryResponse) Reset() {
+	*x = SecretHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6566,13 +6942,13 @@ func (x *DocumentDeleteRequest) Reset() {
 	}
 }
 
-func (x *DocumentDeleteRequest) String() string {
+func (x *SecretHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentDeleteRequest) ProtoMessage() {}
+func (*SecretHistoryResponse) ProtoMessage() {}
 
-func (x *DocumentDeleteRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6584,33 +6960,31 @@ func (x *DocumentDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentDeleteRequest.ProtoReflect.Descriptor instead.
-func (*DocumentDeleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretHistoryResponse.ProtoReflect.Descriptor instead.
+func (*SecretHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *DocumentDeleteRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *DocumentDeleteRequest) GetDB() string {
+func (x *SecretHistoryResponse) GetVersions() []*SecretVersion {
 	if x != nil {
-		return x.DB
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-type DocumentDeleteResponse struct {
+type SecretRestoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *DocumentDeleteResponse) Reset() {
-	*x = DocumentDeleteResponse{}
+func (x *SecretRestoreRequest) Reset() {
+	*x = SecretRestoreRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6618,13 +6992,13 @@ func (x *DocumentDeleteResponse) Reset() {
 	}
 }
 
-func (x *DocumentDeleteResponse) String() string {
+func (x *SecretRestoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentDeleteResponse) ProtoMessage() {}
+func (*SecretRestoreRequest) ProtoMessage() {}
 
-func (x *DocumentDeleteResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretRestoreRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6636,30 +7010,42 @@ func (x *DocumentDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentDeleteResponse.ProtoReflect.Descriptor instead.
-func (*DocumentDeleteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretRestoreRequest.ProtoReflect.Descriptor instead.
+func (*SecretRestoreRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{104}
 }
 
-type User struct {
+func (x *SecretRestoreRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *SecretRestoreRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SecretRestoreRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type SecretRestoreResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID         string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	KID        string     `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
-	Seq        int32      `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
-	Service    string     `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
-	URL        string     `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	Status     UserStatus `protobuf:"varint,10,opt,name=status,proto3,enum=keys.UserStatus" json:"status,omitempty"`
-	VerifiedAt int64      `protobuf:"varint,11,opt,name=verifiedAt,proto3" json:"verifiedAt,omitempty"`
-	Timestamp  int64      `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Err        string     `protobuf:"bytes,20,opt,name=err,proto3" json:"err,omitempty"`
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *SecretRestoreResponse) Reset() {
+	*x = SecretRestoreResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6667,13 +7053,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *SecretRestoreResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*SecretRestoreResponse) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *SecretRestoreResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6685,92 +7071,32 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretRestoreResponse.ProtoReflect.Descriptor instead.
+func (*SecretRestoreResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *User) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *User) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *User) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *User) GetSeq() int32 {
-	if x != nil {
-		return x.Seq
-	}
-	return 0
-}
-
-func (x *User) GetService() string {
-	if x != nil {
-		return x.Service
-	}
-	return ""
-}
-
-func (x *User) GetURL() string {
-	if x != nil {
-		return x.URL
-	}
-	return ""
-}
-
-func (x *User) GetStatus() UserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return UserStatusUnknown
-}
-
-func (x *User) GetVerifiedAt() int64 {
-	if x != nil {
-		return x.VerifiedAt
-	}
-	return 0
-}
-
-func (x *User) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *User) GetErr() string {
+func (x *SecretRestoreResponse) GetSecret() *Secret {
 	if x != nil {
-		return x.Err
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
-type UserRequest struct {
+type SecretOTPRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID   string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	Local bool   `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name (if ID not specified).
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *UserRequest) Reset() {
-	*x = UserRequest{}
+func (x *SecretOTPRequest) Reset() {
+	*x = SecretOTPRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6778,13 +7104,13 @@ func (x *UserRequest) Reset() {
 	}
 }
 
-func (x *UserRequest) String() string {
+func (x *SecretOTPRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRequest) ProtoMessage() {}
+func (*SecretOTPRequest) ProtoMessage() {}
 
-func (x *UserRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretOTPRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6796,35 +7122,48 @@ func (x *UserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
-func (*UserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretOTPRequest.ProtoReflect.Descriptor instead.
+func (*SecretOTPRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *UserRequest) GetKID() string {
+func (x *SecretOTPRequest) GetID() string {
 	if x != nil {
-		return x.KID
+		return x.ID
 	}
 	return ""
 }
 
-func (x *UserRequest) GetLocal() bool {
+func (x *SecretOTPRequest) GetName() string {
 	if x != nil {
-		return x.Local
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-type UserResponse struct {
+func (x *SecretOTPRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type SecretOTPResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Type is "totp" or "hotp".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Remaining (in milliseconds) the TOTP code is valid for.
+	Remaining int64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// Counter used for the HOTP code.
+	Counter int64 `protobuf:"varint,4,opt,name=counter,proto3" json:"counter,omitempty"`
 }
 
-func (x *UserResponse) Reset() {
-	*x = UserResponse{}
+func (x *SecretOTPResponse) Reset() {
+	*x = SecretOTPResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6832,13 +7171,13 @@ func (x *UserResponse) Reset() {
 	}
 }
 
-func (x *UserResponse) String() string {
+func (x *SecretOTPResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserResponse) ProtoMessage() {}
+func (*SecretOTPResponse) ProtoMessage() {}
 
-func (x *UserResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretOTPResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6850,30 +7189,55 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
-func (*UserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretOTPResponse.ProtoReflect.Descriptor instead.
+func (*SecretOTPResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *UserResponse) GetUser() *User {
+func (x *SecretOTPResponse) GetCode() string {
 	if x != nil {
-		return x.User
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type UserSearchRequest struct {
+func (x *SecretOTPResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SecretOTPResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *SecretOTPResponse) GetCounter() int64 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+type SecretImportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Local bool   `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
+	// Format is bitwarden, 1password, keepass or csv.
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	In     []byte `protobuf:"bytes,2,opt,name=in,proto3" json:"in,omitempty"`
+	// DryRun to return what would be imported, without saving.
+	DryRun bool `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *UserSearchRequest) Reset() {
-	*x = UserSearchRequest{}
+func (x *SecretImportRequest) Reset() {
+	*x = SecretImportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6881,13 +7245,13 @@ func (x *UserSearchRequest) Reset() {
 	}
 }
 
-func (x *UserSearchRequest) String() string {
+func (x *SecretImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSearchRequest) ProtoMessage() {}
+func (*SecretImportRequest) ProtoMessage() {}
 
-func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretImportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6899,42 +7263,52 @@ func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSearchRequest.ProtoReflect.Descriptor instead.
-func (*UserSearchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretImportRequest.ProtoReflect.Descriptor instead.
+func (*SecretImportRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *UserSearchRequest) GetQuery() string {
+func (x *SecretImportRequest) GetFormat() string {
 	if x != nil {
-		return x.Query
+		return x.Format
 	}
 	return ""
 }
 
-func (x *UserSearchRequest) GetLimit() int32 {
+func (x *SecretImportRequest) GetIn() []byte {
 	if x != nil {
-		return x.Limit
+		return x.In
 	}
-	return 0
+	return nil
 }
 
-func (x *UserSearchRequest) GetLocal() bool {
+func (x *SecretImportRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Local
+		return x.DryRun
 	}
 	return false
 }
 
-type UserSearchResponse struct {
+func (x *SecretImportRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type SecretImportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Added secrets (or secrets that would be added, with dryRun).
+	Added []*Secret `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	// Skipped secrets, with the same name and URL as an existing secret.
+	Skipped []*Secret `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
 }
 
-func (x *UserSearchResponse) Reset() {
-	*x = UserSearchResponse{}
+func (x *SecretImportResponse) Reset() {
+	*x = SecretImportResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6942,13 +7316,13 @@ func (x *UserSearchResponse) Reset() {
 	}
 }
 
-func (x *UserSearchResponse) String() string {
+func (x *SecretImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSearchResponse) ProtoMessage() {}
+func (*SecretImportResponse) ProtoMessage() {}
 
-func (x *UserSearchResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretImportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6960,28 +7334,50 @@ func (x *UserSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSearchResponse.ProtoReflect.Descriptor instead.
-func (*UserSearchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretImportResponse.ProtoReflect.Descriptor instead.
+func (*SecretImportResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *UserSearchResponse) GetUsers() []*User {
+func (x *SecretImportResponse) GetAdded() []*Secret {
 	if x != nil {
-		return x.Users
+		return x.Added
 	}
 	return nil
 }
 
-type SearchRequest struct {
+func (x *SecretImportResponse) GetSkipped() []*Secret {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type SecretsExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-}
-
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
+	// Format is json (encrypted to recipients) or csv (plaintext).
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// Recipients to encrypt to (json).
+	Recipients []string `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// Sender, or anonymous (json).
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Options for encrypt (json).
+	Options *EncryptOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// Types to export (or all if empty).
+	Types []SecretType `protobuf:"varint,10,rep,packed,name=types,proto3,enum=keys.SecretType" json:"types,omitempty"`
+	// Name to match (case-insensitive substring).
+	Name string `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,12,opt,name=collection,proto3" json:"collection,omitempty"`
+	// Plaintext is required to export unencrypted (csv).
+	Plaintext bool `protobuf:"varint,20,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+}
+
+func (x *SecretsExportRequest) Reset() {
+	*x = SecretsExportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6989,13 +7385,13 @@ func (x *SearchRequest) Reset() {
 	}
 }
 
-func (x *SearchRequest) String() string {
+func (x *SecretsExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest) ProtoMessage() {}
+func (*SecretsExportRequest) ProtoMessage() {}
 
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretsExportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7007,28 +7403,78 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretsExportRequest.ProtoReflect.Descriptor instead.
+func (*SecretsExportRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *SearchRequest) GetQuery() string {
+func (x *SecretsExportRequest) GetFormat() string {
 	if x != nil {
-		return x.Query
+		return x.Format
 	}
 	return ""
 }
 
-type SearchResponse struct {
+func (x *SecretsExportRequest) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *SecretsExportRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SecretsExportRequest) GetOptions() *EncryptOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SecretsExportRequest) GetTypes() []SecretType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SecretsExportRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretsExportRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SecretsExportRequest) GetPlaintext() bool {
+	if x != nil {
+		return x.Plaintext
+	}
+	return false
+}
+
+type SecretsExportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keys []*Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Data  []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *SearchResponse) Reset() {
-	*x = SearchResponse{}
+func (x *SecretsExportResponse) Reset() {
+	*x = SecretsExportResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7036,13 +7482,13 @@ func (x *SearchResponse) Reset() {
 	}
 }
 
-func (x *SearchResponse) String() string {
+func (x *SecretsExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResponse) ProtoMessage() {}
+func (*SecretsExportResponse) ProtoMessage() {}
 
-func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretsExportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7054,26 +7500,47 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
-func (*SearchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretsExportResponse.ProtoReflect.Descriptor instead.
+func (*SecretsExportResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *SearchResponse) GetKeys() []*Key {
+func (x *SecretsExportResponse) GetData() []byte {
 	if x != nil {
-		return x.Keys
+		return x.Data
 	}
 	return nil
 }
 
-type VaultSyncRequest struct {
+func (x *SecretsExportResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SecretsAuditRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *VaultSyncRequest) Reset() {
-	*x = VaultSyncRequest{}
+	// Breached is a path to breached password (SHA-1) hashes, one per line
+	// (optional).
+	Breached string `protobuf:"bytes,1,opt,name=breached,proto3" json:"breached,omitempty"`
+	// StaleDays is the number of days (since updated) a password is stale,
+	// defaults to 365.
+	StaleDays int32 `protobuf:"varint,2,opt,name=staleDays,proto3" json:"staleDays,omitempty"`
+	// MinEntropy is the minimum password entropy (bits), defaults to 60.
+	MinEntropy int32 `protobuf:"varint,3,opt,name=minEntropy,proto3" json:"minEntropy,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	// BreachedPrefix is the (5 character) hash prefix, if Breached is a range of
+	// hash suffixes, for example "5BAA6" (optional).
+	BreachedPrefix string `protobuf:"bytes,5,opt,name=breachedPrefix,proto3" json:"breachedPrefix,omitempty"`
+}
+
+func (x *SecretsAuditRequest) Reset() {
+	*x = SecretsAuditRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7081,13 +7548,13 @@ func (x *VaultSyncRequest) Reset() {
 	}
 }
 
-func (x *VaultSyncRequest) String() string {
+func (x *SecretsAuditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultSyncRequest) ProtoMessage() {}
+func (*SecretsAuditRequest) ProtoMessage() {}
 
-func (x *VaultSyncRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretsAuditRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7099,19 +7566,62 @@ func (x *VaultSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultSyncRequest.ProtoReflect.Descriptor instead.
-func (*VaultSyncRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretsAuditRequest.ProtoReflect.Descriptor instead.
+func (*SecretsAuditRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{112}
 }
 
-type VaultSyncResponse struct {
-	state         protoimpl.MessageState
+func (x *SecretsAuditRequest) GetBreached() string {
+	if x != nil {
+		return x.Breached
+	}
+	return ""
+}
+
+func (x *SecretsAuditRequest) GetStaleDays() int32 {
+	if x != nil {
+		return x.StaleDays
+	}
+	return 0
+}
+
+func (x *SecretsAuditRequest) GetMinEntropy() int32 {
+	if x != nil {
+		return x.MinEntropy
+	}
+	return 0
+}
+
+func (x *SecretsAuditRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SecretsAuditRequest) GetBreachedPrefix() string {
+	if x != nil {
+		return x.BreachedPrefix
+	}
+	return ""
+}
+
+type SecretsAuditFinding struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SecretID string `protobuf:"bytes,1,opt,name=secretId,proto3" json:"secretId,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Issue is breached, reused, weak, stale or missing-url.
+	Issue string `protobuf:"bytes,3,opt,name=issue,proto3" json:"issue,omitempty"`
+	// Severity is high, medium or low.
+	Severity string `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Detail   string `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
 }
 
-func (x *VaultSyncResponse) Reset() {
-	*x = VaultSyncResponse{}
+func (x *SecretsAuditFinding) Reset() {
+	*x = SecretsAuditFinding{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7119,13 +7629,13 @@ func (x *VaultSyncResponse) Reset() {
 	}
 }
 
-func (x *VaultSyncResponse) String() string {
+func (x *SecretsAuditFinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultSyncResponse) ProtoMessage() {}
+func (*SecretsAuditFinding) ProtoMessage() {}
 
-func (x *VaultSyncResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretsAuditFinding) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7137,19 +7647,57 @@ func (x *VaultSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultSyncResponse.ProtoReflect.Descriptor instead.
-func (*VaultSyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretsAuditFinding.ProtoReflect.Descriptor instead.
+func (*SecretsAuditFinding) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{113}
 }
 
-type VaultUnsyncRequest struct {
+func (x *SecretsAuditFinding) GetSecretID() string {
+	if x != nil {
+		return x.SecretID
+	}
+	return ""
+}
+
+func (x *SecretsAuditFinding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretsAuditFinding) GetIssue() string {
+	if x != nil {
+		return x.Issue
+	}
+	return ""
+}
+
+func (x *SecretsAuditFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *SecretsAuditFinding) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type SecretsAuditResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Findings sorted by severity (highest first).
+	Findings []*SecretsAuditFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
 }
 
-func (x *VaultUnsyncRequest) Reset() {
-	*x = VaultUnsyncRequest{}
+func (x *SecretsAuditResponse) Reset() {
+	*x = SecretsAuditResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7157,13 +7705,13 @@ func (x *VaultUnsyncRequest) Reset() {
 	}
 }
 
-func (x *VaultUnsyncRequest) String() string {
+func (x *SecretsAuditResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultUnsyncRequest) ProtoMessage() {}
+func (*SecretsAuditResponse) ProtoMessage() {}
 
-func (x *VaultUnsyncRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretsAuditResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7175,19 +7723,35 @@ func (x *VaultUnsyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultUnsyncRequest.ProtoReflect.Descriptor instead.
-func (*VaultUnsyncRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretsAuditResponse.ProtoReflect.Descriptor instead.
+func (*SecretsAuditResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{114}
 }
 
-type VaultUnsyncResponse struct {
+func (x *SecretsAuditResponse) GetFindings() []*SecretsAuditFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type SecretShareRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name (if ID not specified).
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Collection (optional) is a shared collection ID.
+	Collection string `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	// Expire is how long the share is available, for example, "10m",
+	// defaults to (and max is) 15m.
+	Expire string `protobuf:"bytes,4,opt,name=expire,proto3" json:"expire,omitempty"`
 }
 
-func (x *VaultUnsyncResponse) Reset() {
-	*x = VaultUnsyncResponse{}
+func (x *SecretShareRequest) Reset() {
+	*x = SecretShareRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7195,13 +7759,13 @@ func (x *VaultUnsyncResponse) Reset() {
 	}
 }
 
-func (x *VaultUnsyncResponse) String() string {
+func (x *SecretShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultUnsyncResponse) ProtoMessage() {}
+func (*SecretShareRequest) ProtoMessage() {}
 
-func (x *VaultUnsyncResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretShareRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7213,19 +7777,52 @@ func (x *VaultUnsyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultUnsyncResponse.ProtoReflect.Descriptor instead.
-func (*VaultUnsyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretShareRequest.ProtoReflect.Descriptor instead.
+func (*SecretShareRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{115}
 }
 
-type VaultAuthRequest struct {
+func (x *SecretShareRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *SecretShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretShareRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SecretShareRequest) GetExpire() string {
+	if x != nil {
+		return x.Expire
+	}
+	return ""
+}
+
+type SecretShareResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Phrase to receive the secret (only once).
+	Phrase string `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
+	// Expire time.
+	Expire int64 `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
 }
 
-func (x *VaultAuthRequest) Reset() {
-	*x = VaultAuthRequest{}
+func (x *SecretShareResponse) Reset() {
+	*x = SecretShareResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7233,13 +7830,13 @@ func (x *VaultAuthRequest) Reset() {
 	}
 }
 
-func (x *VaultAuthRequest) String() string {
+func (x *SecretShareResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultAuthRequest) ProtoMessage() {}
+func (*SecretShareResponse) ProtoMessage() {}
 
-func (x *VaultAuthRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretShareResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7251,21 +7848,39 @@ func (x *VaultAuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultAuthRequest.ProtoReflect.Descriptor instead.
-func (*VaultAuthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretShareResponse.ProtoReflect.Descriptor instead.
+func (*SecretShareResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{116}
 }
 
-type VaultAuthResponse struct {
+func (x *SecretShareResponse) GetPhrase() string {
+	if x != nil {
+		return x.Phrase
+	}
+	return ""
+}
+
+func (x *SecretShareResponse) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type SecretReceiveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Phrase string `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
+	// Save the secret to the vault.
+	Save bool `protobuf:"varint,2,opt,name=save,proto3" json:"save,omitempty"`
+	// Collection (optional) is a shared collection ID, to save to.
+	Collection string `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *VaultAuthResponse) Reset() {
-	*x = VaultAuthResponse{}
+func (x *SecretReceiveRequest) Reset() {
+	*x = SecretReceiveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7273,13 +7888,13 @@ func (x *VaultAuthResponse) Reset() {
 	}
 }
 
-func (x *VaultAuthResponse) String() string {
+func (x *SecretReceiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultAuthResponse) ProtoMessage() {}
+func (*SecretReceiveRequest) ProtoMessage() {}
 
-func (x *VaultAuthResponse) ProtoReflect() protoreflect.Message {
+func (x *SecretReceiveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7291,26 +7906,42 @@ func (x *VaultAuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultAuthResponse.ProtoReflect.Descriptor instead.
-func (*VaultAuthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretReceiveRequest.ProtoReflect.Descriptor instead.
+func (*SecretReceiveRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *VaultAuthResponse) GetPhrase() string {
+func (x *SecretReceiveRequest) GetPhrase() string {
 	if x != nil {
 		return x.Phrase
 	}
 	return ""
 }
 
-type VaultStatusRequest struct {
+func (x *SecretReceiveRequest) GetSave() bool {
+	if x != nil {
+		return x.Save
+	}
+	return false
+}
+
+func (x *SecretReceiveRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type SecretReceiveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *VaultStatusRequest) Reset() {
-	*x = VaultStatusRequest{}
+func (x *SecretReceiveResponse) Reset() {
+	*x = SecretReceiveResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7318,13 +7949,13 @@ func (x *VaultStatusRequest) Reset() {
 	}
 }
 
-func (x *VaultStatusRequest) String() string {
+func (x *SecretReceiveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultStatusRequest) ProtoMessage() {}
+func (*SecretReceiveResponse) ProtoMessage() {}
 
-func (x *VaultStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *SecretReceiveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7336,22 +7967,33 @@ func (x *VaultStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultStatusRequest.ProtoReflect.Descriptor instead.
-func (*VaultStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretReceiveResponse.ProtoReflect.Descriptor instead.
+func (*SecretReceiveResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{118}
 }
 
-type VaultStatusResponse struct {
+func (x *SecretReceiveResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type Attachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID      string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	SyncedAt int64  `protobuf:"varint,2,opt,name=syncedAt,proto3" json:"syncedAt,omitempty"`
+	ID        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size      int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Digest    []byte `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	CreatedAt int64  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *VaultStatusResponse) Reset() {
-	*x = VaultStatusResponse{}
+func (x *Attachment) Reset() {
+	*x = Attachment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7359,13 +8001,13 @@ func (x *VaultStatusResponse) Reset() {
 	}
 }
 
-func (x *VaultStatusResponse) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultStatusResponse) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *VaultStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *Attachment) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7377,33 +8019,68 @@ func (x *VaultStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultStatusResponse.ProtoReflect.Descriptor instead.
-func (*VaultStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *VaultStatusResponse) GetKID() string {
+func (x *Attachment) GetID() string {
 	if x != nil {
-		return x.KID
+		return x.ID
 	}
 	return ""
 }
 
-func (x *VaultStatusResponse) GetSyncedAt() int64 {
+func (x *Attachment) GetName() string {
 	if x != nil {
-		return x.SyncedAt
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
 	}
 	return 0
 }
 
-type VaultUpdateRequest struct {
+func (x *Attachment) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *Attachment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Attachment) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type AttachmentPutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// ID to replace an existing attachment, or empty for a new attachment.
+	// Only set in the first request.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name, only set in the first request.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *VaultUpdateRequest) Reset() {
-	*x = VaultUpdateRequest{}
+func (x *AttachmentPutRequest) Reset() {
+	*x = AttachmentPutRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7411,13 +8088,13 @@ func (x *VaultUpdateRequest) Reset() {
 	}
 }
 
-func (x *VaultUpdateRequest) String() string {
+func (x *AttachmentPutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultUpdateRequest) ProtoMessage() {}
+func (*AttachmentPutRequest) ProtoMessage() {}
 
-func (x *VaultUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *AttachmentPutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7429,19 +8106,42 @@ func (x *VaultUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultUpdateRequest.ProtoReflect.Descriptor instead.
-func (*VaultUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachmentPutRequest.ProtoReflect.Descriptor instead.
+func (*AttachmentPutRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{120}
 }
 
-type VaultUpdateResponse struct {
+func (x *AttachmentPutRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *AttachmentPutRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttachmentPutRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AttachmentPutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
 }
 
-func (x *VaultUpdateResponse) Reset() {
-	*x = VaultUpdateResponse{}
+func (x *AttachmentPutResponse) Reset() {
+	*x = AttachmentPutResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7449,13 +8149,13 @@ func (x *VaultUpdateResponse) Reset() {
 	}
 }
 
-func (x *VaultUpdateResponse) String() string {
+func (x *AttachmentPutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultUpdateResponse) ProtoMessage() {}
+func (*AttachmentPutResponse) ProtoMessage() {}
 
-func (x *VaultUpdateResponse) ProtoReflect() protoreflect.Message {
+func (x *AttachmentPutResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7467,25 +8167,28 @@ func (x *VaultUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultUpdateResponse.ProtoReflect.Descriptor instead.
-func (*VaultUpdateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachmentPutResponse.ProtoReflect.Descriptor instead.
+func (*AttachmentPutResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{121}
 }
 
-type Message struct {
+func (x *AttachmentPutResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type AttachmentGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sender    *Key          `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Text      []string      `protobuf:"bytes,10,rep,name=text,proto3" json:"text,omitempty"`
-	Status    MessageStatus `protobuf:"varint,20,opt,name=status,proto3,enum=keys.MessageStatus" json:"status,omitempty"`
-	CreatedAt int64         `protobuf:"varint,31,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *AttachmentGetRequest) Reset() {
+	*x = AttachmentGetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7493,13 +8196,13 @@ func (x *Message) Reset() {
 	}
 }
 
-func (x *Message) String() string {
+func (x *AttachmentGetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*AttachmentGetRequest) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
+func (x *AttachmentGetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7511,58 +8214,30 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachmentGetRequest.ProtoReflect.Descriptor instead.
+func (*AttachmentGetRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *Message) GetID() string {
+func (x *AttachmentGetRequest) GetID() string {
 	if x != nil {
 		return x.ID
 	}
 	return ""
 }
 
-func (x *Message) GetSender() *Key {
-	if x != nil {
-		return x.Sender
-	}
-	return nil
-}
-
-func (x *Message) GetText() []string {
-	if x != nil {
-		return x.Text
-	}
-	return nil
-}
-
-func (x *Message) GetStatus() MessageStatus {
-	if x != nil {
-		return x.Status
-	}
-	return MessageSent
-}
-
-func (x *Message) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-type MessagePrepareRequest struct {
+type AttachmentGetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	Text    string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+	// Attachment, only set in the first response.
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Data       []byte      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *MessagePrepareRequest) Reset() {
-	*x = MessagePrepareRequest{}
+func (x *AttachmentGetResponse) Reset() {
+	*x = AttachmentGetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7570,13 +8245,13 @@ func (x *MessagePrepareRequest) Reset() {
 	}
 }
 
-func (x *MessagePrepareRequest) String() string {
+func (x *AttachmentGetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagePrepareRequest) ProtoMessage() {}
+func (*AttachmentGetResponse) ProtoMessage() {}
 
-func (x *MessagePrepareRequest) ProtoReflect() protoreflect.Message {
+func (x *AttachmentGetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7588,42 +8263,38 @@ func (x *MessagePrepareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagePrepareRequest.ProtoReflect.Descriptor instead.
-func (*MessagePrepareRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachmentGetResponse.ProtoReflect.Descriptor instead.
+func (*AttachmentGetResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *MessagePrepareRequest) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *MessagePrepareRequest) GetChannel() string {
+func (x *AttachmentGetResponse) GetAttachment() *Attachment {
 	if x != nil {
-		return x.Channel
+		return x.Attachment
 	}
-	return ""
+	return nil
 }
 
-func (x *MessagePrepareRequest) GetText() string {
+func (x *AttachmentGetResponse) GetData() []byte {
 	if x != nil {
-		return x.Text
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type MessagePrepareResponse struct {
+type RandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	NumBytes  int32    `protobuf:"varint,1,opt,name=numBytes,proto3" json:"numBytes,omitempty"`
+	Encoding  Encoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=keys.Encoding" json:"encoding,omitempty"`
+	NoPadding bool     `protobuf:"varint,10,opt,name=noPadding,proto3" json:"noPadding,omitempty"`
+	Lowercase bool     `protobuf:"varint,11,opt,name=lowercase,proto3" json:"lowercase,omitempty"`
 }
 
-func (x *MessagePrepareResponse) Reset() {
-	*x = MessagePrepareResponse{}
+func (x *RandRequest) Reset() {
+	*x = RandRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7631,13 +8302,13 @@ func (x *MessagePrepareResponse) Reset() {
 	}
 }
 
-func (x *MessagePrepareResponse) String() string {
+func (x *RandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagePrepareResponse) ProtoMessage() {}
+func (*RandRequest) ProtoMessage() {}
 
-func (x *MessagePrepareResponse) ProtoReflect() protoreflect.Message {
+func (x *RandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7649,33 +8320,49 @@ func (x *MessagePrepareResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagePrepareResponse.ProtoReflect.Descriptor instead.
-func (*MessagePrepareResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandRequest.ProtoReflect.Descriptor instead.
+func (*RandRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *MessagePrepareResponse) GetMessage() *Message {
+func (x *RandRequest) GetNumBytes() int32 {
 	if x != nil {
-		return x.Message
+		return x.NumBytes
 	}
-	return nil
+	return 0
 }
 
-type MessageCreateRequest struct {
+func (x *RandRequest) GetEncoding() Encoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return Hex
+}
+
+func (x *RandRequest) GetNoPadding() bool {
+	if x != nil {
+		return x.NoPadding
+	}
+	return false
+}
+
+func (x *RandRequest) GetLowercase() bool {
+	if x != nil {
+		return x.Lowercase
+	}
+	return false
+}
+
+type RandResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	// ID is optional, if message was prepared you can use that ID, otherwise it
-	// is autogenerated.
-	ID   string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Text string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *MessageCreateRequest) Reset() {
-	*x = MessageCreateRequest{}
+func (x *RandResponse) Reset() {
+	*x = RandResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7683,13 +8370,13 @@ func (x *MessageCreateRequest) Reset() {
 	}
 }
 
-func (x *MessageCreateRequest) String() string {
+func (x *RandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageCreateRequest) ProtoMessage() {}
+func (*RandResponse) ProtoMessage() {}
 
-func (x *MessageCreateRequest) ProtoReflect() protoreflect.Message {
+func (x *RandResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7701,49 +8388,28 @@ func (x *MessageCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageCreateRequest.ProtoReflect.Descriptor instead.
-func (*MessageCreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandResponse.ProtoReflect.Descriptor instead.
+func (*RandResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *MessageCreateRequest) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *MessageCreateRequest) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *MessageCreateRequest) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *MessageCreateRequest) GetText() string {
+func (x *RandResponse) GetData() string {
 	if x != nil {
-		return x.Text
+		return x.Data
 	}
 	return ""
 }
 
-type MessageCreateResponse struct {
+type RandPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Length int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
 }
 
-func (x *MessageCreateResponse) Reset() {
-	*x = MessageCreateResponse{}
+func (x *RandPasswordRequest) Reset() {
+	*x = RandPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7751,13 +8417,13 @@ func (x *MessageCreateResponse) Reset() {
 	}
 }
 
-func (x *MessageCreateResponse) String() string {
+func (x *RandPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageCreateResponse) ProtoMessage() {}
+func (*RandPasswordRequest) ProtoMessage() {}
 
-func (x *MessageCreateResponse) ProtoReflect() protoreflect.Message {
+func (x *RandPasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7769,31 +8435,28 @@ func (x *MessageCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageCreateResponse.ProtoReflect.Descriptor instead.
-func (*MessageCreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandPasswordRequest.ProtoReflect.Descriptor instead.
+func (*RandPasswordRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *MessageCreateResponse) GetMessage() *Message {
+func (x *RandPasswordRequest) GetLength() int32 {
 	if x != nil {
-		return x.Message
+		return x.Length
 	}
-	return nil
+	return 0
 }
 
-type MessagesRequest struct {
+type RandPasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	User    string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	// Update, if true will update from the server.
-	Update bool `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *MessagesRequest) Reset() {
-	*x = MessagesRequest{}
+func (x *RandPasswordResponse) Reset() {
+	*x = RandPasswordResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7801,13 +8464,13 @@ func (x *MessagesRequest) Reset() {
 	}
 }
 
-func (x *MessagesRequest) String() string {
+func (x *RandPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagesRequest) ProtoMessage() {}
+func (*RandPasswordResponse) ProtoMessage() {}
 
-func (x *MessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *RandPasswordResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7819,42 +8482,28 @@ func (x *MessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagesRequest.ProtoReflect.Descriptor instead.
-func (*MessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandPasswordResponse.ProtoReflect.Descriptor instead.
+func (*RandPasswordResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *MessagesRequest) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *MessagesRequest) GetUser() string {
+func (x *RandPasswordResponse) GetPassword() string {
 	if x != nil {
-		return x.User
+		return x.Password
 	}
 	return ""
 }
 
-func (x *MessagesRequest) GetUpdate() bool {
-	if x != nil {
-		return x.Update
-	}
-	return false
-}
-
-type MessagesResponse struct {
+type PullRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *MessagesResponse) Reset() {
-	*x = MessagesResponse{}
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7862,13 +8511,13 @@ func (x *MessagesResponse) Reset() {
 	}
 }
 
-func (x *MessagesResponse) String() string {
+func (x *PullRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagesResponse) ProtoMessage() {}
+func (*PullRequest) ProtoMessage() {}
 
-func (x *MessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7880,26 +8529,28 @@ func (x *MessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagesResponse.ProtoReflect.Descriptor instead.
-func (*MessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *MessagesResponse) GetMessages() []*Message {
+func (x *PullRequest) GetKey() string {
 	if x != nil {
-		return x.Messages
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type NotifyStreamRequest struct {
+type PullResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	KIDs []string `protobuf:"bytes,1,rep,name=kids,proto3" json:"kids,omitempty"`
 }
 
-func (x *NotifyStreamRequest) Reset() {
-	*x = NotifyStreamRequest{}
+func (x *PullResponse) Reset() {
+	*x = PullResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7907,13 +8558,13 @@ func (x *NotifyStreamRequest) Reset() {
 	}
 }
 
-func (x *NotifyStreamRequest) String() string {
+func (x *PullResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotifyStreamRequest) ProtoMessage() {}
+func (*PullResponse) ProtoMessage() {}
 
-func (x *NotifyStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *PullResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7925,24 +8576,29 @@ func (x *NotifyStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotifyStreamRequest.ProtoReflect.Descriptor instead.
-func (*NotifyStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullResponse.ProtoReflect.Descriptor instead.
+func (*PullResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{129}
 }
 
-type NotifyStreamOutput struct {
+func (x *PullResponse) GetKIDs() []string {
+	if x != nil {
+		return x.KIDs
+	}
+	return nil
+}
+
+type PushRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    NotificationType `protobuf:"varint,1,opt,name=type,proto3,enum=keys.NotificationType" json:"type,omitempty"`
-	Channel string           `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	User    string           `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Index   int64            `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	RemoteCheck bool   `protobuf:"varint,2,opt,name=remoteCheck,proto3" json:"remoteCheck,omitempty"`
 }
 
-func (x *NotifyStreamOutput) Reset() {
-	*x = NotifyStreamOutput{}
+func (x *PushRequest) Reset() {
+	*x = PushRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7950,13 +8606,13 @@ func (x *NotifyStreamOutput) Reset() {
 	}
 }
 
-func (x *NotifyStreamOutput) String() string {
+func (x *PushRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotifyStreamOutput) ProtoMessage() {}
+func (*PushRequest) ProtoMessage() {}
 
-func (x *NotifyStreamOutput) ProtoReflect() protoreflect.Message {
+func (x *PushRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7968,53 +8624,36 @@ func (x *NotifyStreamOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotifyStreamOutput.ProtoReflect.Descriptor instead.
-func (*NotifyStreamOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushRequest.ProtoReflect.Descriptor instead.
+func (*PushRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *NotifyStreamOutput) GetType() NotificationType {
-	if x != nil {
-		return x.Type
-	}
-	return UnspecifiedNotification
-}
-
-func (x *NotifyStreamOutput) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *NotifyStreamOutput) GetUser() string {
+func (x *PushRequest) GetKey() string {
 	if x != nil {
-		return x.User
+		return x.Key
 	}
 	return ""
 }
 
-func (x *NotifyStreamOutput) GetIndex() int64 {
+func (x *PushRequest) GetRemoteCheck() bool {
 	if x != nil {
-		return x.Index
+		return x.RemoteCheck
 	}
-	return 0
+	return false
 }
 
-type Channel struct {
+type PushResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Snippet   string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
-	UpdatedAt int64  `protobuf:"varint,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Index     int64  `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	KID  string   `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	URLs []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
 }
 
-func (x *Channel) Reset() {
-	*x = Channel{}
+func (x *PushResponse) Reset() {
+	*x = PushResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8022,13 +8661,13 @@ func (x *Channel) Reset() {
 	}
 }
 
-func (x *Channel) String() string {
+func (x *PushResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Channel) ProtoMessage() {}
+func (*PushResponse) ProtoMessage() {}
 
-func (x *Channel) ProtoReflect() protoreflect.Message {
+func (x *PushResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8040,56 +8679,35 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
-func (*Channel) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushResponse.ProtoReflect.Descriptor instead.
+func (*PushResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *Channel) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *Channel) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Channel) GetSnippet() string {
+func (x *PushResponse) GetKID() string {
 	if x != nil {
-		return x.Snippet
+		return x.KID
 	}
 	return ""
 }
 
-func (x *Channel) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *Channel) GetIndex() int64 {
+func (x *PushResponse) GetURLs() []string {
 	if x != nil {
-		return x.Index
+		return x.URLs
 	}
-	return 0
+	return nil
 }
 
-type ChannelsRequest struct {
+type Collection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *ChannelsRequest) Reset() {
-	*x = ChannelsRequest{}
+func (x *Collection) Reset() {
+	*x = Collection{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8097,13 +8715,13 @@ func (x *ChannelsRequest) Reset() {
 	}
 }
 
-func (x *ChannelsRequest) String() string {
+func (x *Collection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelsRequest) ProtoMessage() {}
+func (*Collection) ProtoMessage() {}
 
-func (x *ChannelsRequest) ProtoReflect() protoreflect.Message {
+func (x *Collection) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8115,28 +8733,30 @@ func (x *ChannelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelsRequest.ProtoReflect.Descriptor instead.
-func (*ChannelsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *ChannelsRequest) GetUser() string {
+func (x *Collection) GetPath() string {
 	if x != nil {
-		return x.User
+		return x.Path
 	}
 	return ""
 }
 
-type ChannelsResponse struct {
+type CollectionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// DB is "service" or "vault".
+	DB string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
 }
 
-func (x *ChannelsResponse) Reset() {
-	*x = ChannelsResponse{}
+func (x *CollectionsRequest) Reset() {
+	*x = CollectionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8144,13 +8764,13 @@ func (x *ChannelsResponse) Reset() {
 	}
 }
 
-func (x *ChannelsResponse) String() string {
+func (x *CollectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelsResponse) ProtoMessage() {}
+func (*CollectionsRequest) ProtoMessage() {}
 
-func (x *ChannelsResponse) ProtoReflect() protoreflect.Message {
+func (x *CollectionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8162,29 +8782,35 @@ func (x *ChannelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelsResponse.ProtoReflect.Descriptor instead.
-func (*ChannelsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectionsRequest.ProtoReflect.Descriptor instead.
+func (*CollectionsRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *ChannelsResponse) GetChannels() []*Channel {
+func (x *CollectionsRequest) GetParent() string {
 	if x != nil {
-		return x.Channels
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-type ChannelCreateRequest struct {
+func (x *CollectionsRequest) GetDB() string {
+	if x != nil {
+		return x.DB
+	}
+	return ""
+}
+
+type CollectionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
 }
 
-func (x *ChannelCreateRequest) Reset() {
-	*x = ChannelCreateRequest{}
+func (x *CollectionsResponse) Reset() {
+	*x = CollectionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8192,13 +8818,13 @@ func (x *ChannelCreateRequest) Reset() {
 	}
 }
 
-func (x *ChannelCreateRequest) String() string {
+func (x *CollectionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelCreateRequest) ProtoMessage() {}
+func (*CollectionsResponse) ProtoMessage() {}
 
-func (x *ChannelCreateRequest) ProtoReflect() protoreflect.Message {
+func (x *CollectionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8210,35 +8836,31 @@ func (x *ChannelCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelCreateRequest.ProtoReflect.Descriptor instead.
-func (*ChannelCreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectionsResponse.ProtoReflect.Descriptor instead.
+func (*CollectionsResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *ChannelCreateRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ChannelCreateRequest) GetUser() string {
+func (x *CollectionsResponse) GetCollections() []*Collection {
 	if x != nil {
-		return x.User
+		return x.Collections
 	}
-	return ""
+	return nil
 }
 
-type ChannelCreateResponse struct {
+type Document struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel *Channel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Value     string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAt int64  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *ChannelCreateResponse) Reset() {
-	*x = ChannelCreateResponse{}
+func (x *Document) Reset() {
+	*x = Document{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8246,13 +8868,13 @@ func (x *ChannelCreateResponse) Reset() {
 	}
 }
 
-func (x *ChannelCreateResponse) String() string {
+func (x *Document) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelCreateResponse) ProtoMessage() {}
+func (*Document) ProtoMessage() {}
 
-func (x *ChannelCreateResponse) ProtoReflect() protoreflect.Message {
+func (x *Document) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8264,44 +8886,65 @@ func (x *ChannelCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelCreateResponse.ProtoReflect.Descriptor instead.
-func (*ChannelCreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *ChannelCreateResponse) GetChannel() *Channel {
+func (x *Document) GetPath() string {
 	if x != nil {
-		return x.Channel
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-type ChannelInvitesCreateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Document) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
 
-	Channel    string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Sender     string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+func (x *Document) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *ChannelInvitesCreateRequest) Reset() {
-	*x = ChannelInvitesCreateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_keys_proto_msgTypes[136]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Document) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
 	}
+	return 0
 }
 
-func (x *ChannelInvitesCreateRequest) String() string {
+type DocumentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// DB is "service" or "vault".
+	DB string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
+}
+
+func (x *DocumentsRequest) Reset() {
+	*x = DocumentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInvitesCreateRequest) ProtoMessage() {}
+func (*DocumentsRequest) ProtoMessage() {}
 
-func (x *ChannelInvitesCreateRequest) ProtoReflect() protoreflect.Message {
+func (x *DocumentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8313,40 +8956,35 @@ func (x *ChannelInvitesCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInvitesCreateRequest.ProtoReflect.Descriptor instead.
-func (*ChannelInvitesCreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentsRequest.ProtoReflect.Descriptor instead.
+func (*DocumentsRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *ChannelInvitesCreateRequest) GetChannel() string {
+func (x *DocumentsRequest) GetPrefix() string {
 	if x != nil {
-		return x.Channel
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *ChannelInvitesCreateRequest) GetSender() string {
+func (x *DocumentsRequest) GetDB() string {
 	if x != nil {
-		return x.Sender
+		return x.DB
 	}
 	return ""
 }
 
-func (x *ChannelInvitesCreateRequest) GetRecipients() []string {
-	if x != nil {
-		return x.Recipients
-	}
-	return nil
-}
-
-type ChannelInvitesCreateResponse struct {
+type DocumentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Documents []*Document `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
 }
 
-func (x *ChannelInvitesCreateResponse) Reset() {
-	*x = ChannelInvitesCreateResponse{}
+func (x *DocumentsResponse) Reset() {
+	*x = DocumentsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8354,13 +8992,13 @@ func (x *ChannelInvitesCreateResponse) Reset() {
 	}
 }
 
-func (x *ChannelInvitesCreateResponse) String() string {
+func (x *DocumentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInvitesCreateResponse) ProtoMessage() {}
+func (*DocumentsResponse) ProtoMessage() {}
 
-func (x *ChannelInvitesCreateResponse) ProtoReflect() protoreflect.Message {
+func (x *DocumentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8372,22 +9010,29 @@ func (x *ChannelInvitesCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInvitesCreateResponse.ProtoReflect.Descriptor instead.
-func (*ChannelInvitesCreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentsResponse.ProtoReflect.Descriptor instead.
+func (*DocumentsResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{137}
 }
 
-type ChannelInviteAcceptRequest struct {
+func (x *DocumentsResponse) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type DocumentDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	User    string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	DB   string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
 }
 
-func (x *ChannelInviteAcceptRequest) Reset() {
-	*x = ChannelInviteAcceptRequest{}
+func (x *DocumentDeleteRequest) Reset() {
+	*x = DocumentDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8395,13 +9040,13 @@ func (x *ChannelInviteAcceptRequest) Reset() {
 	}
 }
 
-func (x *ChannelInviteAcceptRequest) String() string {
+func (x *DocumentDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInviteAcceptRequest) ProtoMessage() {}
+func (*DocumentDeleteRequest) ProtoMessage() {}
 
-func (x *ChannelInviteAcceptRequest) ProtoReflect() protoreflect.Message {
+func (x *DocumentDeleteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8413,33 +9058,33 @@ func (x *ChannelInviteAcceptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInviteAcceptRequest.ProtoReflect.Descriptor instead.
-func (*ChannelInviteAcceptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentDeleteRequest.ProtoReflect.Descriptor instead.
+func (*DocumentDeleteRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *ChannelInviteAcceptRequest) GetChannel() string {
+func (x *DocumentDeleteRequest) GetPath() string {
 	if x != nil {
-		return x.Channel
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ChannelInviteAcceptRequest) GetUser() string {
+func (x *DocumentDeleteRequest) GetDB() string {
 	if x != nil {
-		return x.User
+		return x.DB
 	}
 	return ""
 }
 
-type ChannelInviteAcceptResponse struct {
+type DocumentDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ChannelInviteAcceptResponse) Reset() {
-	*x = ChannelInviteAcceptResponse{}
+func (x *DocumentDeleteResponse) Reset() {
+	*x = DocumentDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8447,13 +9092,13 @@ func (x *ChannelInviteAcceptResponse) Reset() {
 	}
 }
 
-func (x *ChannelInviteAcceptResponse) String() string {
+func (x *DocumentDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInviteAcceptResponse) ProtoMessage() {}
+func (*DocumentDeleteResponse) ProtoMessage() {}
 
-func (x *ChannelInviteAcceptResponse) ProtoReflect() protoreflect.Message {
+func (x *DocumentDeleteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8465,23 +9110,30 @@ func (x *ChannelInviteAcceptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInviteAcceptResponse.ProtoReflect.Descriptor instead.
-func (*ChannelInviteAcceptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentDeleteResponse.ProtoReflect.Descriptor instead.
+func (*DocumentDeleteResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{139}
 }
 
-type AdminSignURLRequest struct {
+type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	URL    string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ID         string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	KID        string     `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	Seq        int32      `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	Service    string     `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	URL        string     `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Status     UserStatus `protobuf:"varint,10,opt,name=status,proto3,enum=keys.UserStatus" json:"status,omitempty"`
+	VerifiedAt int64      `protobuf:"varint,11,opt,name=verifiedAt,proto3" json:"verifiedAt,omitempty"`
+	Timestamp  int64      `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Err        string     `protobuf:"bytes,20,opt,name=err,proto3" json:"err,omitempty"`
 }
 
-func (x *AdminSignURLRequest) Reset() {
-	*x = AdminSignURLRequest{}
+func (x *User) Reset() {
+	*x = User{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8489,13 +9141,13 @@ func (x *AdminSignURLRequest) Reset() {
 	}
 }
 
-func (x *AdminSignURLRequest) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSignURLRequest) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *AdminSignURLRequest) ProtoReflect() protoreflect.Message {
+func (x *User) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8507,44 +9159,92 @@ func (x *AdminSignURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSignURLRequest.ProtoReflect.Descriptor instead.
-func (*AdminSignURLRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *AdminSignURLRequest) GetSigner() string {
+func (x *User) GetID() string {
 	if x != nil {
-		return x.Signer
+		return x.ID
 	}
 	return ""
 }
 
-func (x *AdminSignURLRequest) GetMethod() string {
+func (x *User) GetName() string {
 	if x != nil {
-		return x.Method
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AdminSignURLRequest) GetURL() string {
+func (x *User) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
+}
+
+func (x *User) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *User) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *User) GetURL() string {
 	if x != nil {
 		return x.URL
 	}
 	return ""
 }
 
-type AdminSignURLResponse struct {
+func (x *User) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatusUnknown
+}
+
+func (x *User) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+func (x *User) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *User) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+type UserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth string `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
-	URL  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	CURL string `protobuf:"bytes,3,opt,name=curl,proto3" json:"curl,omitempty"`
+	KID   string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Local bool   `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *AdminSignURLResponse) Reset() {
-	*x = AdminSignURLResponse{}
+func (x *UserRequest) Reset() {
+	*x = UserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8552,13 +9252,13 @@ func (x *AdminSignURLResponse) Reset() {
 	}
 }
 
-func (x *AdminSignURLResponse) String() string {
+func (x *UserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSignURLResponse) ProtoMessage() {}
+func (*UserRequest) ProtoMessage() {}
 
-func (x *AdminSignURLResponse) ProtoReflect() protoreflect.Message {
+func (x *UserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8570,43 +9270,35 @@ func (x *AdminSignURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSignURLResponse.ProtoReflect.Descriptor instead.
-func (*AdminSignURLResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
+func (*UserRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *AdminSignURLResponse) GetAuth() string {
-	if x != nil {
-		return x.Auth
-	}
-	return ""
-}
-
-func (x *AdminSignURLResponse) GetURL() string {
+func (x *UserRequest) GetKID() string {
 	if x != nil {
-		return x.URL
+		return x.KID
 	}
 	return ""
 }
 
-func (x *AdminSignURLResponse) GetCURL() string {
+func (x *UserRequest) GetLocal() bool {
 	if x != nil {
-		return x.CURL
+		return x.Local
 	}
-	return ""
+	return false
 }
 
-type AdminCheckRequest struct {
+type UserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Check  string `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *AdminCheckRequest) Reset() {
-	*x = AdminCheckRequest{}
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8614,13 +9306,13 @@ func (x *AdminCheckRequest) Reset() {
 	}
 }
 
-func (x *AdminCheckRequest) String() string {
+func (x *UserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminCheckRequest) ProtoMessage() {}
+func (*UserResponse) ProtoMessage() {}
 
-func (x *AdminCheckRequest) ProtoReflect() protoreflect.Message {
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8632,33 +9324,30 @@ func (x *AdminCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminCheckRequest.ProtoReflect.Descriptor instead.
-func (*AdminCheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *AdminCheckRequest) GetSigner() string {
-	if x != nil {
-		return x.Signer
-	}
-	return ""
-}
-
-func (x *AdminCheckRequest) GetCheck() string {
+func (x *UserResponse) GetUser() *User {
 	if x != nil {
-		return x.Check
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-type AdminCheckResponse struct {
+type UserSearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Local bool   `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *AdminCheckResponse) Reset() {
-	*x = AdminCheckResponse{}
+func (x *UserSearchRequest) Reset() {
+	*x = UserSearchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8666,13 +9355,13 @@ func (x *AdminCheckResponse) Reset() {
 	}
 }
 
-func (x *AdminCheckResponse) String() string {
+func (x *UserSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminCheckResponse) ProtoMessage() {}
+func (*UserSearchRequest) ProtoMessage() {}
 
-func (x *AdminCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8684,23 +9373,42 @@ func (x *AdminCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminCheckResponse.ProtoReflect.Descriptor instead.
-func (*AdminCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserSearchRequest.ProtoReflect.Descriptor instead.
+func (*UserSearchRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{143}
 }
 
-type Config struct {
+func (x *UserSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *UserSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *UserSearchRequest) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+type UserSearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	App     *Config_App     `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
-	Encrypt *Config_Encrypt `protobuf:"bytes,10,opt,name=encrypt,proto3" json:"encrypt,omitempty"`
-	Sign    *Config_Sign    `protobuf:"bytes,11,opt,name=sign,proto3" json:"sign,omitempty"`
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *UserSearchResponse) Reset() {
+	*x = UserSearchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8708,13 +9416,13 @@ func (x *Config) Reset() {
 	}
 }
 
-func (x *Config) String() string {
+func (x *UserSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*UserSearchResponse) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
+func (x *UserSearchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8726,42 +9434,28 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserSearchResponse.ProtoReflect.Descriptor instead.
+func (*UserSearchResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *Config) GetApp() *Config_App {
-	if x != nil {
-		return x.App
-	}
-	return nil
-}
-
-func (x *Config) GetEncrypt() *Config_Encrypt {
-	if x != nil {
-		return x.Encrypt
-	}
-	return nil
-}
-
-func (x *Config) GetSign() *Config_Sign {
+func (x *UserSearchResponse) GetUsers() []*User {
 	if x != nil {
-		return x.Sign
+		return x.Users
 	}
 	return nil
 }
 
-type ConfigGetRequest struct {
+type SearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *ConfigGetRequest) Reset() {
-	*x = ConfigGetRequest{}
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8769,13 +9463,13 @@ func (x *ConfigGetRequest) Reset() {
 	}
 }
 
-func (x *ConfigGetRequest) String() string {
+func (x *SearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigGetRequest) ProtoMessage() {}
+func (*SearchRequest) ProtoMessage() {}
 
-func (x *ConfigGetRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8787,28 +9481,28 @@ func (x *ConfigGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigGetRequest.ProtoReflect.Descriptor instead.
-func (*ConfigGetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *ConfigGetRequest) GetName() string {
+func (x *SearchRequest) GetQuery() string {
 	if x != nil {
-		return x.Name
+		return x.Query
 	}
 	return ""
 }
 
-type ConfigGetResponse struct {
+type SearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Keys []*Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *ConfigGetResponse) Reset() {
-	*x = ConfigGetResponse{}
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8816,13 +9510,13 @@ func (x *ConfigGetResponse) Reset() {
 	}
 }
 
-func (x *ConfigGetResponse) String() string {
+func (x *SearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigGetResponse) ProtoMessage() {}
+func (*SearchResponse) ProtoMessage() {}
 
-func (x *ConfigGetResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8834,29 +9528,30 @@ func (x *ConfigGetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigGetResponse.ProtoReflect.Descriptor instead.
-func (*ConfigGetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *ConfigGetResponse) GetConfig() *Config {
+func (x *SearchResponse) GetKeys() []*Key {
 	if x != nil {
-		return x.Config
+		return x.Keys
 	}
 	return nil
 }
 
-type ConfigSetRequest struct {
+type VaultSyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Config *Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// URL (optional) of the keys server to sync to, if not synced yet.
+	// Defaults to the service server.
+	URL string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *ConfigSetRequest) Reset() {
-	*x = ConfigSetRequest{}
+func (x *VaultSyncRequest) Reset() {
+	*x = VaultSyncRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[147]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8864,13 +9559,13 @@ func (x *ConfigSetRequest) Reset() {
 	}
 }
 
-func (x *ConfigSetRequest) String() string {
+func (x *VaultSyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigSetRequest) ProtoMessage() {}
+func (*VaultSyncRequest) ProtoMessage() {}
 
-func (x *ConfigSetRequest) ProtoReflect() protoreflect.Message {
+func (x *VaultSyncRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[147]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8882,33 +9577,26 @@ func (x *ConfigSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigSetRequest.ProtoReflect.Descriptor instead.
-func (*ConfigSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VaultSyncRequest.ProtoReflect.Descriptor instead.
+func (*VaultSyncRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *ConfigSetRequest) GetName() string {
+func (x *VaultSyncRequest) GetURL() string {
 	if x != nil {
-		return x.Name
+		return x.URL
 	}
 	return ""
 }
 
-func (x *ConfigSetRequest) GetConfig() *Config {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-type ConfigSetResponse struct {
+type VaultSyncResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ConfigSetResponse) Reset() {
-	*x = ConfigSetResponse{}
+func (x *VaultSyncResponse) Reset() {
+	*x = VaultSyncResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[148]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8916,13 +9604,13 @@ func (x *ConfigSetResponse) Reset() {
 	}
 }
 
-func (x *ConfigSetResponse) String() string {
+func (x *VaultSyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigSetResponse) ProtoMessage() {}
+func (*VaultSyncResponse) ProtoMessage() {}
 
-func (x *ConfigSetResponse) ProtoReflect() protoreflect.Message {
+func (x *VaultSyncResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[148]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8934,19 +9622,23 @@ func (x *ConfigSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigSetResponse.ProtoReflect.Descriptor instead.
-func (*ConfigSetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VaultSyncResponse.ProtoReflect.Descriptor instead.
+func (*VaultSyncResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{148}
 }
 
-type RelayInput struct {
+type VaultSyncProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// URL (optional) of the keys server to sync to, if not synced yet.
+	// Defaults to the service server.
+	URL string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *RelayInput) Reset() {
-	*x = RelayInput{}
+func (x *VaultSyncProgressRequest) Reset() {
+	*x = VaultSyncProgressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[149]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8954,13 +9646,13 @@ func (x *RelayInput) Reset() {
 	}
 }
 
-func (x *RelayInput) String() string {
+func (x *VaultSyncProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelayInput) ProtoMessage() {}
+func (*VaultSyncProgressRequest) ProtoMessage() {}
 
-func (x *RelayInput) ProtoReflect() protoreflect.Message {
+func (x *VaultSyncProgressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[149]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8972,21 +9664,39 @@ func (x *RelayInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelayInput.ProtoReflect.Descriptor instead.
-func (*RelayInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use VaultSyncProgressRequest.ProtoReflect.Descriptor instead.
+func (*VaultSyncProgressRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{149}
 }
 
-type RelayOutput struct {
+func (x *VaultSyncProgressRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type VaultSyncProgressOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-}
-
-func (x *RelayOutput) Reset() {
-	*x = RelayOutput{}
+	// Events pulled (so far).
+	Events int64 `protobuf:"varint,1,opt,name=events,proto3" json:"events,omitempty"`
+	// Bytes pulled (so far).
+	Bytes int64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Index is the remote index pulled to.
+	Index int64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// Max is the latest remote index.
+	Max int64 `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	// Remaining is the estimated time remaining (in milliseconds), or 0 if unknown.
+	Remaining int64 `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// Done if sync finished.
+	Done bool `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (x *VaultSyncProgressOutput) Reset() {
+	*x = VaultSyncProgressOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[150]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8994,13 +9704,13 @@ func (x *RelayOutput) Reset() {
 	}
 }
 
-func (x *RelayOutput) String() string {
+func (x *VaultSyncProgressOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelayOutput) ProtoMessage() {}
+func (*VaultSyncProgressOutput) ProtoMessage() {}
 
-func (x *RelayOutput) ProtoReflect() protoreflect.Message {
+func (x *VaultSyncProgressOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[150]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9012,32 +9722,61 @@ func (x *RelayOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelayOutput.ProtoReflect.Descriptor instead.
-func (*RelayOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use VaultSyncProgressOutput.ProtoReflect.Descriptor instead.
+func (*VaultSyncProgressOutput) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *RelayOutput) GetKID() string {
+func (x *VaultSyncProgressOutput) GetEvents() int64 {
 	if x != nil {
-		return x.KID
+		return x.Events
 	}
-	return ""
+	return 0
 }
 
-type WormholeInput struct {
+func (x *VaultSyncProgressOutput) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *VaultSyncProgressOutput) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *VaultSyncProgressOutput) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *VaultSyncProgressOutput) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *VaultSyncProgressOutput) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type VaultUnsyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Invite    string `protobuf:"bytes,3,opt,name=invite,proto3" json:"invite,omitempty"`
-	ID        string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Text      string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *WormholeInput) Reset() {
-	*x = WormholeInput{}
+func (x *VaultUnsyncRequest) Reset() {
+	*x = VaultUnsyncRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[151]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9045,13 +9784,13 @@ func (x *WormholeInput) Reset() {
 	}
 }
 
-func (x *WormholeInput) String() string {
+func (x *VaultUnsyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WormholeInput) ProtoMessage() {}
+func (*VaultUnsyncRequest) ProtoMessage() {}
 
-func (x *WormholeInput) ProtoReflect() protoreflect.Message {
+func (x *VaultUnsyncRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[151]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9063,61 +9802,19 @@ func (x *WormholeInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WormholeInput.ProtoReflect.Descriptor instead.
-func (*WormholeInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use VaultUnsyncRequest.ProtoReflect.Descriptor instead.
+func (*VaultUnsyncRequest) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *WormholeInput) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *WormholeInput) GetRecipient() string {
-	if x != nil {
-		return x.Recipient
-	}
-	return ""
-}
-
-func (x *WormholeInput) GetInvite() string {
-	if x != nil {
-		return x.Invite
-	}
-	return ""
-}
-
-func (x *WormholeInput) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *WormholeInput) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-type WormholeMessage struct {
+type VaultUnsyncResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ID        string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sender    *Key                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient *Key                  `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Status    WormholeMessageStatus `protobuf:"varint,5,opt,name=status,proto3,enum=keys.WormholeMessageStatus" json:"status,omitempty"`
-	Text      string                `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
-	CreatedAt int64                 `protobuf:"varint,21,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *WormholeMessage) Reset() {
-	*x = WormholeMessage{}
+func (x *VaultUnsyncResponse) Reset() {
+	*x = VaultUnsyncResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_keys_proto_msgTypes[152]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9125,13 +9822,13 @@ func (x *WormholeMessage) Reset() {
 	}
 }
 
-func (x *WormholeMessage) String() string {
+func (x *VaultUnsyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WormholeMessage) ProtoMessage() {}
+func (*VaultUnsyncResponse) ProtoMessage() {}
 
-func (x *WormholeMessage) ProtoReflect() protoreflect.Message {
+func (x *VaultUnsyncResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_keys_proto_msgTypes[152]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9143,79 +9840,74 @@ func (x *WormholeMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WormholeMessage.ProtoReflect.Descriptor instead.
-func (*WormholeMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use VaultUnsyncResponse.ProtoReflect.Descriptor instead.
+func (*VaultUnsyncResponse) Descriptor() ([]byte, []int) {
 	return file_keys_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *WormholeMessage) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
+type VaultAuthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *WormholeMessage) GetSender() *Key {
-	if x != nil {
-		return x.Sender
+func (x *VaultAuthRequest) Reset() {
+	*x = VaultAuthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *WormholeMessage) GetRecipient() *Key {
-	if x != nil {
-		return x.Recipient
-	}
-	return nil
+func (x *VaultAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WormholeMessage) GetStatus() WormholeMessageStatus {
-	if x != nil {
-		return x.Status
-	}
-	return WormholeMessageSent
-}
+func (*VaultAuthRequest) ProtoMessage() {}
 
-func (x *WormholeMessage) GetText() string {
-	if x != nil {
-		return x.Text
+func (x *VaultAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *WormholeMessage) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
+// Deprecated: Use VaultAuthRequest.ProtoReflect.Descriptor instead.
+func (*VaultAuthRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{153}
 }
 
-type WormholeOutput struct {
+type VaultAuthResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *WormholeMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Status  WormholeStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=keys.WormholeStatus" json:"status,omitempty"`
+	Phrase string `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
 }
 
-func (x *WormholeOutput) Reset() {
-	*x = WormholeOutput{}
+func (x *VaultAuthResponse) Reset() {
+	*x = VaultAuthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_keys_proto_msgTypes[153]
+		mi := &file_keys_proto_msgTypes[154]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WormholeOutput) String() string {
+func (x *VaultAuthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WormholeOutput) ProtoMessage() {}
+func (*VaultAuthResponse) ProtoMessage() {}
 
-func (x *WormholeOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_keys_proto_msgTypes[153]
+func (x *VaultAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[154]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9226,52 +9918,41 @@ func (x *WormholeOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WormholeOutput.ProtoReflect.Descriptor instead.
-func (*WormholeOutput) Descriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{153}
-}
-
-func (x *WormholeOutput) GetMessage() *WormholeMessage {
-	if x != nil {
-		return x.Message
-	}
-	return nil
+// Deprecated: Use VaultAuthResponse.ProtoReflect.Descriptor instead.
+func (*VaultAuthResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *WormholeOutput) GetStatus() WormholeStatus {
+func (x *VaultAuthResponse) GetPhrase() string {
 	if x != nil {
-		return x.Status
+		return x.Phrase
 	}
-	return WormholeDefault
+	return ""
 }
 
-type Config_App struct {
+type VaultStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Location     string   `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	History      []string `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
-	NavMinimized bool     `protobuf:"varint,100,opt,name=navMinimized,proto3" json:"navMinimized,omitempty"`
 }
 
-func (x *Config_App) Reset() {
-	*x = Config_App{}
+func (x *VaultStatusRequest) Reset() {
+	*x = VaultStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_keys_proto_msgTypes[154]
+		mi := &file_keys_proto_msgTypes[155]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config_App) String() string {
+func (x *VaultStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config_App) ProtoMessage() {}
+func (*VaultStatusRequest) ProtoMessage() {}
 
-func (x *Config_App) ProtoReflect() protoreflect.Message {
-	mi := &file_keys_proto_msgTypes[154]
+func (x *VaultStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[155]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9282,60 +9963,40 @@ func (x *Config_App) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config_App.ProtoReflect.Descriptor instead.
-func (*Config_App) Descriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{144, 0}
-}
-
-func (x *Config_App) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
-}
-
-func (x *Config_App) GetHistory() []string {
-	if x != nil {
-		return x.History
-	}
-	return nil
-}
-
-func (x *Config_App) GetNavMinimized() bool {
-	if x != nil {
-		return x.NavMinimized
-	}
-	return false
+// Deprecated: Use VaultStatusRequest.ProtoReflect.Descriptor instead.
+func (*VaultStatusRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{155}
 }
 
-type Config_Encrypt struct {
+type VaultStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recipients        []string `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients,omitempty"`
-	Sender            string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	NoSenderRecipient bool     `protobuf:"varint,3,opt,name=noSenderRecipient,proto3" json:"noSenderRecipient,omitempty"`
-	NoSign            bool     `protobuf:"varint,4,opt,name=noSign,proto3" json:"noSign,omitempty"`
+	KID      string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	SyncedAt int64  `protobuf:"varint,2,opt,name=syncedAt,proto3" json:"syncedAt,omitempty"`
+	URL      string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Remotes, the first is the primary remote (with an empty name).
+	Remotes []*VaultRemote `protobuf:"bytes,4,rep,name=remotes,proto3" json:"remotes,omitempty"`
 }
 
-func (x *Config_Encrypt) Reset() {
-	*x = Config_Encrypt{}
+func (x *VaultStatusResponse) Reset() {
+	*x = VaultStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_keys_proto_msgTypes[155]
+		mi := &file_keys_proto_msgTypes[156]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config_Encrypt) String() string {
+func (x *VaultStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config_Encrypt) ProtoMessage() {}
+func (*VaultStatusResponse) ProtoMessage() {}
 
-func (x *Config_Encrypt) ProtoReflect() protoreflect.Message {
-	mi := &file_keys_proto_msgTypes[155]
+func (x *VaultStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[156]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9346,64 +10007,71 @@ func (x *Config_Encrypt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config_Encrypt.ProtoReflect.Descriptor instead.
-func (*Config_Encrypt) Descriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{144, 1}
+// Deprecated: Use VaultStatusResponse.ProtoReflect.Descriptor instead.
+func (*VaultStatusResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *Config_Encrypt) GetRecipients() []string {
+func (x *VaultStatusResponse) GetKID() string {
 	if x != nil {
-		return x.Recipients
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-func (x *Config_Encrypt) GetSender() string {
+func (x *VaultStatusResponse) GetSyncedAt() int64 {
 	if x != nil {
-		return x.Sender
+		return x.SyncedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *Config_Encrypt) GetNoSenderRecipient() bool {
+func (x *VaultStatusResponse) GetURL() string {
 	if x != nil {
-		return x.NoSenderRecipient
+		return x.URL
 	}
-	return false
+	return ""
 }
 
-func (x *Config_Encrypt) GetNoSign() bool {
+func (x *VaultStatusResponse) GetRemotes() []*VaultRemote {
 	if x != nil {
-		return x.NoSign
+		return x.Remotes
 	}
-	return false
+	return nil
 }
 
-type Config_Sign struct {
+type VaultRemote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	URL         string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	KID         string `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	PullIndex   int64  `protobuf:"varint,4,opt,name=pullIndex,proto3" json:"pullIndex,omitempty"`
+	PushedIndex int64  `protobuf:"varint,5,opt,name=pushedIndex,proto3" json:"pushedIndex,omitempty"`
+	SyncedAt    int64  `protobuf:"varint,6,opt,name=syncedAt,proto3" json:"syncedAt,omitempty"`
+	// Error from the last sync (to this remote).
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *Config_Sign) Reset() {
-	*x = Config_Sign{}
+func (x *VaultRemote) Reset() {
+	*x = VaultRemote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_keys_proto_msgTypes[156]
+		mi := &file_keys_proto_msgTypes[157]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config_Sign) String() string {
+func (x *VaultRemote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config_Sign) ProtoMessage() {}
+func (*VaultRemote) ProtoMessage() {}
 
-func (x *Config_Sign) ProtoReflect() protoreflect.Message {
-	mi := &file_keys_proto_msgTypes[156]
+func (x *VaultRemote) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[157]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9414,1652 +10082,7475 @@ func (x *Config_Sign) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config_Sign.ProtoReflect.Descriptor instead.
-func (*Config_Sign) Descriptor() ([]byte, []int) {
-	return file_keys_proto_rawDescGZIP(), []int{144, 2}
+// Deprecated: Use VaultRemote.ProtoReflect.Descriptor instead.
+func (*VaultRemote) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *Config_Sign) GetSigner() string {
+func (x *VaultRemote) GetName() string {
 	if x != nil {
-		return x.Signer
+		return x.Name
 	}
 	return ""
 }
 
-var File_keys_proto protoreflect.FileDescriptor
+func (x *VaultRemote) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
 
-var file_keys_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6b, 0x65,
-	0x79, 0x73, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63,
-	0x68, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63,
-	0x68, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52,
-	0x03, 0x6b, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74,
-	0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74,
-	0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x6b, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c,
-	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52,
-	0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x22, 0x23, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x47, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a,
-	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x22, 0x3d, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22,
-	0x3b, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x0b,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x45, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x22, 0x47, 0x0a, 0x10, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x21, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6f, 0x75, 0x74, 0x22, 0x3b, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12,
-	0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69,
-	0x67, 0x22, 0x3b, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63,
-	0x68, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
-	0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xbe,
-	0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65,
-	0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x2e, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22,
-	0x60, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x41, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
-	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x73, 0x65, 0x71, 0x22, 0x42, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
-	0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x48, 0x0a, 0x17, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
-	0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
-	0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x22, 0x48, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x8f, 0x01, 0x0a,
-	0x09, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10,
-	0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f,
-	0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72,
-	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x3d,
-	0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca,
-	0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x97, 0x01,
-	0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e, 0x6f,
-	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e,
-	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x39, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x51, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x23, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x24, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x0f, 0x44,
-	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x34, 0x0a, 0x10,
-	0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e,
-	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f,
-	0x75, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x22, 0x22, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x6d, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x15,
-	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x78, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x78, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61,
-	0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e,
-	0x63, 0x12, 0x21, 0x0a, 0x05, 0x66, 0x69, 0x64, 0x6f, 0x32, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x0b, 0xca, 0xb5, 0x03, 0x07, 0x0a, 0x05, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x52, 0x05, 0x66,
-	0x69, 0x64, 0x6f, 0x32, 0x22, 0x66, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x13, 0x0a, 0x11,
-	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2a, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x13, 0x0a,
-	0x11, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x67, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
-	0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x12, 0x41,
-	0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x86, 0x01, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x22, 0x4a, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x31, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04,
-	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68,
-	0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f,
-	0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6e, 0x65, 0x77, 0x22, 0x1c, 0x0a, 0x1a, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x24, 0x0a, 0x06, 0x61, 0x61, 0x67, 0x75, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x41, 0x41, 0x47, 0x55, 0x49, 0x44, 0x52, 0x06,
-	0x61, 0x61, 0x67, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x50, 0x69, 0x6e, 0x18,
-	0x65, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x50, 0x69, 0x6e, 0x22, 0x17, 0x0a, 0x15,
-	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x33, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x4c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x74,
-	0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52,
-	0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79,
-	0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x33, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x4b, 0x65, 0x79, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0x32, 0x0a, 0x13, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44,
-	0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
-	0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x22, 0x5c, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
-	0x6b, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x40, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
-	0x6b, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
-	0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x2b, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22,
-	0x3e, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x02, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x30, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69,
-	0x64, 0x22, 0x2f, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
-	0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12,
-	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03,
-	0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6b, 0x65,
-	0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x61,
-	0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x73,
-	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x29, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x0a, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x0b, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x39, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x6f,
-	0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72,
-	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x6b,
-	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f,
-	0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x83, 0x02, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04,
-	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72,
-	0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55,
-	0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
-	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x29, 0x0a, 0x0d, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x39, 0x0a, 0x11,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
-	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3a, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
-	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7f, 0x0a, 0x0e,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
-	0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01,
-	0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72,
-	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f,
-	0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a,
-	0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f,
-	0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e,
-	0x6f, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x13, 0x52, 0x61,
-	0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x32, 0x0a, 0x14, 0x52, 0x61, 0x6e,
-	0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1f, 0x0a,
-	0x0b, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2e,
-	0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
-	0x0a, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5,
-	0x03, 0x06, 0x0a, 0x04, 0x4b, 0x49, 0x44, 0x73, 0x52, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x22, 0x41,
-	0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x22, 0x4b, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x1e,
-	0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5,
-	0x03, 0x06, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x20,
-	0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x22, 0x46, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18,
-	0x0a, 0x02, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04,
-	0x0a, 0x02, 0x44, 0x42, 0x52, 0x02, 0x64, 0x62, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x32, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca,
-	0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52, 0x02, 0x64, 0x62, 0x22, 0x41, 0x0a, 0x11, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x45,
-	0x0a, 0x15, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x02, 0x64,
-	0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44,
-	0x42, 0x52, 0x02, 0x64, 0x62, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x94, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
-	0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
-	0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x40, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x2e, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22,
-	0x36, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x2f,
-	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1d, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22,
-	0x12, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15,
-	0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x11, 0x56, 0x61, 0x75,
-	0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13,
-	0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x22, 0x14, 0x0a, 0x12,
-	0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x07, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x5d, 0x0a, 0x15, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x22, 0x41, 0x0a, 0x16, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x65,
-	0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x76, 0x0a, 0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a,
-	0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x40, 0x0a, 0x15, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a,
-	0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x3d, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x01, 0x0a,
-	0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x85, 0x01, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
-	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03,
-	0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x0f, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x22, 0x3d, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x73, 0x22, 0x3e, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x22, 0x40, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x65,
-	0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x22, 0x6f, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x62, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x22, 0x67, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e,
-	0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
-	0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
-	0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x04,
-	0x63, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06,
-	0x0a, 0x04, 0x43, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x63, 0x75, 0x72, 0x6c, 0x22, 0x41, 0x0a, 0x11,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x22,
-	0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x22, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x52,
-	0x03, 0x61, 0x70, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x1a, 0x5f, 0x0a, 0x03, 0x41,
-	0x70, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x61, 0x76, 0x4d,
-	0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x6e, 0x61, 0x76, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x1a, 0x87, 0x01, 0x0a,
-	0x07, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e, 0x6f, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x1a, 0x1e, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39,
-	0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4c, 0x0a, 0x10, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x0a, 0x0a,
-	0x52, 0x65, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x2a, 0x0a, 0x0b, 0x52, 0x65,
-	0x6c, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49,
-	0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6d, 0x68,
-	0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x4b, 0x65, 0x79, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x33,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6f, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c,
-	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xa9, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
-	0x54, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x00, 0x1a, 0x14, 0xca, 0xb5, 0x03,
-	0x10, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x12, 0x2b, 0x0a, 0x10, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41, 0x43, 0x4b, 0x5f, 0x45, 0x4e,
-	0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x01, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x53,
-	0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x2f,
-	0x0a, 0x12, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x43,
-	0x52, 0x59, 0x50, 0x54, 0x10, 0x03, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x53, 0x61,
-	0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x1a,
-	0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f,
-	0x64, 0x65, 0x2a, 0xbb, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x23, 0x0a, 0x0c, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x55,
-	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53,
-	0x45, 0x54, 0x55, 0x50, 0x5f, 0x4e, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x15, 0xca,
-	0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x65,
-	0x65, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0d, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x4c,
-	0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x41,
-	0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x41,
-	0x55, 0x54, 0x48, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x10, 0xca, 0xb5,
-	0x03, 0x0c, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0x10,
-	0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2a, 0xc5, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a,
-	0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x00, 0x1a,
-	0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x75,
-	0x74, 0x68, 0x12, 0x25, 0x0a, 0x0d, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x41,
-	0x55, 0x54, 0x48, 0x10, 0x0a, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x75, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x41, 0x50,
-	0x45, 0x52, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x0b, 0x1a, 0x12, 0xca,
-	0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x50, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x35, 0x0a, 0x16, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f,
-	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x14, 0x1a, 0x19, 0xca,
-	0xb5, 0x03, 0x15, 0x0a, 0x13, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x48, 0x4d, 0x41, 0x43, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08,
-	0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x2a, 0xa2, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x44, 0x45, 0x46, 0x41, 0x55,
-	0x4c, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00,
-	0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x14, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41, 0x43,
-	0x4b, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x1a,
-	0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0f, 0x53, 0x53, 0x48, 0x5f, 0x45, 0x58, 0x50,
-	0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x02, 0x1a, 0x0f, 0xca, 0xb5, 0x03, 0x0b,
-	0x0a, 0x09, 0x53, 0x53, 0x48, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x10, 0xca, 0xb5, 0x03,
-	0x0c, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x56, 0x0a,
-	0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x0a, 0x07, 0x53,
-	0x6f, 0x72, 0x74, 0x41, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01,
-	0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63,
-	0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xe6, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
-	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x1a, 0x13, 0xca,
-	0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53,
-	0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0a, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x27, 0x0a,
-	0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10,
-	0x0b, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x53,
-	0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0c, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x43,
-	0x61, 0x72, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x4e, 0x4f, 0x54,
-	0x45, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0d, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c,
-	0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x10, 0xca, 0xb5,
-	0x03, 0x0c, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2a, 0xe6,
-	0x01, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x03, 0x48,
-	0x45, 0x58, 0x10, 0x00, 0x1a, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x48, 0x65, 0x78, 0x12,
-	0x18, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x36, 0x32, 0x10, 0x01, 0x1a, 0x0c, 0xca, 0xb5, 0x03,
-	0x08, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x36, 0x32, 0x12, 0x18, 0x0a, 0x06, 0x42, 0x41, 0x53,
-	0x45, 0x35, 0x38, 0x10, 0x02, 0x1a, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x42, 0x61, 0x73,
-	0x65, 0x35, 0x38, 0x12, 0x18, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x33, 0x32, 0x10, 0x03, 0x1a,
-	0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x33, 0x32, 0x12, 0x18, 0x0a,
-	0x06, 0x42, 0x41, 0x53, 0x45, 0x31, 0x36, 0x10, 0x04, 0x1a, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a,
-	0x06, 0x42, 0x61, 0x73, 0x65, 0x31, 0x36, 0x12, 0x18, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x36,
-	0x34, 0x10, 0x05, 0x1a, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x36,
-	0x34, 0x12, 0x1c, 0x0a, 0x08, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41, 0x43, 0x4b, 0x10, 0x06, 0x1a,
-	0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x12,
-	0x16, 0x0a, 0x05, 0x42, 0x49, 0x50, 0x33, 0x39, 0x10, 0x07, 0x1a, 0x0b, 0xca, 0xb5, 0x03, 0x07,
-	0x0a, 0x05, 0x42, 0x49, 0x50, 0x33, 0x39, 0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2a, 0xff, 0x02, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x12, 0x1f, 0x0a, 0x07, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x4b, 0x10, 0x01, 0x1a, 0x12,
-	0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x4f, 0x4b, 0x12, 0x3d, 0x0a, 0x17, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x14, 0x1a,
-	0x20, 0xca, 0xb5, 0x03, 0x1c, 0x0a, 0x1a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x3b, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e,
-	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x1e, 0x1a, 0x1f, 0xca,
-	0xb5, 0x03, 0x1b, 0x0a, 0x19, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x38,
-	0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x49,
-	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x1f, 0x1a, 0x1e, 0xca, 0xb5, 0x03, 0x1a, 0x0a, 0x18,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x53, 0x45, 0x52,
-	0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x28, 0x1a,
-	0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x0c,
-	0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x32, 0x1a, 0x17,
-	0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x74, 0x0a, 0x0d, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0c, 0x4d, 0x45,
-	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5,
-	0x03, 0x0d, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12,
-	0x29, 0x0a, 0x0f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0x01, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f,
-	0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a,
-	0xef, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x18, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x1a, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x42, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x44, 0x10, 0x01, 0x1a, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45,
-	0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x02, 0x1a, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0x0a, 0x1a, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a,
-	0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x2a, 0xf0, 0x02, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x10, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45,
-	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11,
-	0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x12, 0x2d, 0x0a, 0x11, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x0a, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10,
-	0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x2d, 0x0a, 0x11, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x46, 0x46,
-	0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x14, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x57,
-	0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x2f, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x4e, 0x53, 0x57,
-	0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x15, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57,
-	0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x2f, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x48, 0x41, 0x4e,
-	0x44, 0x53, 0x48, 0x41, 0x4b, 0x45, 0x10, 0x28, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11,
-	0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b,
-	0x65, 0x12, 0x2f, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4f,
-	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x64, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a,
-	0x11, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x0f, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x43,
-	0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0xc8, 0x01, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e,
-	0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x1a, 0x14,
-	0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x2a, 0xda, 0x01, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34,
-	0x0a, 0x15, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41,
-	0x47, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x1a, 0x19, 0xca, 0xb5, 0x03, 0x15, 0x0a,
-	0x13, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x53, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45,
-	0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x1a, 0x1c, 0xca, 0xb5, 0x03, 0x18, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f,
-	0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x12, 0x32, 0x0a, 0x14, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53,
-	0x53, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x1a, 0x18, 0xca, 0xb5, 0x03, 0x14,
-	0x0a, 0x12, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x41, 0x63, 0x6b, 0x1a, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6d,
-	0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x32, 0x97, 0x25, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x4b, 0x65,
-	0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x2f, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65,
-	0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x2c, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3e, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3e, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3e, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x16, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x2f, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3b, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x1a, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c,
-	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x35, 0x0a,
-	0x0a, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0f, 0x2e, 0x6b, 0x65,
-	0x79, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x10, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00,
-	0x28, 0x01, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x13,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69,
-	0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3b,
-	0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1b, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63,
-	0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x12, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
-	0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74,
-	0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
-	0x01, 0x12, 0x53, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63,
-	0x68, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x38, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x12, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3e, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
-	0x12, 0x44, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12,
-	0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x12, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3e, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
-	0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12,
-	0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x15, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38,
-	0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x12, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x12, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x35, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x53, 0x61, 0x76, 0x65, 0x12, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x38, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x14, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x04,
-	0x50, 0x75, 0x6c, 0x6c, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x50,
-	0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a,
-	0x04, 0x50, 0x75, 0x73, 0x68, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x50, 0x75, 0x73,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b,
-	0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
-	0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x09, 0x41,
-	0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x41,
-	0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x41,
-	0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b,
-	0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a,
-	0x04, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47,
-	0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x65,
-	0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3e, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3e, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x18,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e,
-	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
-	0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65,
-	0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x53, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65,
-	0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5f, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5c, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x20, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x65, 0x79, 0x73,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d,
-	0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
-	0x12, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a,
-	0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1a,
-	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b,
-	0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x47, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x12,
-	0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e,
-	0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65, 0x79,
-	0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2e,
-	0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *VaultRemote) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
 }
 
-var (
-	file_keys_proto_rawDescOnce sync.Once
-	file_keys_proto_rawDescData = file_keys_proto_rawDesc
-)
+func (x *VaultRemote) GetPullIndex() int64 {
+	if x != nil {
+		return x.PullIndex
+	}
+	return 0
+}
 
-func file_keys_proto_rawDescGZIP() []byte {
-	file_keys_proto_rawDescOnce.Do(func() {
-		file_keys_proto_rawDescData = protoimpl.X.CompressGZIP(file_keys_proto_rawDescData)
-	})
-	return file_keys_proto_rawDescData
+func (x *VaultRemote) GetPushedIndex() int64 {
+	if x != nil {
+		return x.PushedIndex
+	}
+	return 0
 }
 
-var file_keys_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_keys_proto_msgTypes = make([]protoimpl.MessageInfo, 157)
-var file_keys_proto_goTypes = []interface{}{
-	(EncryptMode)(0),                     // 0: keys.EncryptMode
-	(AuthStatus)(0),                      // 1: keys.AuthStatus
-	(AuthType)(0),                        // 2: keys.AuthType
-	(ExportType)(0),                      // 3: keys.ExportType
-	(SortDirection)(0),                   // 4: keys.SortDirection
-	(SecretType)(0),                      // 5: keys.SecretType
-	(Encoding)(0),                        // 6: keys.Encoding
-	(UserStatus)(0),                      // 7: keys.UserStatus
-	(MessageStatus)(0),                   // 8: keys.MessageStatus
-	(NotificationType)(0),                // 9: keys.NotificationType
-	(WormholeStatus)(0),                  // 10: keys.WormholeStatus
-	(WormholeMessageStatus)(0),           // 11: keys.WormholeMessageStatus
-	(*SignRequest)(nil),                  // 12: keys.SignRequest
-	(*SignResponse)(nil),                 // 13: keys.SignResponse
-	(*SignFileInput)(nil),                // 14: keys.SignFileInput
-	(*SignFileOutput)(nil),               // 15: keys.SignFileOutput
-	(*VerifyRequest)(nil),                // 16: keys.VerifyRequest
-	(*VerifyResponse)(nil),               // 17: keys.VerifyResponse
-	(*VerifyDetachedRequest)(nil),        // 18: keys.VerifyDetachedRequest
-	(*VerifyDetachedResponse)(nil),       // 19: keys.VerifyDetachedResponse
-	(*VerifyInput)(nil),                  // 20: keys.VerifyInput
-	(*VerifyOutput)(nil),                 // 21: keys.VerifyOutput
-	(*VerifyFileInput)(nil),              // 22: keys.VerifyFileInput
-	(*VerifyFileOutput)(nil),             // 23: keys.VerifyFileOutput
-	(*VerifyDetachedFileInput)(nil),      // 24: keys.VerifyDetachedFileInput
-	(*VerifyDetachedInput)(nil),          // 25: keys.VerifyDetachedInput
-	(*Statement)(nil),                    // 26: keys.Statement
-	(*SigchainRequest)(nil),              // 27: keys.SigchainRequest
-	(*SigchainResponse)(nil),             // 28: keys.SigchainResponse
-	(*StatementRequest)(nil),             // 29: keys.StatementRequest
-	(*StatementResponse)(nil),            // 30: keys.StatementResponse
-	(*StatementCreateRequest)(nil),       // 31: keys.StatementCreateRequest
-	(*StatementCreateResponse)(nil),      // 32: keys.StatementCreateResponse
-	(*StatementRevokeRequest)(nil),       // 33: keys.StatementRevokeRequest
-	(*StatementRevokeResponse)(nil),      // 34: keys.StatementRevokeResponse
-	(*SignInput)(nil),                    // 35: keys.SignInput
-	(*SignOutput)(nil),                   // 36: keys.SignOutput
-	(*EncryptOptions)(nil),               // 37: keys.EncryptOptions
-	(*EncryptRequest)(nil),               // 38: keys.EncryptRequest
-	(*EncryptResponse)(nil),              // 39: keys.EncryptResponse
-	(*EncryptFileInput)(nil),             // 40: keys.EncryptFileInput
-	(*EncryptFileOutput)(nil),            // 41: keys.EncryptFileOutput
-	(*EncryptInput)(nil),                 // 42: keys.EncryptInput
-	(*EncryptOutput)(nil),                // 43: keys.EncryptOutput
-	(*DecryptRequest)(nil),               // 44: keys.DecryptRequest
-	(*DecryptResponse)(nil),              // 45: keys.DecryptResponse
-	(*DecryptFileInput)(nil),             // 46: keys.DecryptFileInput
-	(*DecryptFileOutput)(nil),            // 47: keys.DecryptFileOutput
-	(*DecryptInput)(nil),                 // 48: keys.DecryptInput
-	(*DecryptOutput)(nil),                // 49: keys.DecryptOutput
-	(*RuntimeStatusRequest)(nil),         // 50: keys.RuntimeStatusRequest
-	(*RuntimeStatusResponse)(nil),        // 51: keys.RuntimeStatusResponse
-	(*AuthSetupRequest)(nil),             // 52: keys.AuthSetupRequest
-	(*AuthSetupResponse)(nil),            // 53: keys.AuthSetupResponse
-	(*AuthVaultRequest)(nil),             // 54: keys.AuthVaultRequest
-	(*AuthVaultResponse)(nil),            // 55: keys.AuthVaultResponse
-	(*AuthUnlockRequest)(nil),            // 56: keys.AuthUnlockRequest
-	(*AuthUnlockResponse)(nil),           // 57: keys.AuthUnlockResponse
-	(*AuthProvisionRequest)(nil),         // 58: keys.AuthProvisionRequest
-	(*AuthProvisionResponse)(nil),        // 59: keys.AuthProvisionResponse
-	(*AuthDeprovisionRequest)(nil),       // 60: keys.AuthDeprovisionRequest
-	(*AuthDeprovisionResponse)(nil),      // 61: keys.AuthDeprovisionResponse
-	(*AuthPasswordChangeRequest)(nil),    // 62: keys.AuthPasswordChangeRequest
-	(*AuthPasswordChangeResponse)(nil),   // 63: keys.AuthPasswordChangeResponse
-	(*AuthProvision)(nil),                // 64: keys.AuthProvision
-	(*AuthProvisionsRequest)(nil),        // 65: keys.AuthProvisionsRequest
-	(*AuthProvisionsResponse)(nil),       // 66: keys.AuthProvisionsResponse
-	(*AuthLockRequest)(nil),              // 67: keys.AuthLockRequest
-	(*AuthLockResponse)(nil),             // 68: keys.AuthLockResponse
-	(*AuthResetRequest)(nil),             // 69: keys.AuthResetRequest
-	(*AuthResetResponse)(nil),            // 70: keys.AuthResetResponse
-	(*AuthRecoverRequest)(nil),           // 71: keys.AuthRecoverRequest
-	(*AuthRecoverResponse)(nil),          // 72: keys.AuthRecoverResponse
-	(*KeyGenerateRequest)(nil),           // 73: keys.KeyGenerateRequest
-	(*KeyGenerateResponse)(nil),          // 74: keys.KeyGenerateResponse
-	(*UserServiceRequest)(nil),           // 75: keys.UserServiceRequest
-	(*UserServiceResponse)(nil),          // 76: keys.UserServiceResponse
-	(*UserSignRequest)(nil),              // 77: keys.UserSignRequest
-	(*UserSignResponse)(nil),             // 78: keys.UserSignResponse
-	(*UserAddRequest)(nil),               // 79: keys.UserAddRequest
-	(*UserAddResponse)(nil),              // 80: keys.UserAddResponse
-	(*KeyExportRequest)(nil),             // 81: keys.KeyExportRequest
-	(*KeyExportResponse)(nil),            // 82: keys.KeyExportResponse
-	(*KeyImportRequest)(nil),             // 83: keys.KeyImportRequest
-	(*KeyImportResponse)(nil),            // 84: keys.KeyImportResponse
-	(*KeyRemoveRequest)(nil),             // 85: keys.KeyRemoveRequest
-	(*KeyRemoveResponse)(nil),            // 86: keys.KeyRemoveResponse
-	(*Key)(nil),                          // 87: keys.Key
-	(*KeyRequest)(nil),                   // 88: keys.KeyRequest
-	(*KeyResponse)(nil),                  // 89: keys.KeyResponse
-	(*KeysRequest)(nil),                  // 90: keys.KeysRequest
-	(*KeysResponse)(nil),                 // 91: keys.KeysResponse
-	(*Secret)(nil),                       // 92: keys.Secret
-	(*SecretRequest)(nil),                // 93: keys.SecretRequest
-	(*SecretResponse)(nil),               // 94: keys.SecretResponse
-	(*SecretSaveRequest)(nil),            // 95: keys.SecretSaveRequest
-	(*SecretSaveResponse)(nil),           // 96: keys.SecretSaveResponse
-	(*SecretRemoveRequest)(nil),          // 97: keys.SecretRemoveRequest
-	(*SecretRemoveResponse)(nil),         // 98: keys.SecretRemoveResponse
-	(*SecretsRequest)(nil),               // 99: keys.SecretsRequest
-	(*SecretsResponse)(nil),              // 100: keys.SecretsResponse
-	(*RandRequest)(nil),                  // 101: keys.RandRequest
-	(*RandResponse)(nil),                 // 102: keys.RandResponse
-	(*RandPasswordRequest)(nil),          // 103: keys.RandPasswordRequest
-	(*RandPasswordResponse)(nil),         // 104: keys.RandPasswordResponse
-	(*PullRequest)(nil),                  // 105: keys.PullRequest
-	(*PullResponse)(nil),                 // 106: keys.PullResponse
-	(*PushRequest)(nil),                  // 107: keys.PushRequest
-	(*PushResponse)(nil),                 // 108: keys.PushResponse
-	(*Collection)(nil),                   // 109: keys.Collection
-	(*CollectionsRequest)(nil),           // 110: keys.CollectionsRequest
-	(*CollectionsResponse)(nil),          // 111: keys.CollectionsResponse
-	(*Document)(nil),                     // 112: keys.Document
-	(*DocumentsRequest)(nil),             // 113: keys.DocumentsRequest
-	(*DocumentsResponse)(nil),            // 114: keys.DocumentsResponse
-	(*DocumentDeleteRequest)(nil),        // 115: keys.DocumentDeleteRequest
-	(*DocumentDeleteResponse)(nil),       // 116: keys.DocumentDeleteResponse
-	(*User)(nil),                         // 117: keys.User
-	(*UserRequest)(nil),                  // 118: keys.UserRequest
-	(*UserResponse)(nil),                 // 119: keys.UserResponse
-	(*UserSearchRequest)(nil),            // 120: keys.UserSearchRequest
-	(*UserSearchResponse)(nil),           // 121: keys.UserSearchResponse
-	(*SearchRequest)(nil),                // 122: keys.SearchRequest
-	(*SearchResponse)(nil),               // 123: keys.SearchResponse
-	(*VaultSyncRequest)(nil),             // 124: keys.VaultSyncRequest
-	(*VaultSyncResponse)(nil),            // 125: keys.VaultSyncResponse
-	(*VaultUnsyncRequest)(nil),           // 126: keys.VaultUnsyncRequest
-	(*VaultUnsyncResponse)(nil),          // 127: keys.VaultUnsyncResponse
-	(*VaultAuthRequest)(nil),             // 128: keys.VaultAuthRequest
-	(*VaultAuthResponse)(nil),            // 129: keys.VaultAuthResponse
-	(*VaultStatusRequest)(nil),           // 130: keys.VaultStatusRequest
-	(*VaultStatusResponse)(nil),          // 131: keys.VaultStatusResponse
-	(*VaultUpdateRequest)(nil),           // 132: keys.VaultUpdateRequest
-	(*VaultUpdateResponse)(nil),          // 133: keys.VaultUpdateResponse
-	(*Message)(nil),                      // 134: keys.Message
-	(*MessagePrepareRequest)(nil),        // 135: keys.MessagePrepareRequest
-	(*MessagePrepareResponse)(nil),       // 136: keys.MessagePrepareResponse
-	(*MessageCreateRequest)(nil),         // 137: keys.MessageCreateRequest
-	(*MessageCreateResponse)(nil),        // 138: keys.MessageCreateResponse
-	(*MessagesRequest)(nil),              // 139: keys.MessagesRequest
-	(*MessagesResponse)(nil),             // 140: keys.MessagesResponse
-	(*NotifyStreamRequest)(nil),          // 141: keys.NotifyStreamRequest
-	(*NotifyStreamOutput)(nil),           // 142: keys.NotifyStreamOutput
-	(*Channel)(nil),                      // 143: keys.Channel
-	(*ChannelsRequest)(nil),              // 144: keys.ChannelsRequest
-	(*ChannelsResponse)(nil),             // 145: keys.ChannelsResponse
-	(*ChannelCreateRequest)(nil),         // 146: keys.ChannelCreateRequest
-	(*ChannelCreateResponse)(nil),        // 147: keys.ChannelCreateResponse
-	(*ChannelInvitesCreateRequest)(nil),  // 148: keys.ChannelInvitesCreateRequest
-	(*ChannelInvitesCreateResponse)(nil), // 149: keys.ChannelInvitesCreateResponse
-	(*ChannelInviteAcceptRequest)(nil),   // 150: keys.ChannelInviteAcceptRequest
-	(*ChannelInviteAcceptResponse)(nil),  // 151: keys.ChannelInviteAcceptResponse
-	(*AdminSignURLRequest)(nil),          // 152: keys.AdminSignURLRequest
-	(*AdminSignURLResponse)(nil),         // 153: keys.AdminSignURLResponse
-	(*AdminCheckRequest)(nil),            // 154: keys.AdminCheckRequest
-	(*AdminCheckResponse)(nil),           // 155: keys.AdminCheckResponse
-	(*Config)(nil),                       // 156: keys.Config
-	(*ConfigGetRequest)(nil),             // 157: keys.ConfigGetRequest
-	(*ConfigGetResponse)(nil),            // 158: keys.ConfigGetResponse
-	(*ConfigSetRequest)(nil),             // 159: keys.ConfigSetRequest
-	(*ConfigSetResponse)(nil),            // 160: keys.ConfigSetResponse
-	(*RelayInput)(nil),                   // 161: keys.RelayInput
-	(*RelayOutput)(nil),                  // 162: keys.RelayOutput
-	(*WormholeInput)(nil),                // 163: keys.WormholeInput
-	(*WormholeMessage)(nil),              // 164: keys.WormholeMessage
-	(*WormholeOutput)(nil),               // 165: keys.WormholeOutput
-	(*Config_App)(nil),                   // 166: keys.Config.App
-	(*Config_Encrypt)(nil),               // 167: keys.Config.Encrypt
-	(*Config_Sign)(nil),                  // 168: keys.Config.Sign
+func (x *VaultRemote) GetSyncedAt() int64 {
+	if x != nil {
+		return x.SyncedAt
+	}
+	return 0
 }
-var file_keys_proto_depIdxs = []int32{
-	87,  // 0: keys.VerifyResponse.signer:type_name -> keys.Key
-	87,  // 1: keys.VerifyDetachedResponse.signer:type_name -> keys.Key
-	87,  // 2: keys.VerifyOutput.signer:type_name -> keys.Key
-	87,  // 3: keys.VerifyFileOutput.signer:type_name -> keys.Key
-	87,  // 4: keys.SigchainResponse.key:type_name -> keys.Key
-	26,  // 5: keys.SigchainResponse.statements:type_name -> keys.Statement
-	26,  // 6: keys.StatementResponse.statement:type_name -> keys.Statement
-	26,  // 7: keys.StatementCreateResponse.statement:type_name -> keys.Statement
-	26,  // 8: keys.StatementRevokeResponse.statement:type_name -> keys.Statement
-	0,   // 9: keys.EncryptOptions.mode:type_name -> keys.EncryptMode
-	37,  // 10: keys.EncryptRequest.options:type_name -> keys.EncryptOptions
-	37,  // 11: keys.EncryptFileInput.options:type_name -> keys.EncryptOptions
-	37,  // 12: keys.EncryptInput.options:type_name -> keys.EncryptOptions
-	87,  // 13: keys.DecryptResponse.sender:type_name -> keys.Key
-	0,   // 14: keys.DecryptResponse.mode:type_name -> keys.EncryptMode
-	87,  // 15: keys.DecryptFileOutput.sender:type_name -> keys.Key
-	0,   // 16: keys.DecryptFileOutput.mode:type_name -> keys.EncryptMode
-	87,  // 17: keys.DecryptOutput.sender:type_name -> keys.Key
-	0,   // 18: keys.DecryptOutput.mode:type_name -> keys.EncryptMode
-	1,   // 19: keys.RuntimeStatusResponse.authStatus:type_name -> keys.AuthStatus
-	2,   // 20: keys.AuthSetupRequest.type:type_name -> keys.AuthType
-	2,   // 21: keys.AuthUnlockRequest.type:type_name -> keys.AuthType
-	2,   // 22: keys.AuthProvisionRequest.type:type_name -> keys.AuthType
-	64,  // 23: keys.AuthProvisionResponse.provision:type_name -> keys.AuthProvision
-	2,   // 24: keys.AuthProvision.type:type_name -> keys.AuthType
-	64,  // 25: keys.AuthProvisionsResponse.provisions:type_name -> keys.AuthProvision
-	117, // 26: keys.UserAddResponse.user:type_name -> keys.User
-	26,  // 27: keys.UserAddResponse.statement:type_name -> keys.Statement
-	3,   // 28: keys.KeyExportRequest.type:type_name -> keys.ExportType
-	117, // 29: keys.Key.user:type_name -> keys.User
-	87,  // 30: keys.KeyResponse.key:type_name -> keys.Key
-	4,   // 31: keys.KeysRequest.sortDirection:type_name -> keys.SortDirection
-	87,  // 32: keys.KeysResponse.keys:type_name -> keys.Key
-	4,   // 33: keys.KeysResponse.sortDirection:type_name -> keys.SortDirection
-	5,   // 34: keys.Secret.type:type_name -> keys.SecretType
-	92,  // 35: keys.SecretResponse.secret:type_name -> keys.Secret
-	92,  // 36: keys.SecretSaveRequest.secret:type_name -> keys.Secret
-	92,  // 37: keys.SecretSaveResponse.secret:type_name -> keys.Secret
-	4,   // 38: keys.SecretsRequest.sortDirection:type_name -> keys.SortDirection
-	92,  // 39: keys.SecretsResponse.secrets:type_name -> keys.Secret
-	4,   // 40: keys.SecretsResponse.sortDirection:type_name -> keys.SortDirection
-	6,   // 41: keys.RandRequest.encoding:type_name -> keys.Encoding
-	109, // 42: keys.CollectionsResponse.collections:type_name -> keys.Collection
-	112, // 43: keys.DocumentsResponse.documents:type_name -> keys.Document
-	7,   // 44: keys.User.status:type_name -> keys.UserStatus
-	117, // 45: keys.UserResponse.user:type_name -> keys.User
-	117, // 46: keys.UserSearchResponse.users:type_name -> keys.User
-	87,  // 47: keys.SearchResponse.keys:type_name -> keys.Key
-	87,  // 48: keys.Message.sender:type_name -> keys.Key
-	8,   // 49: keys.Message.status:type_name -> keys.MessageStatus
-	134, // 50: keys.MessagePrepareResponse.message:type_name -> keys.Message
-	134, // 51: keys.MessageCreateResponse.message:type_name -> keys.Message
-	134, // 52: keys.MessagesResponse.messages:type_name -> keys.Message
-	9,   // 53: keys.NotifyStreamOutput.type:type_name -> keys.NotificationType
-	143, // 54: keys.ChannelsResponse.channels:type_name -> keys.Channel
-	143, // 55: keys.ChannelCreateResponse.channel:type_name -> keys.Channel
-	166, // 56: keys.Config.app:type_name -> keys.Config.App
-	167, // 57: keys.Config.encrypt:type_name -> keys.Config.Encrypt
-	168, // 58: keys.Config.sign:type_name -> keys.Config.Sign
-	156, // 59: keys.ConfigGetResponse.config:type_name -> keys.Config
-	156, // 60: keys.ConfigSetRequest.config:type_name -> keys.Config
-	87,  // 61: keys.WormholeMessage.sender:type_name -> keys.Key
-	87,  // 62: keys.WormholeMessage.recipient:type_name -> keys.Key
-	11,  // 63: keys.WormholeMessage.status:type_name -> keys.WormholeMessageStatus
-	164, // 64: keys.WormholeOutput.message:type_name -> keys.WormholeMessage
-	10,  // 65: keys.WormholeOutput.status:type_name -> keys.WormholeStatus
-	73,  // 66: keys.Keys.KeyGenerate:input_type -> keys.KeyGenerateRequest
-	90,  // 67: keys.Keys.Keys:input_type -> keys.KeysRequest
-	88,  // 68: keys.Keys.Key:input_type -> keys.KeyRequest
-	83,  // 69: keys.Keys.KeyImport:input_type -> keys.KeyImportRequest
-	81,  // 70: keys.Keys.KeyExport:input_type -> keys.KeyExportRequest
-	85,  // 71: keys.Keys.KeyRemove:input_type -> keys.KeyRemoveRequest
-	12,  // 72: keys.Keys.Sign:input_type -> keys.SignRequest
-	14,  // 73: keys.Keys.SignFile:input_type -> keys.SignFileInput
-	35,  // 74: keys.Keys.SignStream:input_type -> keys.SignInput
-	16,  // 75: keys.Keys.Verify:input_type -> keys.VerifyRequest
-	22,  // 76: keys.Keys.VerifyFile:input_type -> keys.VerifyFileInput
-	20,  // 77: keys.Keys.VerifyStream:input_type -> keys.VerifyInput
-	18,  // 78: keys.Keys.VerifyDetached:input_type -> keys.VerifyDetachedRequest
-	24,  // 79: keys.Keys.VerifyDetachedFile:input_type -> keys.VerifyDetachedFileInput
-	25,  // 80: keys.Keys.VerifyDetachedStream:input_type -> keys.VerifyDetachedInput
-	38,  // 81: keys.Keys.Encrypt:input_type -> keys.EncryptRequest
-	42,  // 82: keys.Keys.EncryptStream:input_type -> keys.EncryptInput
-	40,  // 83: keys.Keys.EncryptFile:input_type -> keys.EncryptFileInput
-	44,  // 84: keys.Keys.Decrypt:input_type -> keys.DecryptRequest
-	48,  // 85: keys.Keys.DecryptStream:input_type -> keys.DecryptInput
-	46,  // 86: keys.Keys.DecryptFile:input_type -> keys.DecryptFileInput
-	27,  // 87: keys.Keys.Sigchain:input_type -> keys.SigchainRequest
-	29,  // 88: keys.Keys.Statement:input_type -> keys.StatementRequest
-	31,  // 89: keys.Keys.StatementCreate:input_type -> keys.StatementCreateRequest
-	33,  // 90: keys.Keys.StatementRevoke:input_type -> keys.StatementRevokeRequest
-	118, // 91: keys.Keys.User:input_type -> keys.UserRequest
-	120, // 92: keys.Keys.UserSearch:input_type -> keys.UserSearchRequest
-	75,  // 93: keys.Keys.UserService:input_type -> keys.UserServiceRequest
-	77,  // 94: keys.Keys.UserSign:input_type -> keys.UserSignRequest
-	79,  // 95: keys.Keys.UserAdd:input_type -> keys.UserAddRequest
-	122, // 96: keys.Keys.Search:input_type -> keys.SearchRequest
-	93,  // 97: keys.Keys.Secret:input_type -> keys.SecretRequest
-	95,  // 98: keys.Keys.SecretSave:input_type -> keys.SecretSaveRequest
-	97,  // 99: keys.Keys.SecretRemove:input_type -> keys.SecretRemoveRequest
-	99,  // 100: keys.Keys.Secrets:input_type -> keys.SecretsRequest
-	105, // 101: keys.Keys.Pull:input_type -> keys.PullRequest
-	107, // 102: keys.Keys.Push:input_type -> keys.PushRequest
-	163, // 103: keys.Keys.Wormhole:input_type -> keys.WormholeInput
-	52,  // 104: keys.Keys.AuthSetup:input_type -> keys.AuthSetupRequest
-	54,  // 105: keys.Keys.AuthVault:input_type -> keys.AuthVaultRequest
-	56,  // 106: keys.Keys.AuthUnlock:input_type -> keys.AuthUnlockRequest
-	67,  // 107: keys.Keys.AuthLock:input_type -> keys.AuthLockRequest
-	69,  // 108: keys.Keys.AuthReset:input_type -> keys.AuthResetRequest
-	71,  // 109: keys.Keys.AuthRecover:input_type -> keys.AuthRecoverRequest
-	50,  // 110: keys.Keys.RuntimeStatus:input_type -> keys.RuntimeStatusRequest
-	101, // 111: keys.Keys.Rand:input_type -> keys.RandRequest
-	103, // 112: keys.Keys.RandPassword:input_type -> keys.RandPasswordRequest
-	58,  // 113: keys.Keys.AuthProvision:input_type -> keys.AuthProvisionRequest
-	60,  // 114: keys.Keys.AuthDeprovision:input_type -> keys.AuthDeprovisionRequest
-	65,  // 115: keys.Keys.AuthProvisions:input_type -> keys.AuthProvisionsRequest
-	62,  // 116: keys.Keys.AuthPasswordChange:input_type -> keys.AuthPasswordChangeRequest
-	128, // 117: keys.Keys.VaultAuth:input_type -> keys.VaultAuthRequest
-	124, // 118: keys.Keys.VaultSync:input_type -> keys.VaultSyncRequest
-	126, // 119: keys.Keys.VaultUnsync:input_type -> keys.VaultUnsyncRequest
-	130, // 120: keys.Keys.VaultStatus:input_type -> keys.VaultStatusRequest
-	132, // 121: keys.Keys.VaultUpdate:input_type -> keys.VaultUpdateRequest
-	110, // 122: keys.Keys.Collections:input_type -> keys.CollectionsRequest
-	113, // 123: keys.Keys.Documents:input_type -> keys.DocumentsRequest
-	115, // 124: keys.Keys.DocumentDelete:input_type -> keys.DocumentDeleteRequest
-	157, // 125: keys.Keys.ConfigGet:input_type -> keys.ConfigGetRequest
-	159, // 126: keys.Keys.ConfigSet:input_type -> keys.ConfigSetRequest
-	144, // 127: keys.Keys.Channels:input_type -> keys.ChannelsRequest
-	146, // 128: keys.Keys.ChannelCreate:input_type -> keys.ChannelCreateRequest
-	148, // 129: keys.Keys.ChannelInvitesCreate:input_type -> keys.ChannelInvitesCreateRequest
-	150, // 130: keys.Keys.ChannelInviteAccept:input_type -> keys.ChannelInviteAcceptRequest
-	135, // 131: keys.Keys.MessagePrepare:input_type -> keys.MessagePrepareRequest
-	137, // 132: keys.Keys.MessageCreate:input_type -> keys.MessageCreateRequest
-	139, // 133: keys.Keys.Messages:input_type -> keys.MessagesRequest
-	141, // 134: keys.Keys.NotifyStream:input_type -> keys.NotifyStreamRequest
-	152, // 135: keys.Keys.AdminSignURL:input_type -> keys.AdminSignURLRequest
-	154, // 136: keys.Keys.AdminCheck:input_type -> keys.AdminCheckRequest
-	74,  // 137: keys.Keys.KeyGenerate:output_type -> keys.KeyGenerateResponse
-	91,  // 138: keys.Keys.Keys:output_type -> keys.KeysResponse
-	89,  // 139: keys.Keys.Key:output_type -> keys.KeyResponse
-	84,  // 140: keys.Keys.KeyImport:output_type -> keys.KeyImportResponse
-	82,  // 141: keys.Keys.KeyExport:output_type -> keys.KeyExportResponse
-	86,  // 142: keys.Keys.KeyRemove:output_type -> keys.KeyRemoveResponse
-	13,  // 143: keys.Keys.Sign:output_type -> keys.SignResponse
-	15,  // 144: keys.Keys.SignFile:output_type -> keys.SignFileOutput
-	36,  // 145: keys.Keys.SignStream:output_type -> keys.SignOutput
-	17,  // 146: keys.Keys.Verify:output_type -> keys.VerifyResponse
-	23,  // 147: keys.Keys.VerifyFile:output_type -> keys.VerifyFileOutput
-	21,  // 148: keys.Keys.VerifyStream:output_type -> keys.VerifyOutput
-	19,  // 149: keys.Keys.VerifyDetached:output_type -> keys.VerifyDetachedResponse
-	19,  // 150: keys.Keys.VerifyDetachedFile:output_type -> keys.VerifyDetachedResponse
-	19,  // 151: keys.Keys.VerifyDetachedStream:output_type -> keys.VerifyDetachedResponse
-	39,  // 152: keys.Keys.Encrypt:output_type -> keys.EncryptResponse
-	43,  // 153: keys.Keys.EncryptStream:output_type -> keys.EncryptOutput
-	41,  // 154: keys.Keys.EncryptFile:output_type -> keys.EncryptFileOutput
-	45,  // 155: keys.Keys.Decrypt:output_type -> keys.DecryptResponse
-	49,  // 156: keys.Keys.DecryptStream:output_type -> keys.DecryptOutput
-	47,  // 157: keys.Keys.DecryptFile:output_type -> keys.DecryptFileOutput
-	28,  // 158: keys.Keys.Sigchain:output_type -> keys.SigchainResponse
-	30,  // 159: keys.Keys.Statement:output_type -> keys.StatementResponse
-	32,  // 160: keys.Keys.StatementCreate:output_type -> keys.StatementCreateResponse
-	34,  // 161: keys.Keys.StatementRevoke:output_type -> keys.StatementRevokeResponse
-	119, // 162: keys.Keys.User:output_type -> keys.UserResponse
-	121, // 163: keys.Keys.UserSearch:output_type -> keys.UserSearchResponse
-	76,  // 164: keys.Keys.UserService:output_type -> keys.UserServiceResponse
-	78,  // 165: keys.Keys.UserSign:output_type -> keys.UserSignResponse
-	80,  // 166: keys.Keys.UserAdd:output_type -> keys.UserAddResponse
-	123, // 167: keys.Keys.Search:output_type -> keys.SearchResponse
-	94,  // 168: keys.Keys.Secret:output_type -> keys.SecretResponse
-	96,  // 169: keys.Keys.SecretSave:output_type -> keys.SecretSaveResponse
-	98,  // 170: keys.Keys.SecretRemove:output_type -> keys.SecretRemoveResponse
-	100, // 171: keys.Keys.Secrets:output_type -> keys.SecretsResponse
-	106, // 172: keys.Keys.Pull:output_type -> keys.PullResponse
-	108, // 173: keys.Keys.Push:output_type -> keys.PushResponse
-	165, // 174: keys.Keys.Wormhole:output_type -> keys.WormholeOutput
-	53,  // 175: keys.Keys.AuthSetup:output_type -> keys.AuthSetupResponse
-	55,  // 176: keys.Keys.AuthVault:output_type -> keys.AuthVaultResponse
-	57,  // 177: keys.Keys.AuthUnlock:output_type -> keys.AuthUnlockResponse
-	68,  // 178: keys.Keys.AuthLock:output_type -> keys.AuthLockResponse
-	70,  // 179: keys.Keys.AuthReset:output_type -> keys.AuthResetResponse
-	72,  // 180: keys.Keys.AuthRecover:output_type -> keys.AuthRecoverResponse
-	51,  // 181: keys.Keys.RuntimeStatus:output_type -> keys.RuntimeStatusResponse
-	102, // 182: keys.Keys.Rand:output_type -> keys.RandResponse
-	104, // 183: keys.Keys.RandPassword:output_type -> keys.RandPasswordResponse
-	59,  // 184: keys.Keys.AuthProvision:output_type -> keys.AuthProvisionResponse
-	61,  // 185: keys.Keys.AuthDeprovision:output_type -> keys.AuthDeprovisionResponse
-	66,  // 186: keys.Keys.AuthProvisions:output_type -> keys.AuthProvisionsResponse
-	63,  // 187: keys.Keys.AuthPasswordChange:output_type -> keys.AuthPasswordChangeResponse
-	129, // 188: keys.Keys.VaultAuth:output_type -> keys.VaultAuthResponse
-	125, // 189: keys.Keys.VaultSync:output_type -> keys.VaultSyncResponse
-	127, // 190: keys.Keys.VaultUnsync:output_type -> keys.VaultUnsyncResponse
-	131, // 191: keys.Keys.VaultStatus:output_type -> keys.VaultStatusResponse
-	133, // 192: keys.Keys.VaultUpdate:output_type -> keys.VaultUpdateResponse
-	111, // 193: keys.Keys.Collections:output_type -> keys.CollectionsResponse
-	114, // 194: keys.Keys.Documents:output_type -> keys.DocumentsResponse
-	116, // 195: keys.Keys.DocumentDelete:output_type -> keys.DocumentDeleteResponse
-	158, // 196: keys.Keys.ConfigGet:output_type -> keys.ConfigGetResponse
-	160, // 197: keys.Keys.ConfigSet:output_type -> keys.ConfigSetResponse
-	145, // 198: keys.Keys.Channels:output_type -> keys.ChannelsResponse
-	147, // 199: keys.Keys.ChannelCreate:output_type -> keys.ChannelCreateResponse
-	149, // 200: keys.Keys.ChannelInvitesCreate:output_type -> keys.ChannelInvitesCreateResponse
-	151, // 201: keys.Keys.ChannelInviteAccept:output_type -> keys.ChannelInviteAcceptResponse
-	136, // 202: keys.Keys.MessagePrepare:output_type -> keys.MessagePrepareResponse
-	138, // 203: keys.Keys.MessageCreate:output_type -> keys.MessageCreateResponse
-	140, // 204: keys.Keys.Messages:output_type -> keys.MessagesResponse
-	142, // 205: keys.Keys.NotifyStream:output_type -> keys.NotifyStreamOutput
-	153, // 206: keys.Keys.AdminSignURL:output_type -> keys.AdminSignURLResponse
-	155, // 207: keys.Keys.AdminCheck:output_type -> keys.AdminCheckResponse
-	137, // [137:208] is the sub-list for method output_type
-	66,  // [66:137] is the sub-list for method input_type
-	66,  // [66:66] is the sub-list for extension type_name
-	66,  // [66:66] is the sub-list for extension extendee
-	0,   // [0:66] is the sub-list for field type_name
+
+func (x *VaultRemote) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func init() { file_keys_proto_init() }
-func file_keys_proto_init() {
-	if File_keys_proto != nil {
-		return
+type VaultRemoteAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	URL  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *VaultRemoteAddRequest) Reset() {
+	*x = VaultRemoteAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_keys_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignRequest); i {
+}
+
+func (x *VaultRemoteAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRemoteAddRequest) ProtoMessage() {}
+
+func (x *VaultRemoteAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultRemoteAddRequest.ProtoReflect.Descriptor instead.
+func (*VaultRemoteAddRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *VaultRemoteAddRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VaultRemoteAddRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type VaultRemoteAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remote *VaultRemote `protobuf:"bytes,1,opt,name=remote,proto3" json:"remote,omitempty"`
+}
+
+func (x *VaultRemoteAddResponse) Reset() {
+	*x = VaultRemoteAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultRemoteAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRemoteAddResponse) ProtoMessage() {}
+
+func (x *VaultRemoteAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultRemoteAddResponse.ProtoReflect.Descriptor instead.
+func (*VaultRemoteAddResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *VaultRemoteAddResponse) GetRemote() *VaultRemote {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+type VaultRemoteRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *VaultRemoteRemoveRequest) Reset() {
+	*x = VaultRemoteRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultRemoteRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRemoteRemoveRequest) ProtoMessage() {}
+
+func (x *VaultRemoteRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultRemoteRemoveRequest.ProtoReflect.Descriptor instead.
+func (*VaultRemoteRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *VaultRemoteRemoveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type VaultRemoteRemoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultRemoteRemoveResponse) Reset() {
+	*x = VaultRemoteRemoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultRemoteRemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRemoteRemoveResponse) ProtoMessage() {}
+
+func (x *VaultRemoteRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultRemoteRemoveResponse.ProtoReflect.Descriptor instead.
+func (*VaultRemoteRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{161}
+}
+
+type VaultUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultUpdateRequest) Reset() {
+	*x = VaultUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultUpdateRequest) ProtoMessage() {}
+
+func (x *VaultUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultUpdateRequest.ProtoReflect.Descriptor instead.
+func (*VaultUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{162}
+}
+
+type VaultUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultUpdateResponse) Reset() {
+	*x = VaultUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultUpdateResponse) ProtoMessage() {}
+
+func (x *VaultUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultUpdateResponse.ProtoReflect.Descriptor instead.
+func (*VaultUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{163}
+}
+
+type VaultBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path to write backup archive to.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *VaultBackupRequest) Reset() {
+	*x = VaultBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultBackupRequest) ProtoMessage() {}
+
+func (x *VaultBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultBackupRequest.ProtoReflect.Descriptor instead.
+func (*VaultBackupRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *VaultBackupRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type VaultBackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *VaultBackupResponse) Reset() {
+	*x = VaultBackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultBackupResponse) ProtoMessage() {}
+
+func (x *VaultBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultBackupResponse.ProtoReflect.Descriptor instead.
+func (*VaultBackupResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *VaultBackupResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type VaultRestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path to backup archive.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Auth type and secret (password or paper key) for an auth in the backup.
+	Type   AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=keys.AuthType" json:"type,omitempty"`
+	Secret string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// DryRun to list what would be restored.
+	DryRun bool `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *VaultRestoreRequest) Reset() {
+	*x = VaultRestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRestoreRequest) ProtoMessage() {}
+
+func (x *VaultRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultRestoreRequest.ProtoReflect.Descriptor instead.
+func (*VaultRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *VaultRestoreRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VaultRestoreRequest) GetType() AuthType {
+	if x != nil {
+		return x.Type
+	}
+	return UnknownAuth
+}
+
+func (x *VaultRestoreRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *VaultRestoreRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type VaultRestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *VaultRestoreResponse) Reset() {
+	*x = VaultRestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRestoreResponse) ProtoMessage() {}
+
+func (x *VaultRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultRestoreResponse.ProtoReflect.Descriptor instead.
+func (*VaultRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *VaultRestoreResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type VaultItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Deleted if item was removed.
+	Deleted bool `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Secret if item is a secret.
+	Secret    *Secret `protobuf:"bytes,10,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedAt int64   `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *VaultItem) Reset() {
+	*x = VaultItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultItem) ProtoMessage() {}
+
+func (x *VaultItem) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultItem.ProtoReflect.Descriptor instead.
+func (*VaultItem) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *VaultItem) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *VaultItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VaultItem) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VaultItem) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *VaultItem) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *VaultItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type VaultConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Base is the item both changes were made from, if known.
+	Base *VaultItem `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// Current is the local item that was overwritten.
+	Current *VaultItem `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	// Incoming is the remote item that was applied.
+	Incoming *VaultItem `protobuf:"bytes,4,opt,name=incoming,proto3" json:"incoming,omitempty"`
+}
+
+func (x *VaultConflict) Reset() {
+	*x = VaultConflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultConflict) ProtoMessage() {}
+
+func (x *VaultConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultConflict.ProtoReflect.Descriptor instead.
+func (*VaultConflict) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *VaultConflict) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *VaultConflict) GetBase() *VaultItem {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *VaultConflict) GetCurrent() *VaultItem {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *VaultConflict) GetIncoming() *VaultItem {
+	if x != nil {
+		return x.Incoming
+	}
+	return nil
+}
+
+type VaultConflictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultConflictsRequest) Reset() {
+	*x = VaultConflictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultConflictsRequest) ProtoMessage() {}
+
+func (x *VaultConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultConflictsRequest.ProtoReflect.Descriptor instead.
+func (*VaultConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{170}
+}
+
+type VaultConflictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conflicts []*VaultConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *VaultConflictsResponse) Reset() {
+	*x = VaultConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultConflictsResponse) ProtoMessage() {}
+
+func (x *VaultConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultConflictsResponse.ProtoReflect.Descriptor instead.
+func (*VaultConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *VaultConflictsResponse) GetConflicts() []*VaultConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type VaultResolveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Resolution ConflictResolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=keys.ConflictResolution" json:"resolution,omitempty"`
+}
+
+func (x *VaultResolveRequest) Reset() {
+	*x = VaultResolveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultResolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultResolveRequest) ProtoMessage() {}
+
+func (x *VaultResolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultResolveRequest.ProtoReflect.Descriptor instead.
+func (*VaultResolveRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *VaultResolveRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *VaultResolveRequest) GetResolution() ConflictResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return LastWriterWins
+}
+
+type VaultResolveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultResolveResponse) Reset() {
+	*x = VaultResolveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultResolveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultResolveResponse) ProtoMessage() {}
+
+func (x *VaultResolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultResolveResponse.ProtoReflect.Descriptor instead.
+func (*VaultResolveResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{173}
+}
+
+type VaultEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultEventsRequest) Reset() {
+	*x = VaultEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultEventsRequest) ProtoMessage() {}
+
+func (x *VaultEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultEventsRequest.ProtoReflect.Descriptor instead.
+func (*VaultEventsRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{174}
+}
+
+type VaultEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type VaultEventType `protobuf:"varint,1,opt,name=type,proto3,enum=keys.VaultEventType" json:"type,omitempty"`
+	// ID of item (set, delete, pull).
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Deleted if pulled item was deleted.
+	Deleted bool `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Error if sync failed.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *VaultEvent) Reset() {
+	*x = VaultEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultEvent) ProtoMessage() {}
+
+func (x *VaultEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultEvent.ProtoReflect.Descriptor instead.
+func (*VaultEvent) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *VaultEvent) GetType() VaultEventType {
+	if x != nil {
+		return x.Type
+	}
+	return UnspecifiedVaultEvent
+}
+
+func (x *VaultEvent) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *VaultEvent) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *VaultEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender    *Key          `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Text      []string      `protobuf:"bytes,10,rep,name=text,proto3" json:"text,omitempty"`
+	Status    MessageStatus `protobuf:"varint,20,opt,name=status,proto3,enum=keys.MessageStatus" json:"status,omitempty"`
+	CreatedAt int64         `protobuf:"varint,31,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *Message) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Message) GetSender() *Key {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *Message) GetText() []string {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *Message) GetStatus() MessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MessageSent
+}
+
+func (x *Message) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type MessagePrepareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Text    string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *MessagePrepareRequest) Reset() {
+	*x = MessagePrepareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagePrepareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagePrepareRequest) ProtoMessage() {}
+
+func (x *MessagePrepareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagePrepareRequest.ProtoReflect.Descriptor instead.
+func (*MessagePrepareRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *MessagePrepareRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MessagePrepareRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *MessagePrepareRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type MessagePrepareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MessagePrepareResponse) Reset() {
+	*x = MessagePrepareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagePrepareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagePrepareResponse) ProtoMessage() {}
+
+func (x *MessagePrepareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagePrepareResponse.ProtoReflect.Descriptor instead.
+func (*MessagePrepareResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *MessagePrepareResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// ID is optional, if message was prepared you can use that ID, otherwise it
+	// is autogenerated.
+	ID   string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Text string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *MessageCreateRequest) Reset() {
+	*x = MessageCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageCreateRequest) ProtoMessage() {}
+
+func (x *MessageCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageCreateRequest.ProtoReflect.Descriptor instead.
+func (*MessageCreateRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *MessageCreateRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MessageCreateRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *MessageCreateRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *MessageCreateRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type MessageCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MessageCreateResponse) Reset() {
+	*x = MessageCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageCreateResponse) ProtoMessage() {}
+
+func (x *MessageCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageCreateResponse.ProtoReflect.Descriptor instead.
+func (*MessageCreateResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *MessageCreateResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	User    string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Update, if true will update from the server.
+	Update bool `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (x *MessagesRequest) Reset() {
+	*x = MessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesRequest) ProtoMessage() {}
+
+func (x *MessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesRequest.ProtoReflect.Descriptor instead.
+func (*MessagesRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *MessagesRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *MessagesRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *MessagesRequest) GetUpdate() bool {
+	if x != nil {
+		return x.Update
+	}
+	return false
+}
+
+type MessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *MessagesResponse) Reset() {
+	*x = MessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesResponse) ProtoMessage() {}
+
+func (x *MessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesResponse.ProtoReflect.Descriptor instead.
+func (*MessagesResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *MessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type NotifyStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NotifyStreamRequest) Reset() {
+	*x = NotifyStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyStreamRequest) ProtoMessage() {}
+
+func (x *NotifyStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyStreamRequest.ProtoReflect.Descriptor instead.
+func (*NotifyStreamRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{183}
+}
+
+type NotifyStreamOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    NotificationType `protobuf:"varint,1,opt,name=type,proto3,enum=keys.NotificationType" json:"type,omitempty"`
+	Channel string           `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	User    string           `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Index   int64            `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// Secret (for SecretExpiringNotification).
+	SecretID   string `protobuf:"bytes,5,opt,name=secretId,proto3" json:"secretId,omitempty"`
+	SecretName string `protobuf:"bytes,6,opt,name=secretName,proto3" json:"secretName,omitempty"`
+	ExpireAt   int64  `protobuf:"varint,7,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+}
+
+func (x *NotifyStreamOutput) Reset() {
+	*x = NotifyStreamOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyStreamOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyStreamOutput) ProtoMessage() {}
+
+func (x *NotifyStreamOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyStreamOutput.ProtoReflect.Descriptor instead.
+func (*NotifyStreamOutput) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *NotifyStreamOutput) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return UnspecifiedNotification
+}
+
+func (x *NotifyStreamOutput) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *NotifyStreamOutput) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *NotifyStreamOutput) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NotifyStreamOutput) GetSecretID() string {
+	if x != nil {
+		return x.SecretID
+	}
+	return ""
+}
+
+func (x *NotifyStreamOutput) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *NotifyStreamOutput) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+type Channel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Snippet   string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Index     int64  `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *Channel) Reset() {
+	*x = Channel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Channel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Channel) ProtoMessage() {}
+
+func (x *Channel) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *Channel) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Channel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Channel) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *Channel) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Channel) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type ChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ChannelsRequest) Reset() {
+	*x = ChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelsRequest) ProtoMessage() {}
+
+func (x *ChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ChannelsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ChannelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ChannelsResponse) Reset() {
+	*x = ChannelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelsResponse) ProtoMessage() {}
+
+func (x *ChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ChannelsResponse) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ChannelCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ChannelCreateRequest) Reset() {
+	*x = ChannelCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelCreateRequest) ProtoMessage() {}
+
+func (x *ChannelCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelCreateRequest.ProtoReflect.Descriptor instead.
+func (*ChannelCreateRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *ChannelCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelCreateRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ChannelCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel *Channel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *ChannelCreateResponse) Reset() {
+	*x = ChannelCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelCreateResponse) ProtoMessage() {}
+
+func (x *ChannelCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelCreateResponse.ProtoReflect.Descriptor instead.
+func (*ChannelCreateResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *ChannelCreateResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ChannelInvitesCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel    string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sender     string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (x *ChannelInvitesCreateRequest) Reset() {
+	*x = ChannelInvitesCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelInvitesCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInvitesCreateRequest) ProtoMessage() {}
+
+func (x *ChannelInvitesCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelInvitesCreateRequest.ProtoReflect.Descriptor instead.
+func (*ChannelInvitesCreateRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *ChannelInvitesCreateRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelInvitesCreateRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ChannelInvitesCreateRequest) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type ChannelInvitesCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChannelInvitesCreateResponse) Reset() {
+	*x = ChannelInvitesCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelInvitesCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInvitesCreateResponse) ProtoMessage() {}
+
+func (x *ChannelInvitesCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelInvitesCreateResponse.ProtoReflect.Descriptor instead.
+func (*ChannelInvitesCreateResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{191}
+}
+
+type ChannelInviteAcceptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	User    string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ChannelInviteAcceptRequest) Reset() {
+	*x = ChannelInviteAcceptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelInviteAcceptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInviteAcceptRequest) ProtoMessage() {}
+
+func (x *ChannelInviteAcceptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelInviteAcceptRequest.ProtoReflect.Descriptor instead.
+func (*ChannelInviteAcceptRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ChannelInviteAcceptRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelInviteAcceptRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ChannelInviteAcceptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChannelInviteAcceptResponse) Reset() {
+	*x = ChannelInviteAcceptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelInviteAcceptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelInviteAcceptResponse) ProtoMessage() {}
+
+func (x *ChannelInviteAcceptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelInviteAcceptResponse.ProtoReflect.Descriptor instead.
+func (*ChannelInviteAcceptResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{193}
+}
+
+type AdminSignURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	URL    string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *AdminSignURLRequest) Reset() {
+	*x = AdminSignURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSignURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSignURLRequest) ProtoMessage() {}
+
+func (x *AdminSignURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSignURLRequest.ProtoReflect.Descriptor instead.
+func (*AdminSignURLRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *AdminSignURLRequest) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *AdminSignURLRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AdminSignURLRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type AdminSignURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth string `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	URL  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	CURL string `protobuf:"bytes,3,opt,name=curl,proto3" json:"curl,omitempty"`
+}
+
+func (x *AdminSignURLResponse) Reset() {
+	*x = AdminSignURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSignURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSignURLResponse) ProtoMessage() {}
+
+func (x *AdminSignURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSignURLResponse.ProtoReflect.Descriptor instead.
+func (*AdminSignURLResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *AdminSignURLResponse) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *AdminSignURLResponse) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *AdminSignURLResponse) GetCURL() string {
+	if x != nil {
+		return x.CURL
+	}
+	return ""
+}
+
+type AdminCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Check  string `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+}
+
+func (x *AdminCheckRequest) Reset() {
+	*x = AdminCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCheckRequest) ProtoMessage() {}
+
+func (x *AdminCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCheckRequest.ProtoReflect.Descriptor instead.
+func (*AdminCheckRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *AdminCheckRequest) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *AdminCheckRequest) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+type AdminCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminCheckResponse) Reset() {
+	*x = AdminCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCheckResponse) ProtoMessage() {}
+
+func (x *AdminCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCheckResponse.ProtoReflect.Descriptor instead.
+func (*AdminCheckResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{197}
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	App     *Config_App     `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	Encrypt *Config_Encrypt `protobuf:"bytes,10,opt,name=encrypt,proto3" json:"encrypt,omitempty"`
+	Sign    *Config_Sign    `protobuf:"bytes,11,opt,name=sign,proto3" json:"sign,omitempty"`
+	Lock    *Config_Lock    `protobuf:"bytes,12,opt,name=lock,proto3" json:"lock,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *Config) GetApp() *Config_App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+func (x *Config) GetEncrypt() *Config_Encrypt {
+	if x != nil {
+		return x.Encrypt
+	}
+	return nil
+}
+
+func (x *Config) GetSign() *Config_Sign {
+	if x != nil {
+		return x.Sign
+	}
+	return nil
+}
+
+func (x *Config) GetLock() *Config_Lock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+type ConfigGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ConfigGetRequest) Reset() {
+	*x = ConfigGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigGetRequest) ProtoMessage() {}
+
+func (x *ConfigGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigGetRequest.ProtoReflect.Descriptor instead.
+func (*ConfigGetRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *ConfigGetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConfigGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ConfigGetResponse) Reset() {
+	*x = ConfigGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigGetResponse) ProtoMessage() {}
+
+func (x *ConfigGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigGetResponse.ProtoReflect.Descriptor instead.
+func (*ConfigGetResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *ConfigGetResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ConfigSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config *Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ConfigSetRequest) Reset() {
+	*x = ConfigSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSetRequest) ProtoMessage() {}
+
+func (x *ConfigSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSetRequest.ProtoReflect.Descriptor instead.
+func (*ConfigSetRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *ConfigSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigSetRequest) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ConfigSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConfigSetResponse) Reset() {
+	*x = ConfigSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSetResponse) ProtoMessage() {}
+
+func (x *ConfigSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSetResponse.ProtoReflect.Descriptor instead.
+func (*ConfigSetResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{202}
+}
+
+type RelayInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RelayInput) Reset() {
+	*x = RelayInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayInput) ProtoMessage() {}
+
+func (x *RelayInput) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayInput.ProtoReflect.Descriptor instead.
+func (*RelayInput) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{203}
+}
+
+type RelayOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+}
+
+func (x *RelayOutput) Reset() {
+	*x = RelayOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayOutput) ProtoMessage() {}
+
+func (x *RelayOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayOutput.ProtoReflect.Descriptor instead.
+func (*RelayOutput) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *RelayOutput) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
+}
+
+type WormholeInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Invite    string `protobuf:"bytes,3,opt,name=invite,proto3" json:"invite,omitempty"`
+	ID        string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *WormholeInput) Reset() {
+	*x = WormholeInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WormholeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormholeInput) ProtoMessage() {}
+
+func (x *WormholeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WormholeInput.ProtoReflect.Descriptor instead.
+func (*WormholeInput) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *WormholeInput) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *WormholeInput) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *WormholeInput) GetInvite() string {
+	if x != nil {
+		return x.Invite
+	}
+	return ""
+}
+
+func (x *WormholeInput) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *WormholeInput) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WormholeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender    *Key                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient *Key                  `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Status    WormholeMessageStatus `protobuf:"varint,5,opt,name=status,proto3,enum=keys.WormholeMessageStatus" json:"status,omitempty"`
+	Text      string                `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt int64                 `protobuf:"varint,21,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *WormholeMessage) Reset() {
+	*x = WormholeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WormholeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormholeMessage) ProtoMessage() {}
+
+func (x *WormholeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WormholeMessage.ProtoReflect.Descriptor instead.
+func (*WormholeMessage) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *WormholeMessage) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *WormholeMessage) GetSender() *Key {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *WormholeMessage) GetRecipient() *Key {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *WormholeMessage) GetStatus() WormholeMessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WormholeMessageSent
+}
+
+func (x *WormholeMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *WormholeMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type WormholeOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *WormholeMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Status  WormholeStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=keys.WormholeStatus" json:"status,omitempty"`
+}
+
+func (x *WormholeOutput) Reset() {
+	*x = WormholeOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[207]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WormholeOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormholeOutput) ProtoMessage() {}
+
+func (x *WormholeOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[207]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WormholeOutput.ProtoReflect.Descriptor instead.
+func (*WormholeOutput) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *WormholeOutput) GetMessage() *WormholeMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *WormholeOutput) GetStatus() WormholeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WormholeDefault
+}
+
+type SharedCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt int64  `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *SharedCollection) Reset() {
+	*x = SharedCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[208]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollection) ProtoMessage() {}
+
+func (x *SharedCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[208]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollection.ProtoReflect.Descriptor instead.
+func (*SharedCollection) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *SharedCollection) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *SharedCollection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SharedCollection) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SharedCollectionMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Sender is who invited the member.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *SharedCollectionMember) Reset() {
+	*x = SharedCollectionMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[209]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionMember) ProtoMessage() {}
+
+func (x *SharedCollectionMember) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[209]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionMember.ProtoReflect.Descriptor instead.
+func (*SharedCollectionMember) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *SharedCollectionMember) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
+}
+
+func (x *SharedCollectionMember) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+type SharedCollectionInvite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sender     string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *SharedCollectionInvite) Reset() {
+	*x = SharedCollectionInvite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[210]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionInvite) ProtoMessage() {}
+
+func (x *SharedCollectionInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[210]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionInvite.ProtoReflect.Descriptor instead.
+func (*SharedCollectionInvite) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *SharedCollectionInvite) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SharedCollectionInvite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SharedCollectionInvite) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+type SharedCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SharedCollectionsRequest) Reset() {
+	*x = SharedCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[211]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionsRequest) ProtoMessage() {}
+
+func (x *SharedCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[211]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{211}
+}
+
+type SharedCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*SharedCollection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+}
+
+func (x *SharedCollectionsResponse) Reset() {
+	*x = SharedCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[212]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionsResponse) ProtoMessage() {}
+
+func (x *SharedCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[212]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *SharedCollectionsResponse) GetCollections() []*SharedCollection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type SharedCollectionCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *SharedCollectionCreateRequest) Reset() {
+	*x = SharedCollectionCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[213]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionCreateRequest) ProtoMessage() {}
+
+func (x *SharedCollectionCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[213]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionCreateRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionCreateRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *SharedCollectionCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SharedCollectionCreateRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type SharedCollectionCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *SharedCollection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *SharedCollectionCreateResponse) Reset() {
+	*x = SharedCollectionCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[214]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionCreateResponse) ProtoMessage() {}
+
+func (x *SharedCollectionCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[214]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionCreateResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionCreateResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *SharedCollectionCreateResponse) GetCollection() *SharedCollection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type SharedCollectionInviteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Sender     string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (x *SharedCollectionInviteRequest) Reset() {
+	*x = SharedCollectionInviteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[215]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionInviteRequest) ProtoMessage() {}
+
+func (x *SharedCollectionInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[215]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionInviteRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionInviteRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *SharedCollectionInviteRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SharedCollectionInviteRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SharedCollectionInviteRequest) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type SharedCollectionInviteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SharedCollectionInviteResponse) Reset() {
+	*x = SharedCollectionInviteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[216]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionInviteResponse) ProtoMessage() {}
+
+func (x *SharedCollectionInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[216]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionInviteResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionInviteResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{216}
+}
+
+type SharedCollectionInvitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *SharedCollectionInvitesRequest) Reset() {
+	*x = SharedCollectionInvitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[217]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionInvitesRequest) ProtoMessage() {}
+
+func (x *SharedCollectionInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[217]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionInvitesRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *SharedCollectionInvitesRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type SharedCollectionInvitesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invites []*SharedCollectionInvite `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+}
+
+func (x *SharedCollectionInvitesResponse) Reset() {
+	*x = SharedCollectionInvitesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[218]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionInvitesResponse) ProtoMessage() {}
+
+func (x *SharedCollectionInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[218]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionInvitesResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *SharedCollectionInvitesResponse) GetInvites() []*SharedCollectionInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type SharedCollectionAcceptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	User       string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *SharedCollectionAcceptRequest) Reset() {
+	*x = SharedCollectionAcceptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[219]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionAcceptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionAcceptRequest) ProtoMessage() {}
+
+func (x *SharedCollectionAcceptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[219]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionAcceptRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionAcceptRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *SharedCollectionAcceptRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SharedCollectionAcceptRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type SharedCollectionAcceptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *SharedCollection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *SharedCollectionAcceptResponse) Reset() {
+	*x = SharedCollectionAcceptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[220]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionAcceptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionAcceptResponse) ProtoMessage() {}
+
+func (x *SharedCollectionAcceptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[220]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionAcceptResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionAcceptResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *SharedCollectionAcceptResponse) GetCollection() *SharedCollection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type SharedCollectionMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *SharedCollectionMembersRequest) Reset() {
+	*x = SharedCollectionMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[221]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionMembersRequest) ProtoMessage() {}
+
+func (x *SharedCollectionMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[221]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionMembersRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionMembersRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *SharedCollectionMembersRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type SharedCollectionMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*SharedCollectionMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *SharedCollectionMembersResponse) Reset() {
+	*x = SharedCollectionMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[222]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionMembersResponse) ProtoMessage() {}
+
+func (x *SharedCollectionMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[222]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionMembersResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionMembersResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *SharedCollectionMembersResponse) GetMembers() []*SharedCollectionMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SharedCollectionRemoveMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Sender     string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Member     string `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *SharedCollectionRemoveMemberRequest) Reset() {
+	*x = SharedCollectionRemoveMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[223]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionRemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionRemoveMemberRequest) ProtoMessage() {}
+
+func (x *SharedCollectionRemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[223]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionRemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionRemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *SharedCollectionRemoveMemberRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SharedCollectionRemoveMemberRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SharedCollectionRemoveMemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type SharedCollectionRemoveMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Collection (rotated) with a new ID.
+	Collection *SharedCollection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *SharedCollectionRemoveMemberResponse) Reset() {
+	*x = SharedCollectionRemoveMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[224]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionRemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionRemoveMemberResponse) ProtoMessage() {}
+
+func (x *SharedCollectionRemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[224]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionRemoveMemberResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionRemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *SharedCollectionRemoveMemberResponse) GetCollection() *SharedCollection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type SharedCollectionLeaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	User       string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *SharedCollectionLeaveRequest) Reset() {
+	*x = SharedCollectionLeaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[225]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionLeaveRequest) ProtoMessage() {}
+
+func (x *SharedCollectionLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[225]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionLeaveRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *SharedCollectionLeaveRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SharedCollectionLeaveRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type SharedCollectionLeaveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SharedCollectionLeaveResponse) Reset() {
+	*x = SharedCollectionLeaveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[226]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionLeaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionLeaveResponse) ProtoMessage() {}
+
+func (x *SharedCollectionLeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[226]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionLeaveResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionLeaveResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{226}
+}
+
+type SharedCollectionSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *SharedCollectionSyncRequest) Reset() {
+	*x = SharedCollectionSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[227]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionSyncRequest) ProtoMessage() {}
+
+func (x *SharedCollectionSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[227]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionSyncRequest.ProtoReflect.Descriptor instead.
+func (*SharedCollectionSyncRequest) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *SharedCollectionSyncRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type SharedCollectionSyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SharedCollectionSyncResponse) Reset() {
+	*x = SharedCollectionSyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[228]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedCollectionSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCollectionSyncResponse) ProtoMessage() {}
+
+func (x *SharedCollectionSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[228]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCollectionSyncResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionSyncResponse) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{228}
+}
+
+type Config_App struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location     string   `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	History      []string `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	NavMinimized bool     `protobuf:"varint,100,opt,name=navMinimized,proto3" json:"navMinimized,omitempty"`
+}
+
+func (x *Config_App) Reset() {
+	*x = Config_App{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[229]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_App) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_App) ProtoMessage() {}
+
+func (x *Config_App) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[229]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_App.ProtoReflect.Descriptor instead.
+func (*Config_App) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{198, 0}
+}
+
+func (x *Config_App) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Config_App) GetHistory() []string {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Config_App) GetNavMinimized() bool {
+	if x != nil {
+		return x.NavMinimized
+	}
+	return false
+}
+
+type Config_Encrypt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipients        []string `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Sender            string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	NoSenderRecipient bool     `protobuf:"varint,3,opt,name=noSenderRecipient,proto3" json:"noSenderRecipient,omitempty"`
+	NoSign            bool     `protobuf:"varint,4,opt,name=noSign,proto3" json:"noSign,omitempty"`
+}
+
+func (x *Config_Encrypt) Reset() {
+	*x = Config_Encrypt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[230]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_Encrypt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Encrypt) ProtoMessage() {}
+
+func (x *Config_Encrypt) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[230]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Encrypt.ProtoReflect.Descriptor instead.
+func (*Config_Encrypt) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{198, 1}
+}
+
+func (x *Config_Encrypt) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *Config_Encrypt) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Config_Encrypt) GetNoSenderRecipient() bool {
+	if x != nil {
+		return x.NoSenderRecipient
+	}
+	return false
+}
+
+func (x *Config_Encrypt) GetNoSign() bool {
+	if x != nil {
+		return x.NoSign
+	}
+	return false
+}
+
+type Config_Sign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *Config_Sign) Reset() {
+	*x = Config_Sign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[231]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_Sign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Sign) ProtoMessage() {}
+
+func (x *Config_Sign) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[231]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Sign.ProtoReflect.Descriptor instead.
+func (*Config_Sign) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{198, 2}
+}
+
+func (x *Config_Sign) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+type Config_Lock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IdleTimeout (ms) to lock after no (authorized) requests, 0 to disable.
+	IdleTimeout int64 `protobuf:"varint,1,opt,name=idleTimeout,proto3" json:"idleTimeout,omitempty"`
+	// MaxUnlocked (ms) to lock after unlocked for this long, 0 to disable.
+	MaxUnlocked int64 `protobuf:"varint,2,opt,name=maxUnlocked,proto3" json:"maxUnlocked,omitempty"`
+	// LockOnSuspend to lock if the system was suspended.
+	LockOnSuspend bool `protobuf:"varint,3,opt,name=lockOnSuspend,proto3" json:"lockOnSuspend,omitempty"`
+}
+
+func (x *Config_Lock) Reset() {
+	*x = Config_Lock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keys_proto_msgTypes[232]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_Lock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Lock) ProtoMessage() {}
+
+func (x *Config_Lock) ProtoReflect() protoreflect.Message {
+	mi := &file_keys_proto_msgTypes[232]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Lock.ProtoReflect.Descriptor instead.
+func (*Config_Lock) Descriptor() ([]byte, []int) {
+	return file_keys_proto_rawDescGZIP(), []int{198, 3}
+}
+
+func (x *Config_Lock) GetIdleTimeout() int64 {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return 0
+}
+
+func (x *Config_Lock) GetMaxUnlocked() int64 {
+	if x != nil {
+		return x.MaxUnlocked
+	}
+	return 0
+}
+
+func (x *Config_Lock) GetLockOnSuspend() bool {
+	if x != nil {
+		return x.LockOnSuspend
+	}
+	return false
+}
+
+var File_keys_proto protoreflect.FileDescriptor
+
+var file_keys_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52,
+	0x03, 0x6b, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x6b, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52,
+	0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0x23, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x47, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x22, 0x3d, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22,
+	0x3b, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x0b,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x45, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x22, 0x47, 0x0a, 0x10, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x21, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6f, 0x75, 0x74, 0x22, 0x3b, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69,
+	0x67, 0x22, 0x3b, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xbe,
+	0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x2e, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22,
+	0x60, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x41, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x73, 0x65, 0x71, 0x22, 0x42, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
+	0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x48, 0x0a, 0x17, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
+	0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
+	0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x22, 0x48, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x8f, 0x01, 0x0a,
+	0x09, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x3d,
+	0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca,
+	0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x97, 0x01,
+	0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e, 0x6f,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x39, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x51, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x23, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x24, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x0f, 0x44,
+	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x34, 0x0a, 0x10,
+	0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f,
+	0x75, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6f,
+	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x22, 0x22, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x6d, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x15,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x78, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x78, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e,
+	0x63, 0x12, 0x21, 0x0a, 0x05, 0x66, 0x69, 0x64, 0x6f, 0x32, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x0b, 0xca, 0xb5, 0x03, 0x07, 0x0a, 0x05, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x52, 0x05, 0x66,
+	0x69, 0x64, 0x6f, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x22, 0x7a, 0x0a, 0x10,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a,
+	0x10, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x74,
+	0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7f,
+	0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22,
+	0x32, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x4a, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x16,
+	0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x19, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x19, 0x41,
+	0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22, 0x1c, 0x0a, 0x1a,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x10, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x22,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x31, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05,
+	0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x0d, 0x41,
+	0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x61, 0x67, 0x75,
+	0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06,
+	0x41, 0x41, 0x47, 0x55, 0x49, 0x44, 0x52, 0x06, 0x61, 0x61, 0x67, 0x75, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x50, 0x69, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6e,
+	0x6f, 0x50, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x6e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x4d, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x26, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3f, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x0c, 0x41, 0x75,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x12, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x59, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x30, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x12, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x33,
+	0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x32, 0x0a,
+	0x13, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69,
+	0x64, 0x22, 0x4b, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52,
+	0x03, 0x6b, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x2f,
+	0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22,
+	0x5c, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a,
+	0x10, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x8e, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05,
+	0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x22, 0x60, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52,
+	0x03, 0x6b, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2b,
+	0x0a, 0x11, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3e, 0x0a, 0x10, 0x4b,
+	0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x30, 0x0a, 0x11, 0x4b,
+	0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca,
+	0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x2f, 0x0a,
+	0x10, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x13,
+	0x0a, 0x11, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x61, 0x76,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x29, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x73,
+	0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x62, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x93, 0x03, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04,
+	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55,
+	0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a,
+	0x03, 0x6f, 0x74, 0x70, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05,
+	0x0a, 0x03, 0x4f, 0x54, 0x50, 0x52, 0x03, 0x6f, 0x74, 0x70, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x32, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x33, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x3c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5d, 0x0a, 0x0d, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x59, 0x0a, 0x11, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x12, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x4f, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04,
+	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xed, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x6b, 0x69, 0x70,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x92, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x6f,
+	0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x50, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x48, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x14, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x10, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x11, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x75, 0x0a,
+	0x13, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x12, 0x26, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x90, 0x02, 0x0a, 0x14, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x41, 0x0a, 0x15, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb7,
+	0x01, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0e, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x9f, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x2a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x49, 0x44, 0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x4d, 0x0a, 0x14, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x12, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03,
+	0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x45, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68,
+	0x72, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x62, 0x0a, 0x14,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x61, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x61, 0x76, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x3d, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0xa2, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04,
+	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x58, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x49,
+	0x0a, 0x15, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x14, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca,
+	0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x15, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x52,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75,
+	0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75,
+	0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x22, 0x22,
+	0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x2d, 0x0a, 0x13, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x22, 0x32, 0x0a, 0x14, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1f, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2e, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x4b, 0x49, 0x44, 0x73,
+	0x52, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x4b, 0x0a, 0x0c, 0x50, 0x75, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49,
+	0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73,
+	0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x46, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52, 0x02, 0x64, 0x62,
+	0x22, 0x49, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a, 0x08, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a,
+	0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52,
+	0x02, 0x64, 0x62, 0x22, 0x41, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52, 0x02, 0x64, 0x62, 0x22, 0x18, 0x0a,
+	0x16, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5,
+	0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03,
+	0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x72, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x40,
+	0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05,
+	0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x22, 0x2e, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0x55, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x36, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0x25, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x2f, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65,
+	0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x2f, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
+	0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a,
+	0x18, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52,
+	0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x15, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x11, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98,
+	0x01, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
+	0x6b, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
+	0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x07,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0b, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05,
+	0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b,
+	0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x6c, 0x6c, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x75, 0x6c, 0x6c,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68,
+	0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x15, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x22, 0x43, 0x0a, 0x16, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x22, 0x2e, 0x0a, 0x18, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x29, 0x0a, 0x13,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x7d, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x2c, 0x0a, 0x14, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0d, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x22, 0x17, 0x0a, 0x15, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x16, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x69, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04,
+	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x80, 0x01, 0x0a, 0x0a, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xa5, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03,
+	0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5d, 0x0a, 0x15, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x41, 0x0a, 0x16, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x76, 0x0a,
+	0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x40, 0x0a, 0x15, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x22, 0x3d, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
+	0x15, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a,
+	0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x44, 0x52,
+	0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca,
+	0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a,
+	0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x22, 0x3e, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x6f, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x62, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x67, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69,
+	0x67, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e,
+	0x0a, 0x04, 0x63, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5,
+	0x03, 0x06, 0x0a, 0x04, 0x43, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x63, 0x75, 0x72, 0x6c, 0x22, 0x41,
+	0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x22, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70,
+	0x70, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x07, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x25, 0x0a,
+	0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x04,
+	0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x5f, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x61, 0x76, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x61, 0x76, 0x4d, 0x69, 0x6e, 0x69,
+	0x6d, 0x69, 0x7a, 0x65, 0x64, 0x1a, 0x87, 0x01, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x6f, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x1a,
+	0x1e, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x1a,
+	0x70, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x64,
+	0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4f, 0x6e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x6e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x22, 0x26, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x11, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x4c, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x0a, 0x0a, 0x52, 0x65, 0x6c, 0x61, 0x79,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x2a, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69,
+	0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca,
+	0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0xde, 0x01, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x6f, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68,
+	0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68,
+	0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x5e, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x4d, 0x0a, 0x16, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x03, 0x6b,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
+	0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x22, 0x64, 0x0a, 0x16, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x55, 0x0a, 0x19, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x1d, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0x58, 0x0a, 0x1e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x1d,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x1e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x59, 0x0a,
+	0x1f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x1d, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x58, 0x0a,
+	0x1e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x1e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x59, 0x0a, 0x1f, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x22, 0x75, 0x0a, 0x23, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x24, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x1c, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x1f, 0x0a, 0x1d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3d, 0x0a, 0x1b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x1e, 0x0a, 0x1c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0xa9, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x29, 0x0a, 0x0f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59,
+	0x50, 0x54, 0x10, 0x00, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x2b, 0x0a, 0x10, 0x53, 0x41,
+	0x4c, 0x54, 0x50, 0x41, 0x43, 0x4b, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x01,
+	0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x2f, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x54, 0x50,
+	0x41, 0x43, 0x4b, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x03, 0x1a,
+	0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x53,
+	0x69, 0x67, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x2a, 0xbb, 0x01, 0x0a, 0x0a,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0c, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5,
+	0x03, 0x0d, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12,
+	0x2c, 0x0a, 0x11, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x5f, 0x4e, 0x45,
+	0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0d, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02,
+	0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x41, 0x75, 0x74,
+	0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xeb, 0x01, 0x0a, 0x08, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0d, 0x50,
+	0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x0a, 0x1a, 0x12,
+	0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x41, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x10, 0x0b, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x50, 0x61,
+	0x70, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x4b, 0x45,
+	0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x0c, 0x1a, 0x11, 0xca,
+	0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x35, 0x0a, 0x16, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53,
+	0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x14, 0x1a, 0x19, 0xca, 0xb5,
+	0x03, 0x15, 0x0a, 0x13, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x48, 0x4d, 0x41, 0x43, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x2a, 0xa2, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x1a,
+	0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x14, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41, 0x43, 0x4b,
+	0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x1a, 0x14,
+	0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0f, 0x53, 0x53, 0x48, 0x5f, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x02, 0x1a, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a,
+	0x09, 0x53, 0x53, 0x48, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c,
+	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x56, 0x0a, 0x0d,
+	0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x0a, 0x07, 0x53, 0x6f,
+	0x72, 0x74, 0x41, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x1a,
+	0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x1a,
+	0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x87, 0x02, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53,
+	0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x1a, 0x13, 0xca, 0xb5,
+	0x03, 0x0f, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x29, 0x0a, 0x0f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x45,
+	0x43, 0x52, 0x45, 0x54, 0x10, 0x0a, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0e,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0b,
+	0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45,
+	0x43, 0x52, 0x45, 0x54, 0x10, 0x0c, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x43, 0x61,
+	0x72, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x45,
+	0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0d, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a,
+	0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x4f,
+	0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0e, 0x1a, 0x0f, 0xca, 0xb5, 0x03,
+	0x0b, 0x0a, 0x09, 0x4f, 0x54, 0x50, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x10, 0xca, 0xb5,
+	0x03, 0x0c, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2a, 0xae,
+	0x01, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x10, 0x00, 0x1a, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0c, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x10, 0x01, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x48, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x55, 0x52, 0x4c, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x55,
+	0x52, 0x4c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0a, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x03, 0x1a, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x44,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x2a,
+	0xe6, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x03,
+	0x48, 0x45, 0x58, 0x10, 0x00, 0x1a, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x48, 0x65, 0x78,
+	0x12, 0x18, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x36, 0x32, 0x10, 0x01, 0x1a, 0x0c, 0xca, 0xb5,
+	0x03, 0x08, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x36, 0x32, 0x12, 0x18, 0x0a, 0x06, 0x42, 0x41,
+	0x53, 0x45, 0x35, 0x38, 0x10, 0x02, 0x1a, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x42, 0x61,
+	0x73, 0x65, 0x35, 0x38, 0x12, 0x18, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x33, 0x32, 0x10, 0x03,
+	0x1a, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x33, 0x32, 0x12, 0x18,
+	0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x31, 0x36, 0x10, 0x04, 0x1a, 0x0c, 0xca, 0xb5, 0x03, 0x08,
+	0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x31, 0x36, 0x12, 0x18, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45,
+	0x36, 0x34, 0x10, 0x05, 0x1a, 0x0c, 0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65,
+	0x36, 0x34, 0x12, 0x1c, 0x0a, 0x08, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41, 0x43, 0x4b, 0x10, 0x06,
+	0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x05, 0x42, 0x49, 0x50, 0x33, 0x39, 0x10, 0x07, 0x1a, 0x0b, 0xca, 0xb5, 0x03,
+	0x07, 0x0a, 0x05, 0x42, 0x49, 0x50, 0x33, 0x39, 0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2a, 0xff, 0x02, 0x0a, 0x0a, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a,
+	0x11, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x12, 0x1f, 0x0a, 0x07, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x4b, 0x10, 0x01, 0x1a,
+	0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x4f, 0x4b, 0x12, 0x3d, 0x0a, 0x17, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x14,
+	0x1a, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0x0a, 0x1a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45,
+	0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x1e, 0x1a, 0x1f,
+	0xca, 0xb5, 0x03, 0x1b, 0x0a, 0x19, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x38, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x1f, 0x1a, 0x1e, 0xca, 0xb5, 0x03, 0x1a, 0x0a,
+	0x18, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x28,
+	0x1a, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a,
+	0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x32, 0x1a,
+	0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x91, 0x01, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52,
+	0x5f, 0x57, 0x49, 0x4e, 0x53, 0x10, 0x00, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x4c,
+	0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x1d, 0x0a,
+	0x09, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x01, 0x1a, 0x0e, 0xca, 0xb5,
+	0x03, 0x0a, 0x0a, 0x08, 0x4b, 0x65, 0x65, 0x70, 0x42, 0x6f, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x05,
+	0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x02, 0x1a, 0x0b, 0xca, 0xb5, 0x03, 0x07, 0x0a, 0x05, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x1a, 0x18, 0xca, 0xb5, 0x03, 0x14, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xb2,
+	0x03, 0x0a, 0x0e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x38, 0x0a, 0x17, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1b,
+	0xca, 0xb5, 0x03, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x56,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x01, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x56,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x02, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x56, 0x41, 0x55, 0x4c, 0x54,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x03, 0x1a, 0x13, 0xca, 0xb5,
+	0x03, 0x0f, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x12, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a,
+	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x35, 0x0a, 0x16, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x05, 0x1a, 0x19, 0xca,
+	0xb5, 0x03, 0x15, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x17, 0x56, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x4e,
+	0x49, 0x53, 0x48, 0x10, 0x06, 0x1a, 0x1a, 0xca, 0xb5, 0x03, 0x16, 0x0a, 0x14, 0x53, 0x79, 0x6e,
+	0x63, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x56, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x10, 0x07, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x50,
+	0x75, 0x6c, 0x6c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x14, 0xca,
+	0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x2a, 0x74, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0c, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x53, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x1a, 0x14,
+	0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xb3, 0x02, 0x0a, 0x10, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b,
+	0x0a, 0x18, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1d, 0xca, 0xb5,
+	0x03, 0x19, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1c, 0x4e,
+	0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x20, 0xca,
+	0xb5, 0x03, 0x1c, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x42, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10,
+	0x02, 0x1a, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x1a, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2a,
+	0xf0, 0x02, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2b, 0x0a, 0x10, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f,
+	0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x2d, 0x0a, 0x11, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x0a, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x57, 0x6f,
+	0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d,
+	0x0a, 0x11, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x14, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x57, 0x6f, 0x72,
+	0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a,
+	0x12, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x4e, 0x53, 0x57, 0x45, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x15, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57, 0x6f, 0x72,
+	0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2f,
+	0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x53,
+	0x48, 0x41, 0x4b, 0x45, 0x10, 0x28, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57, 0x6f,
+	0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12,
+	0x2f, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x64, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57,
+	0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x2a, 0x0a, 0x0f, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x44, 0x10, 0xc8, 0x01, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x57, 0x6f,
+	0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x1a, 0x14, 0xca, 0xb5,
+	0x03, 0x10, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2a, 0xda, 0x01, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x15,
+	0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x1a, 0x19, 0xca, 0xb5, 0x03, 0x15, 0x0a, 0x13, 0x57,
+	0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x1a, 0x1c, 0xca, 0xb5, 0x03, 0x18, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x32,
+	0x0a, 0x14, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x1a, 0x18, 0xca, 0xb5, 0x03, 0x14, 0x0a, 0x12,
+	0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41,
+	0x63, 0x6b, 0x1a, 0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f,
+	0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32,
+	0x91, 0x39, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b,
+	0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f,
+	0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x2c, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x09, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x09, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x09, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a,
+	0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x0a, 0x53,
+	0x69, 0x67, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0f, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x10, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x13, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0c,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1b, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12,
+	0x53, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x28, 0x01, 0x12, 0x38, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12,
+	0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x44,
+	0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12,
+	0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x0d, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x44,
+	0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x11, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3b, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x15, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x07,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x12, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a,
+	0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61,
+	0x76, 0x65, 0x12, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x38, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x54, 0x50, 0x12,
+	0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12,
+	0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x75, 0x74, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
+	0x12, 0x4c, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65,
+	0x74, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2f,
+	0x0a, 0x04, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x50, 0x75,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x04, 0x50, 0x75, 0x73, 0x68, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3b, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e, 0x0a,
+	0x09, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65,
+	0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x09, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x0a, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3b, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x09, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a,
+	0x0b, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x11, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x47, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x41, 0x75, 0x74,
+	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x07, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x12,
+	0x14, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41,
+	0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x12, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x11, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a,
+	0x0e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12,
+	0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x41, 0x64, 0x64, 0x12, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x56, 0x0a, 0x11, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x11, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1e, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x65, 0x0a, 0x16, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x16, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x68, 0x0a, 0x17, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x16, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x12, 0x23, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x68, 0x0a, 0x17, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x1c, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x15, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x22, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63,
+	0x12, 0x21, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x65,
+	0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x15, 0x2e, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x6b,
+	0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12,
+	0x20, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x15, 0x2e,
+	0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x41, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x17,
+	0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2e, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_keys_proto_rawDescOnce sync.Once
+	file_keys_proto_rawDescData = file_keys_proto_rawDesc
+)
+
+func file_keys_proto_rawDescGZIP() []byte {
+	file_keys_proto_rawDescOnce.Do(func() {
+		file_keys_proto_rawDescData = protoimpl.X.CompressGZIP(file_keys_proto_rawDescData)
+	})
+	return file_keys_proto_rawDescData
+}
+
+var file_keys_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_keys_proto_msgTypes = make([]protoimpl.MessageInfo, 233)
+var file_keys_proto_goTypes = []interface{}{
+	(EncryptMode)(0),                             // 0: keys.EncryptMode
+	(AuthStatus)(0),                              // 1: keys.AuthStatus
+	(AuthType)(0),                                // 2: keys.AuthType
+	(ExportType)(0),                              // 3: keys.ExportType
+	(SortDirection)(0),                           // 4: keys.SortDirection
+	(SecretType)(0),                              // 5: keys.SecretType
+	(SecretFieldType)(0),                         // 6: keys.SecretFieldType
+	(Encoding)(0),                                // 7: keys.Encoding
+	(UserStatus)(0),                              // 8: keys.UserStatus
+	(ConflictResolution)(0),                      // 9: keys.ConflictResolution
+	(VaultEventType)(0),                          // 10: keys.VaultEventType
+	(MessageStatus)(0),                           // 11: keys.MessageStatus
+	(NotificationType)(0),                        // 12: keys.NotificationType
+	(WormholeStatus)(0),                          // 13: keys.WormholeStatus
+	(WormholeMessageStatus)(0),                   // 14: keys.WormholeMessageStatus
+	(*SignRequest)(nil),                          // 15: keys.SignRequest
+	(*SignResponse)(nil),                         // 16: keys.SignResponse
+	(*SignFileInput)(nil),                        // 17: keys.SignFileInput
+	(*SignFileOutput)(nil),                       // 18: keys.SignFileOutput
+	(*VerifyRequest)(nil),                        // 19: keys.VerifyRequest
+	(*VerifyResponse)(nil),                       // 20: keys.VerifyResponse
+	(*VerifyDetachedRequest)(nil),                // 21: keys.VerifyDetachedRequest
+	(*VerifyDetachedResponse)(nil),               // 22: keys.VerifyDetachedResponse
+	(*VerifyInput)(nil),                          // 23: keys.VerifyInput
+	(*VerifyOutput)(nil),                         // 24: keys.VerifyOutput
+	(*VerifyFileInput)(nil),                      // 25: keys.VerifyFileInput
+	(*VerifyFileOutput)(nil),                     // 26: keys.VerifyFileOutput
+	(*VerifyDetachedFileInput)(nil),              // 27: keys.VerifyDetachedFileInput
+	(*VerifyDetachedInput)(nil),                  // 28: keys.VerifyDetachedInput
+	(*Statement)(nil),                            // 29: keys.Statement
+	(*SigchainRequest)(nil),                      // 30: keys.SigchainRequest
+	(*SigchainResponse)(nil),                     // 31: keys.SigchainResponse
+	(*StatementRequest)(nil),                     // 32: keys.StatementRequest
+	(*StatementResponse)(nil),                    // 33: keys.StatementResponse
+	(*StatementCreateRequest)(nil),               // 34: keys.StatementCreateRequest
+	(*StatementCreateResponse)(nil),              // 35: keys.StatementCreateResponse
+	(*StatementRevokeRequest)(nil),               // 36: keys.StatementRevokeRequest
+	(*StatementRevokeResponse)(nil),              // 37: keys.StatementRevokeResponse
+	(*SignInput)(nil),                            // 38: keys.SignInput
+	(*SignOutput)(nil),                           // 39: keys.SignOutput
+	(*EncryptOptions)(nil),                       // 40: keys.EncryptOptions
+	(*EncryptRequest)(nil),                       // 41: keys.EncryptRequest
+	(*EncryptResponse)(nil),                      // 42: keys.EncryptResponse
+	(*EncryptFileInput)(nil),                     // 43: keys.EncryptFileInput
+	(*EncryptFileOutput)(nil),                    // 44: keys.EncryptFileOutput
+	(*EncryptInput)(nil),                         // 45: keys.EncryptInput
+	(*EncryptOutput)(nil),                        // 46: keys.EncryptOutput
+	(*DecryptRequest)(nil),                       // 47: keys.DecryptRequest
+	(*DecryptResponse)(nil),                      // 48: keys.DecryptResponse
+	(*DecryptFileInput)(nil),                     // 49: keys.DecryptFileInput
+	(*DecryptFileOutput)(nil),                    // 50: keys.DecryptFileOutput
+	(*DecryptInput)(nil),                         // 51: keys.DecryptInput
+	(*DecryptOutput)(nil),                        // 52: keys.DecryptOutput
+	(*RuntimeStatusRequest)(nil),                 // 53: keys.RuntimeStatusRequest
+	(*RuntimeStatusResponse)(nil),                // 54: keys.RuntimeStatusResponse
+	(*AuthSetupRequest)(nil),                     // 55: keys.AuthSetupRequest
+	(*AuthSetupResponse)(nil),                    // 56: keys.AuthSetupResponse
+	(*AuthVaultRequest)(nil),                     // 57: keys.AuthVaultRequest
+	(*AuthVaultResponse)(nil),                    // 58: keys.AuthVaultResponse
+	(*AuthUnlockRequest)(nil),                    // 59: keys.AuthUnlockRequest
+	(*AuthUnlockResponse)(nil),                   // 60: keys.AuthUnlockResponse
+	(*AuthProvisionRequest)(nil),                 // 61: keys.AuthProvisionRequest
+	(*AuthProvisionResponse)(nil),                // 62: keys.AuthProvisionResponse
+	(*AuthDeprovisionRequest)(nil),               // 63: keys.AuthDeprovisionRequest
+	(*AuthDeprovisionResponse)(nil),              // 64: keys.AuthDeprovisionResponse
+	(*AuthPasswordChangeRequest)(nil),            // 65: keys.AuthPasswordChangeRequest
+	(*AuthPasswordChangeResponse)(nil),           // 66: keys.AuthPasswordChangeResponse
+	(*AuthRotateSecret)(nil),                     // 67: keys.AuthRotateSecret
+	(*AuthRotateRequest)(nil),                    // 68: keys.AuthRotateRequest
+	(*AuthRotateResponse)(nil),                   // 69: keys.AuthRotateResponse
+	(*AuthProvision)(nil),                        // 70: keys.AuthProvision
+	(*AuthProvisionsRequest)(nil),                // 71: keys.AuthProvisionsRequest
+	(*AuthProvisionsResponse)(nil),               // 72: keys.AuthProvisionsResponse
+	(*AuthLogRequest)(nil),                       // 73: keys.AuthLogRequest
+	(*AuthLogResponse)(nil),                      // 74: keys.AuthLogResponse
+	(*AuthLogEntry)(nil),                         // 75: keys.AuthLogEntry
+	(*AuthTokensRequest)(nil),                    // 76: keys.AuthTokensRequest
+	(*AuthTokensResponse)(nil),                   // 77: keys.AuthTokensResponse
+	(*AuthToken)(nil),                            // 78: keys.AuthToken
+	(*AuthTokenRevokeRequest)(nil),               // 79: keys.AuthTokenRevokeRequest
+	(*AuthTokenRevokeResponse)(nil),              // 80: keys.AuthTokenRevokeResponse
+	(*AuthLockRequest)(nil),                      // 81: keys.AuthLockRequest
+	(*AuthLockResponse)(nil),                     // 82: keys.AuthLockResponse
+	(*AuthResetRequest)(nil),                     // 83: keys.AuthResetRequest
+	(*AuthResetResponse)(nil),                    // 84: keys.AuthResetResponse
+	(*AuthRecoverRequest)(nil),                   // 85: keys.AuthRecoverRequest
+	(*AuthRecoverResponse)(nil),                  // 86: keys.AuthRecoverResponse
+	(*KeyGenerateRequest)(nil),                   // 87: keys.KeyGenerateRequest
+	(*KeyGenerateResponse)(nil),                  // 88: keys.KeyGenerateResponse
+	(*UserServiceRequest)(nil),                   // 89: keys.UserServiceRequest
+	(*UserServiceResponse)(nil),                  // 90: keys.UserServiceResponse
+	(*UserSignRequest)(nil),                      // 91: keys.UserSignRequest
+	(*UserSignResponse)(nil),                     // 92: keys.UserSignResponse
+	(*UserAddRequest)(nil),                       // 93: keys.UserAddRequest
+	(*UserAddResponse)(nil),                      // 94: keys.UserAddResponse
+	(*KeyExportRequest)(nil),                     // 95: keys.KeyExportRequest
+	(*KeyExportResponse)(nil),                    // 96: keys.KeyExportResponse
+	(*KeyImportRequest)(nil),                     // 97: keys.KeyImportRequest
+	(*KeyImportResponse)(nil),                    // 98: keys.KeyImportResponse
+	(*KeyRemoveRequest)(nil),                     // 99: keys.KeyRemoveRequest
+	(*KeyRemoveResponse)(nil),                    // 100: keys.KeyRemoveResponse
+	(*Key)(nil),                                  // 101: keys.Key
+	(*KeyRequest)(nil),                           // 102: keys.KeyRequest
+	(*KeyResponse)(nil),                          // 103: keys.KeyResponse
+	(*KeysRequest)(nil),                          // 104: keys.KeysRequest
+	(*KeysResponse)(nil),                         // 105: keys.KeysResponse
+	(*SecretField)(nil),                          // 106: keys.SecretField
+	(*Secret)(nil),                               // 107: keys.Secret
+	(*SecretRequest)(nil),                        // 108: keys.SecretRequest
+	(*SecretResponse)(nil),                       // 109: keys.SecretResponse
+	(*SecretSaveRequest)(nil),                    // 110: keys.SecretSaveRequest
+	(*SecretSaveResponse)(nil),                   // 111: keys.SecretSaveResponse
+	(*SecretRemoveRequest)(nil),                  // 112: keys.SecretRemoveRequest
+	(*SecretRemoveResponse)(nil),                 // 113: keys.SecretRemoveResponse
+	(*SecretsRequest)(nil),                       // 114: keys.SecretsRequest
+	(*SecretsResponse)(nil),                      // 115: keys.SecretsResponse
+	(*SecretVersion)(nil),                        // 116: keys.SecretVersion
+	(*SecretHistoryRequest)(nil),                 // 117: keys.SecretHistoryRequest
+	(*SecretHistoryResponse)(nil),                // 118: keys.SecretHistoryResponse
+	(*SecretRestoreRequest)(nil),                 // 119: keys.SecretRestoreRequest
+	(*SecretRestoreResponse)(nil),                // 120: keys.SecretRestoreResponse
+	(*SecretOTPRequest)(nil),                     // 121: keys.SecretOTPRequest
+	(*SecretOTPResponse)(nil),                    // 122: keys.SecretOTPResponse
+	(*SecretImportRequest)(nil),                  // 123: keys.SecretImportRequest
+	(*SecretImportResponse)(nil),                 // 124: keys.SecretImportResponse
+	(*SecretsExportRequest)(nil),                 // 125: keys.SecretsExportRequest
+	(*SecretsExportResponse)(nil),                // 126: keys.SecretsExportResponse
+	(*SecretsAuditRequest)(nil),                  // 127: keys.SecretsAuditRequest
+	(*SecretsAuditFinding)(nil),                  // 128: keys.SecretsAuditFinding
+	(*SecretsAuditResponse)(nil),                 // 129: keys.SecretsAuditResponse
+	(*SecretShareRequest)(nil),                   // 130: keys.SecretShareRequest
+	(*SecretShareResponse)(nil),                  // 131: keys.SecretShareResponse
+	(*SecretReceiveRequest)(nil),                 // 132: keys.SecretReceiveRequest
+	(*SecretReceiveResponse)(nil),                // 133: keys.SecretReceiveResponse
+	(*Attachment)(nil),                           // 134: keys.Attachment
+	(*AttachmentPutRequest)(nil),                 // 135: keys.AttachmentPutRequest
+	(*AttachmentPutResponse)(nil),                // 136: keys.AttachmentPutResponse
+	(*AttachmentGetRequest)(nil),                 // 137: keys.AttachmentGetRequest
+	(*AttachmentGetResponse)(nil),                // 138: keys.AttachmentGetResponse
+	(*RandRequest)(nil),                          // 139: keys.RandRequest
+	(*RandResponse)(nil),                         // 140: keys.RandResponse
+	(*RandPasswordRequest)(nil),                  // 141: keys.RandPasswordRequest
+	(*RandPasswordResponse)(nil),                 // 142: keys.RandPasswordResponse
+	(*PullRequest)(nil),                          // 143: keys.PullRequest
+	(*PullResponse)(nil),                         // 144: keys.PullResponse
+	(*PushRequest)(nil),                          // 145: keys.PushRequest
+	(*PushResponse)(nil),                         // 146: keys.PushResponse
+	(*Collection)(nil),                           // 147: keys.Collection
+	(*CollectionsRequest)(nil),                   // 148: keys.CollectionsRequest
+	(*CollectionsResponse)(nil),                  // 149: keys.CollectionsResponse
+	(*Document)(nil),                             // 150: keys.Document
+	(*DocumentsRequest)(nil),                     // 151: keys.DocumentsRequest
+	(*DocumentsResponse)(nil),                    // 152: keys.DocumentsResponse
+	(*DocumentDeleteRequest)(nil),                // 153: keys.DocumentDeleteRequest
+	(*DocumentDeleteResponse)(nil),               // 154: keys.DocumentDeleteResponse
+	(*User)(nil),                                 // 155: keys.User
+	(*UserRequest)(nil),                          // 156: keys.UserRequest
+	(*UserResponse)(nil),                         // 157: keys.UserResponse
+	(*UserSearchRequest)(nil),                    // 158: keys.UserSearchRequest
+	(*UserSearchResponse)(nil),                   // 159: keys.UserSearchResponse
+	(*SearchRequest)(nil),                        // 160: keys.SearchRequest
+	(*SearchResponse)(nil),                       // 161: keys.SearchResponse
+	(*VaultSyncRequest)(nil),                     // 162: keys.VaultSyncRequest
+	(*VaultSyncResponse)(nil),                    // 163: keys.VaultSyncResponse
+	(*VaultSyncProgressRequest)(nil),             // 164: keys.VaultSyncProgressRequest
+	(*VaultSyncProgressOutput)(nil),              // 165: keys.VaultSyncProgressOutput
+	(*VaultUnsyncRequest)(nil),                   // 166: keys.VaultUnsyncRequest
+	(*VaultUnsyncResponse)(nil),                  // 167: keys.VaultUnsyncResponse
+	(*VaultAuthRequest)(nil),                     // 168: keys.VaultAuthRequest
+	(*VaultAuthResponse)(nil),                    // 169: keys.VaultAuthResponse
+	(*VaultStatusRequest)(nil),                   // 170: keys.VaultStatusRequest
+	(*VaultStatusResponse)(nil),                  // 171: keys.VaultStatusResponse
+	(*VaultRemote)(nil),                          // 172: keys.VaultRemote
+	(*VaultRemoteAddRequest)(nil),                // 173: keys.VaultRemoteAddRequest
+	(*VaultRemoteAddResponse)(nil),               // 174: keys.VaultRemoteAddResponse
+	(*VaultRemoteRemoveRequest)(nil),             // 175: keys.VaultRemoteRemoveRequest
+	(*VaultRemoteRemoveResponse)(nil),            // 176: keys.VaultRemoteRemoveResponse
+	(*VaultUpdateRequest)(nil),                   // 177: keys.VaultUpdateRequest
+	(*VaultUpdateResponse)(nil),                  // 178: keys.VaultUpdateResponse
+	(*VaultBackupRequest)(nil),                   // 179: keys.VaultBackupRequest
+	(*VaultBackupResponse)(nil),                  // 180: keys.VaultBackupResponse
+	(*VaultRestoreRequest)(nil),                  // 181: keys.VaultRestoreRequest
+	(*VaultRestoreResponse)(nil),                 // 182: keys.VaultRestoreResponse
+	(*VaultItem)(nil),                            // 183: keys.VaultItem
+	(*VaultConflict)(nil),                        // 184: keys.VaultConflict
+	(*VaultConflictsRequest)(nil),                // 185: keys.VaultConflictsRequest
+	(*VaultConflictsResponse)(nil),               // 186: keys.VaultConflictsResponse
+	(*VaultResolveRequest)(nil),                  // 187: keys.VaultResolveRequest
+	(*VaultResolveResponse)(nil),                 // 188: keys.VaultResolveResponse
+	(*VaultEventsRequest)(nil),                   // 189: keys.VaultEventsRequest
+	(*VaultEvent)(nil),                           // 190: keys.VaultEvent
+	(*Message)(nil),                              // 191: keys.Message
+	(*MessagePrepareRequest)(nil),                // 192: keys.MessagePrepareRequest
+	(*MessagePrepareResponse)(nil),               // 193: keys.MessagePrepareResponse
+	(*MessageCreateRequest)(nil),                 // 194: keys.MessageCreateRequest
+	(*MessageCreateResponse)(nil),                // 195: keys.MessageCreateResponse
+	(*MessagesRequest)(nil),                      // 196: keys.MessagesRequest
+	(*MessagesResponse)(nil),                     // 197: keys.MessagesResponse
+	(*NotifyStreamRequest)(nil),                  // 198: keys.NotifyStreamRequest
+	(*NotifyStreamOutput)(nil),                   // 199: keys.NotifyStreamOutput
+	(*Channel)(nil),                              // 200: keys.Channel
+	(*ChannelsRequest)(nil),                      // 201: keys.ChannelsRequest
+	(*ChannelsResponse)(nil),                     // 202: keys.ChannelsResponse
+	(*ChannelCreateRequest)(nil),                 // 203: keys.ChannelCreateRequest
+	(*ChannelCreateResponse)(nil),                // 204: keys.ChannelCreateResponse
+	(*ChannelInvitesCreateRequest)(nil),          // 205: keys.ChannelInvitesCreateRequest
+	(*ChannelInvitesCreateResponse)(nil),         // 206: keys.ChannelInvitesCreateResponse
+	(*ChannelInviteAcceptRequest)(nil),           // 207: keys.ChannelInviteAcceptRequest
+	(*ChannelInviteAcceptResponse)(nil),          // 208: keys.ChannelInviteAcceptResponse
+	(*AdminSignURLRequest)(nil),                  // 209: keys.AdminSignURLRequest
+	(*AdminSignURLResponse)(nil),                 // 210: keys.AdminSignURLResponse
+	(*AdminCheckRequest)(nil),                    // 211: keys.AdminCheckRequest
+	(*AdminCheckResponse)(nil),                   // 212: keys.AdminCheckResponse
+	(*Config)(nil),                               // 213: keys.Config
+	(*ConfigGetRequest)(nil),                     // 214: keys.ConfigGetRequest
+	(*ConfigGetResponse)(nil),                    // 215: keys.ConfigGetResponse
+	(*ConfigSetRequest)(nil),                     // 216: keys.ConfigSetRequest
+	(*ConfigSetResponse)(nil),                    // 217: keys.ConfigSetResponse
+	(*RelayInput)(nil),                           // 218: keys.RelayInput
+	(*RelayOutput)(nil),                          // 219: keys.RelayOutput
+	(*WormholeInput)(nil),                        // 220: keys.WormholeInput
+	(*WormholeMessage)(nil),                      // 221: keys.WormholeMessage
+	(*WormholeOutput)(nil),                       // 222: keys.WormholeOutput
+	(*SharedCollection)(nil),                     // 223: keys.SharedCollection
+	(*SharedCollectionMember)(nil),               // 224: keys.SharedCollectionMember
+	(*SharedCollectionInvite)(nil),               // 225: keys.SharedCollectionInvite
+	(*SharedCollectionsRequest)(nil),             // 226: keys.SharedCollectionsRequest
+	(*SharedCollectionsResponse)(nil),            // 227: keys.SharedCollectionsResponse
+	(*SharedCollectionCreateRequest)(nil),        // 228: keys.SharedCollectionCreateRequest
+	(*SharedCollectionCreateResponse)(nil),       // 229: keys.SharedCollectionCreateResponse
+	(*SharedCollectionInviteRequest)(nil),        // 230: keys.SharedCollectionInviteRequest
+	(*SharedCollectionInviteResponse)(nil),       // 231: keys.SharedCollectionInviteResponse
+	(*SharedCollectionInvitesRequest)(nil),       // 232: keys.SharedCollectionInvitesRequest
+	(*SharedCollectionInvitesResponse)(nil),      // 233: keys.SharedCollectionInvitesResponse
+	(*SharedCollectionAcceptRequest)(nil),        // 234: keys.SharedCollectionAcceptRequest
+	(*SharedCollectionAcceptResponse)(nil),       // 235: keys.SharedCollectionAcceptResponse
+	(*SharedCollectionMembersRequest)(nil),       // 236: keys.SharedCollectionMembersRequest
+	(*SharedCollectionMembersResponse)(nil),      // 237: keys.SharedCollectionMembersResponse
+	(*SharedCollectionRemoveMemberRequest)(nil),  // 238: keys.SharedCollectionRemoveMemberRequest
+	(*SharedCollectionRemoveMemberResponse)(nil), // 239: keys.SharedCollectionRemoveMemberResponse
+	(*SharedCollectionLeaveRequest)(nil),         // 240: keys.SharedCollectionLeaveRequest
+	(*SharedCollectionLeaveResponse)(nil),        // 241: keys.SharedCollectionLeaveResponse
+	(*SharedCollectionSyncRequest)(nil),          // 242: keys.SharedCollectionSyncRequest
+	(*SharedCollectionSyncResponse)(nil),         // 243: keys.SharedCollectionSyncResponse
+	(*Config_App)(nil),                           // 244: keys.Config.App
+	(*Config_Encrypt)(nil),                       // 245: keys.Config.Encrypt
+	(*Config_Sign)(nil),                          // 246: keys.Config.Sign
+	(*Config_Lock)(nil),                          // 247: keys.Config.Lock
+}
+var file_keys_proto_depIdxs = []int32{
+	101, // 0: keys.VerifyResponse.signer:type_name -> keys.Key
+	101, // 1: keys.VerifyDetachedResponse.signer:type_name -> keys.Key
+	101, // 2: keys.VerifyOutput.signer:type_name -> keys.Key
+	101, // 3: keys.VerifyFileOutput.signer:type_name -> keys.Key
+	101, // 4: keys.SigchainResponse.key:type_name -> keys.Key
+	29,  // 5: keys.SigchainResponse.statements:type_name -> keys.Statement
+	29,  // 6: keys.StatementResponse.statement:type_name -> keys.Statement
+	29,  // 7: keys.StatementCreateResponse.statement:type_name -> keys.Statement
+	29,  // 8: keys.StatementRevokeResponse.statement:type_name -> keys.Statement
+	0,   // 9: keys.EncryptOptions.mode:type_name -> keys.EncryptMode
+	40,  // 10: keys.EncryptRequest.options:type_name -> keys.EncryptOptions
+	40,  // 11: keys.EncryptFileInput.options:type_name -> keys.EncryptOptions
+	40,  // 12: keys.EncryptInput.options:type_name -> keys.EncryptOptions
+	101, // 13: keys.DecryptResponse.sender:type_name -> keys.Key
+	0,   // 14: keys.DecryptResponse.mode:type_name -> keys.EncryptMode
+	101, // 15: keys.DecryptFileOutput.sender:type_name -> keys.Key
+	0,   // 16: keys.DecryptFileOutput.mode:type_name -> keys.EncryptMode
+	101, // 17: keys.DecryptOutput.sender:type_name -> keys.Key
+	0,   // 18: keys.DecryptOutput.mode:type_name -> keys.EncryptMode
+	1,   // 19: keys.RuntimeStatusResponse.authStatus:type_name -> keys.AuthStatus
+	2,   // 20: keys.AuthSetupRequest.type:type_name -> keys.AuthType
+	2,   // 21: keys.AuthUnlockRequest.type:type_name -> keys.AuthType
+	2,   // 22: keys.AuthProvisionRequest.type:type_name -> keys.AuthType
+	70,  // 23: keys.AuthProvisionResponse.provision:type_name -> keys.AuthProvision
+	2,   // 24: keys.AuthRotateSecret.type:type_name -> keys.AuthType
+	67,  // 25: keys.AuthRotateRequest.secrets:type_name -> keys.AuthRotateSecret
+	2,   // 26: keys.AuthProvision.type:type_name -> keys.AuthType
+	70,  // 27: keys.AuthProvisionsResponse.provisions:type_name -> keys.AuthProvision
+	75,  // 28: keys.AuthLogResponse.entries:type_name -> keys.AuthLogEntry
+	2,   // 29: keys.AuthLogEntry.authType:type_name -> keys.AuthType
+	78,  // 30: keys.AuthTokensResponse.tokens:type_name -> keys.AuthToken
+	155, // 31: keys.UserAddResponse.user:type_name -> keys.User
+	29,  // 32: keys.UserAddResponse.statement:type_name -> keys.Statement
+	3,   // 33: keys.KeyExportRequest.type:type_name -> keys.ExportType
+	155, // 34: keys.Key.user:type_name -> keys.User
+	101, // 35: keys.KeyResponse.key:type_name -> keys.Key
+	4,   // 36: keys.KeysRequest.sortDirection:type_name -> keys.SortDirection
+	101, // 37: keys.KeysResponse.keys:type_name -> keys.Key
+	4,   // 38: keys.KeysResponse.sortDirection:type_name -> keys.SortDirection
+	6,   // 39: keys.SecretField.type:type_name -> keys.SecretFieldType
+	5,   // 40: keys.Secret.type:type_name -> keys.SecretType
+	106, // 41: keys.Secret.fields:type_name -> keys.SecretField
+	107, // 42: keys.SecretResponse.secret:type_name -> keys.Secret
+	107, // 43: keys.SecretSaveRequest.secret:type_name -> keys.Secret
+	107, // 44: keys.SecretSaveResponse.secret:type_name -> keys.Secret
+	4,   // 45: keys.SecretsRequest.sortDirection:type_name -> keys.SortDirection
+	107, // 46: keys.SecretsResponse.secrets:type_name -> keys.Secret
+	4,   // 47: keys.SecretsResponse.sortDirection:type_name -> keys.SortDirection
+	107, // 48: keys.SecretVersion.secret:type_name -> keys.Secret
+	116, // 49: keys.SecretHistoryResponse.versions:type_name -> keys.SecretVersion
+	107, // 50: keys.SecretRestoreResponse.secret:type_name -> keys.Secret
+	107, // 51: keys.SecretImportResponse.added:type_name -> keys.Secret
+	107, // 52: keys.SecretImportResponse.skipped:type_name -> keys.Secret
+	40,  // 53: keys.SecretsExportRequest.options:type_name -> keys.EncryptOptions
+	5,   // 54: keys.SecretsExportRequest.types:type_name -> keys.SecretType
+	128, // 55: keys.SecretsAuditResponse.findings:type_name -> keys.SecretsAuditFinding
+	107, // 56: keys.SecretReceiveResponse.secret:type_name -> keys.Secret
+	134, // 57: keys.AttachmentPutResponse.attachment:type_name -> keys.Attachment
+	134, // 58: keys.AttachmentGetResponse.attachment:type_name -> keys.Attachment
+	7,   // 59: keys.RandRequest.encoding:type_name -> keys.Encoding
+	147, // 60: keys.CollectionsResponse.collections:type_name -> keys.Collection
+	150, // 61: keys.DocumentsResponse.documents:type_name -> keys.Document
+	8,   // 62: keys.User.status:type_name -> keys.UserStatus
+	155, // 63: keys.UserResponse.user:type_name -> keys.User
+	155, // 64: keys.UserSearchResponse.users:type_name -> keys.User
+	101, // 65: keys.SearchResponse.keys:type_name -> keys.Key
+	172, // 66: keys.VaultStatusResponse.remotes:type_name -> keys.VaultRemote
+	172, // 67: keys.VaultRemoteAddResponse.remote:type_name -> keys.VaultRemote
+	2,   // 68: keys.VaultRestoreRequest.type:type_name -> keys.AuthType
+	107, // 69: keys.VaultItem.secret:type_name -> keys.Secret
+	183, // 70: keys.VaultConflict.base:type_name -> keys.VaultItem
+	183, // 71: keys.VaultConflict.current:type_name -> keys.VaultItem
+	183, // 72: keys.VaultConflict.incoming:type_name -> keys.VaultItem
+	184, // 73: keys.VaultConflictsResponse.conflicts:type_name -> keys.VaultConflict
+	9,   // 74: keys.VaultResolveRequest.resolution:type_name -> keys.ConflictResolution
+	10,  // 75: keys.VaultEvent.type:type_name -> keys.VaultEventType
+	101, // 76: keys.Message.sender:type_name -> keys.Key
+	11,  // 77: keys.Message.status:type_name -> keys.MessageStatus
+	191, // 78: keys.MessagePrepareResponse.message:type_name -> keys.Message
+	191, // 79: keys.MessageCreateResponse.message:type_name -> keys.Message
+	191, // 80: keys.MessagesResponse.messages:type_name -> keys.Message
+	12,  // 81: keys.NotifyStreamOutput.type:type_name -> keys.NotificationType
+	200, // 82: keys.ChannelsResponse.channels:type_name -> keys.Channel
+	200, // 83: keys.ChannelCreateResponse.channel:type_name -> keys.Channel
+	244, // 84: keys.Config.app:type_name -> keys.Config.App
+	245, // 85: keys.Config.encrypt:type_name -> keys.Config.Encrypt
+	246, // 86: keys.Config.sign:type_name -> keys.Config.Sign
+	247, // 87: keys.Config.lock:type_name -> keys.Config.Lock
+	213, // 88: keys.ConfigGetResponse.config:type_name -> keys.Config
+	213, // 89: keys.ConfigSetRequest.config:type_name -> keys.Config
+	101, // 90: keys.WormholeMessage.sender:type_name -> keys.Key
+	101, // 91: keys.WormholeMessage.recipient:type_name -> keys.Key
+	14,  // 92: keys.WormholeMessage.status:type_name -> keys.WormholeMessageStatus
+	221, // 93: keys.WormholeOutput.message:type_name -> keys.WormholeMessage
+	13,  // 94: keys.WormholeOutput.status:type_name -> keys.WormholeStatus
+	223, // 95: keys.SharedCollectionsResponse.collections:type_name -> keys.SharedCollection
+	223, // 96: keys.SharedCollectionCreateResponse.collection:type_name -> keys.SharedCollection
+	225, // 97: keys.SharedCollectionInvitesResponse.invites:type_name -> keys.SharedCollectionInvite
+	223, // 98: keys.SharedCollectionAcceptResponse.collection:type_name -> keys.SharedCollection
+	224, // 99: keys.SharedCollectionMembersResponse.members:type_name -> keys.SharedCollectionMember
+	223, // 100: keys.SharedCollectionRemoveMemberResponse.collection:type_name -> keys.SharedCollection
+	87,  // 101: keys.Keys.KeyGenerate:input_type -> keys.KeyGenerateRequest
+	104, // 102: keys.Keys.Keys:input_type -> keys.KeysRequest
+	102, // 103: keys.Keys.Key:input_type -> keys.KeyRequest
+	97,  // 104: keys.Keys.KeyImport:input_type -> keys.KeyImportRequest
+	95,  // 105: keys.Keys.KeyExport:input_type -> keys.KeyExportRequest
+	99,  // 106: keys.Keys.KeyRemove:input_type -> keys.KeyRemoveRequest
+	15,  // 107: keys.Keys.Sign:input_type -> keys.SignRequest
+	17,  // 108: keys.Keys.SignFile:input_type -> keys.SignFileInput
+	38,  // 109: keys.Keys.SignStream:input_type -> keys.SignInput
+	19,  // 110: keys.Keys.Verify:input_type -> keys.VerifyRequest
+	25,  // 111: keys.Keys.VerifyFile:input_type -> keys.VerifyFileInput
+	23,  // 112: keys.Keys.VerifyStream:input_type -> keys.VerifyInput
+	21,  // 113: keys.Keys.VerifyDetached:input_type -> keys.VerifyDetachedRequest
+	27,  // 114: keys.Keys.VerifyDetachedFile:input_type -> keys.VerifyDetachedFileInput
+	28,  // 115: keys.Keys.VerifyDetachedStream:input_type -> keys.VerifyDetachedInput
+	41,  // 116: keys.Keys.Encrypt:input_type -> keys.EncryptRequest
+	45,  // 117: keys.Keys.EncryptStream:input_type -> keys.EncryptInput
+	43,  // 118: keys.Keys.EncryptFile:input_type -> keys.EncryptFileInput
+	47,  // 119: keys.Keys.Decrypt:input_type -> keys.DecryptRequest
+	51,  // 120: keys.Keys.DecryptStream:input_type -> keys.DecryptInput
+	49,  // 121: keys.Keys.DecryptFile:input_type -> keys.DecryptFileInput
+	30,  // 122: keys.Keys.Sigchain:input_type -> keys.SigchainRequest
+	32,  // 123: keys.Keys.Statement:input_type -> keys.StatementRequest
+	34,  // 124: keys.Keys.StatementCreate:input_type -> keys.StatementCreateRequest
+	36,  // 125: keys.Keys.StatementRevoke:input_type -> keys.StatementRevokeRequest
+	156, // 126: keys.Keys.User:input_type -> keys.UserRequest
+	158, // 127: keys.Keys.UserSearch:input_type -> keys.UserSearchRequest
+	89,  // 128: keys.Keys.UserService:input_type -> keys.UserServiceRequest
+	91,  // 129: keys.Keys.UserSign:input_type -> keys.UserSignRequest
+	93,  // 130: keys.Keys.UserAdd:input_type -> keys.UserAddRequest
+	160, // 131: keys.Keys.Search:input_type -> keys.SearchRequest
+	108, // 132: keys.Keys.Secret:input_type -> keys.SecretRequest
+	110, // 133: keys.Keys.SecretSave:input_type -> keys.SecretSaveRequest
+	112, // 134: keys.Keys.SecretRemove:input_type -> keys.SecretRemoveRequest
+	114, // 135: keys.Keys.Secrets:input_type -> keys.SecretsRequest
+	117, // 136: keys.Keys.SecretHistory:input_type -> keys.SecretHistoryRequest
+	119, // 137: keys.Keys.SecretRestore:input_type -> keys.SecretRestoreRequest
+	121, // 138: keys.Keys.SecretOTP:input_type -> keys.SecretOTPRequest
+	123, // 139: keys.Keys.SecretImport:input_type -> keys.SecretImportRequest
+	125, // 140: keys.Keys.SecretsExport:input_type -> keys.SecretsExportRequest
+	127, // 141: keys.Keys.SecretsAudit:input_type -> keys.SecretsAuditRequest
+	130, // 142: keys.Keys.SecretShare:input_type -> keys.SecretShareRequest
+	132, // 143: keys.Keys.SecretReceive:input_type -> keys.SecretReceiveRequest
+	135, // 144: keys.Keys.AttachmentPut:input_type -> keys.AttachmentPutRequest
+	137, // 145: keys.Keys.AttachmentGet:input_type -> keys.AttachmentGetRequest
+	143, // 146: keys.Keys.Pull:input_type -> keys.PullRequest
+	145, // 147: keys.Keys.Push:input_type -> keys.PushRequest
+	220, // 148: keys.Keys.Wormhole:input_type -> keys.WormholeInput
+	55,  // 149: keys.Keys.AuthSetup:input_type -> keys.AuthSetupRequest
+	57,  // 150: keys.Keys.AuthVault:input_type -> keys.AuthVaultRequest
+	59,  // 151: keys.Keys.AuthUnlock:input_type -> keys.AuthUnlockRequest
+	81,  // 152: keys.Keys.AuthLock:input_type -> keys.AuthLockRequest
+	83,  // 153: keys.Keys.AuthReset:input_type -> keys.AuthResetRequest
+	85,  // 154: keys.Keys.AuthRecover:input_type -> keys.AuthRecoverRequest
+	53,  // 155: keys.Keys.RuntimeStatus:input_type -> keys.RuntimeStatusRequest
+	139, // 156: keys.Keys.Rand:input_type -> keys.RandRequest
+	141, // 157: keys.Keys.RandPassword:input_type -> keys.RandPasswordRequest
+	61,  // 158: keys.Keys.AuthProvision:input_type -> keys.AuthProvisionRequest
+	63,  // 159: keys.Keys.AuthDeprovision:input_type -> keys.AuthDeprovisionRequest
+	71,  // 160: keys.Keys.AuthProvisions:input_type -> keys.AuthProvisionsRequest
+	65,  // 161: keys.Keys.AuthPasswordChange:input_type -> keys.AuthPasswordChangeRequest
+	68,  // 162: keys.Keys.AuthRotate:input_type -> keys.AuthRotateRequest
+	73,  // 163: keys.Keys.AuthLog:input_type -> keys.AuthLogRequest
+	76,  // 164: keys.Keys.AuthTokens:input_type -> keys.AuthTokensRequest
+	79,  // 165: keys.Keys.AuthTokenRevoke:input_type -> keys.AuthTokenRevokeRequest
+	168, // 166: keys.Keys.VaultAuth:input_type -> keys.VaultAuthRequest
+	162, // 167: keys.Keys.VaultSync:input_type -> keys.VaultSyncRequest
+	164, // 168: keys.Keys.VaultSyncProgress:input_type -> keys.VaultSyncProgressRequest
+	166, // 169: keys.Keys.VaultUnsync:input_type -> keys.VaultUnsyncRequest
+	170, // 170: keys.Keys.VaultStatus:input_type -> keys.VaultStatusRequest
+	177, // 171: keys.Keys.VaultUpdate:input_type -> keys.VaultUpdateRequest
+	185, // 172: keys.Keys.VaultConflicts:input_type -> keys.VaultConflictsRequest
+	187, // 173: keys.Keys.VaultResolve:input_type -> keys.VaultResolveRequest
+	189, // 174: keys.Keys.VaultEvents:input_type -> keys.VaultEventsRequest
+	179, // 175: keys.Keys.VaultBackup:input_type -> keys.VaultBackupRequest
+	181, // 176: keys.Keys.VaultRestore:input_type -> keys.VaultRestoreRequest
+	173, // 177: keys.Keys.VaultRemoteAdd:input_type -> keys.VaultRemoteAddRequest
+	175, // 178: keys.Keys.VaultRemoteRemove:input_type -> keys.VaultRemoteRemoveRequest
+	226, // 179: keys.Keys.SharedCollections:input_type -> keys.SharedCollectionsRequest
+	228, // 180: keys.Keys.SharedCollectionCreate:input_type -> keys.SharedCollectionCreateRequest
+	230, // 181: keys.Keys.SharedCollectionInvite:input_type -> keys.SharedCollectionInviteRequest
+	232, // 182: keys.Keys.SharedCollectionInvites:input_type -> keys.SharedCollectionInvitesRequest
+	234, // 183: keys.Keys.SharedCollectionAccept:input_type -> keys.SharedCollectionAcceptRequest
+	236, // 184: keys.Keys.SharedCollectionMembers:input_type -> keys.SharedCollectionMembersRequest
+	238, // 185: keys.Keys.SharedCollectionRemoveMember:input_type -> keys.SharedCollectionRemoveMemberRequest
+	240, // 186: keys.Keys.SharedCollectionLeave:input_type -> keys.SharedCollectionLeaveRequest
+	242, // 187: keys.Keys.SharedCollectionSync:input_type -> keys.SharedCollectionSyncRequest
+	148, // 188: keys.Keys.Collections:input_type -> keys.CollectionsRequest
+	151, // 189: keys.Keys.Documents:input_type -> keys.DocumentsRequest
+	153, // 190: keys.Keys.DocumentDelete:input_type -> keys.DocumentDeleteRequest
+	214, // 191: keys.Keys.ConfigGet:input_type -> keys.ConfigGetRequest
+	216, // 192: keys.Keys.ConfigSet:input_type -> keys.ConfigSetRequest
+	201, // 193: keys.Keys.Channels:input_type -> keys.ChannelsRequest
+	203, // 194: keys.Keys.ChannelCreate:input_type -> keys.ChannelCreateRequest
+	205, // 195: keys.Keys.ChannelInvitesCreate:input_type -> keys.ChannelInvitesCreateRequest
+	207, // 196: keys.Keys.ChannelInviteAccept:input_type -> keys.ChannelInviteAcceptRequest
+	192, // 197: keys.Keys.MessagePrepare:input_type -> keys.MessagePrepareRequest
+	194, // 198: keys.Keys.MessageCreate:input_type -> keys.MessageCreateRequest
+	196, // 199: keys.Keys.Messages:input_type -> keys.MessagesRequest
+	198, // 200: keys.Keys.NotifyStream:input_type -> keys.NotifyStreamRequest
+	209, // 201: keys.Keys.AdminSignURL:input_type -> keys.AdminSignURLRequest
+	211, // 202: keys.Keys.AdminCheck:input_type -> keys.AdminCheckRequest
+	88,  // 203: keys.Keys.KeyGenerate:output_type -> keys.KeyGenerateResponse
+	105, // 204: keys.Keys.Keys:output_type -> keys.KeysResponse
+	103, // 205: keys.Keys.Key:output_type -> keys.KeyResponse
+	98,  // 206: keys.Keys.KeyImport:output_type -> keys.KeyImportResponse
+	96,  // 207: keys.Keys.KeyExport:output_type -> keys.KeyExportResponse
+	100, // 208: keys.Keys.KeyRemove:output_type -> keys.KeyRemoveResponse
+	16,  // 209: keys.Keys.Sign:output_type -> keys.SignResponse
+	18,  // 210: keys.Keys.SignFile:output_type -> keys.SignFileOutput
+	39,  // 211: keys.Keys.SignStream:output_type -> keys.SignOutput
+	20,  // 212: keys.Keys.Verify:output_type -> keys.VerifyResponse
+	26,  // 213: keys.Keys.VerifyFile:output_type -> keys.VerifyFileOutput
+	24,  // 214: keys.Keys.VerifyStream:output_type -> keys.VerifyOutput
+	22,  // 215: keys.Keys.VerifyDetached:output_type -> keys.VerifyDetachedResponse
+	22,  // 216: keys.Keys.VerifyDetachedFile:output_type -> keys.VerifyDetachedResponse
+	22,  // 217: keys.Keys.VerifyDetachedStream:output_type -> keys.VerifyDetachedResponse
+	42,  // 218: keys.Keys.Encrypt:output_type -> keys.EncryptResponse
+	46,  // 219: keys.Keys.EncryptStream:output_type -> keys.EncryptOutput
+	44,  // 220: keys.Keys.EncryptFile:output_type -> keys.EncryptFileOutput
+	48,  // 221: keys.Keys.Decrypt:output_type -> keys.DecryptResponse
+	52,  // 222: keys.Keys.DecryptStream:output_type -> keys.DecryptOutput
+	50,  // 223: keys.Keys.DecryptFile:output_type -> keys.DecryptFileOutput
+	31,  // 224: keys.Keys.Sigchain:output_type -> keys.SigchainResponse
+	33,  // 225: keys.Keys.Statement:output_type -> keys.StatementResponse
+	35,  // 226: keys.Keys.StatementCreate:output_type -> keys.StatementCreateResponse
+	37,  // 227: keys.Keys.StatementRevoke:output_type -> keys.StatementRevokeResponse
+	157, // 228: keys.Keys.User:output_type -> keys.UserResponse
+	159, // 229: keys.Keys.UserSearch:output_type -> keys.UserSearchResponse
+	90,  // 230: keys.Keys.UserService:output_type -> keys.UserServiceResponse
+	92,  // 231: keys.Keys.UserSign:output_type -> keys.UserSignResponse
+	94,  // 232: keys.Keys.UserAdd:output_type -> keys.UserAddResponse
+	161, // 233: keys.Keys.Search:output_type -> keys.SearchResponse
+	109, // 234: keys.Keys.Secret:output_type -> keys.SecretResponse
+	111, // 235: keys.Keys.SecretSave:output_type -> keys.SecretSaveResponse
+	113, // 236: keys.Keys.SecretRemove:output_type -> keys.SecretRemoveResponse
+	115, // 237: keys.Keys.Secrets:output_type -> keys.SecretsResponse
+	118, // 238: keys.Keys.SecretHistory:output_type -> keys.SecretHistoryResponse
+	120, // 239: keys.Keys.SecretRestore:output_type -> keys.SecretRestoreResponse
+	122, // 240: keys.Keys.SecretOTP:output_type -> keys.SecretOTPResponse
+	124, // 241: keys.Keys.SecretImport:output_type -> keys.SecretImportResponse
+	126, // 242: keys.Keys.SecretsExport:output_type -> keys.SecretsExportResponse
+	129, // 243: keys.Keys.SecretsAudit:output_type -> keys.SecretsAuditResponse
+	131, // 244: keys.Keys.SecretShare:output_type -> keys.SecretShareResponse
+	133, // 245: keys.Keys.SecretReceive:output_type -> keys.SecretReceiveResponse
+	136, // 246: keys.Keys.AttachmentPut:output_type -> keys.AttachmentPutResponse
+	138, // 247: keys.Keys.AttachmentGet:output_type -> keys.AttachmentGetResponse
+	144, // 248: keys.Keys.Pull:output_type -> keys.PullResponse
+	146, // 249: keys.Keys.Push:output_type -> keys.PushResponse
+	222, // 250: keys.Keys.Wormhole:output_type -> keys.WormholeOutput
+	56,  // 251: keys.Keys.AuthSetup:output_type -> keys.AuthSetupResponse
+	58,  // 252: keys.Keys.AuthVault:output_type -> keys.AuthVaultResponse
+	60,  // 253: keys.Keys.AuthUnlock:output_type -> keys.AuthUnlockResponse
+	82,  // 254: keys.Keys.AuthLock:output_type -> keys.AuthLockResponse
+	84,  // 255: keys.Keys.AuthReset:output_type -> keys.AuthResetResponse
+	86,  // 256: keys.Keys.AuthRecover:output_type -> keys.AuthRecoverResponse
+	54,  // 257: keys.Keys.RuntimeStatus:output_type -> keys.RuntimeStatusResponse
+	140, // 258: keys.Keys.Rand:output_type -> keys.RandResponse
+	142, // 259: keys.Keys.RandPassword:output_type -> keys.RandPasswordResponse
+	62,  // 260: keys.Keys.AuthProvision:output_type -> keys.AuthProvisionResponse
+	64,  // 261: keys.Keys.AuthDeprovision:output_type -> keys.AuthDeprovisionResponse
+	72,  // 262: keys.Keys.AuthProvisions:output_type -> keys.AuthProvisionsResponse
+	66,  // 263: keys.Keys.AuthPasswordChange:output_type -> keys.AuthPasswordChangeResponse
+	69,  // 264: keys.Keys.AuthRotate:output_type -> keys.AuthRotateResponse
+	74,  // 265: keys.Keys.AuthLog:output_type -> keys.AuthLogResponse
+	77,  // 266: keys.Keys.AuthTokens:output_type -> keys.AuthTokensResponse
+	80,  // 267: keys.Keys.AuthTokenRevoke:output_type -> keys.AuthTokenRevokeResponse
+	169, // 268: keys.Keys.VaultAuth:output_type -> keys.VaultAuthResponse
+	163, // 269: keys.Keys.VaultSync:output_type -> keys.VaultSyncResponse
+	165, // 270: keys.Keys.VaultSyncProgress:output_type -> keys.VaultSyncProgressOutput
+	167, // 271: keys.Keys.VaultUnsync:output_type -> keys.VaultUnsyncResponse
+	171, // 272: keys.Keys.VaultStatus:output_type -> keys.VaultStatusResponse
+	178, // 273: keys.Keys.VaultUpdate:output_type -> keys.VaultUpdateResponse
+	186, // 274: keys.Keys.VaultConflicts:output_type -> keys.VaultConflictsResponse
+	188, // 275: keys.Keys.VaultResolve:output_type -> keys.VaultResolveResponse
+	190, // 276: keys.Keys.VaultEvents:output_type -> keys.VaultEvent
+	180, // 277: keys.Keys.VaultBackup:output_type -> keys.VaultBackupResponse
+	182, // 278: keys.Keys.VaultRestore:output_type -> keys.VaultRestoreResponse
+	174, // 279: keys.Keys.VaultRemoteAdd:output_type -> keys.VaultRemoteAddResponse
+	176, // 280: keys.Keys.VaultRemoteRemove:output_type -> keys.VaultRemoteRemoveResponse
+	227, // 281: keys.Keys.SharedCollections:output_type -> keys.SharedCollectionsResponse
+	229, // 282: keys.Keys.SharedCollectionCreate:output_type -> keys.SharedCollectionCreateResponse
+	231, // 283: keys.Keys.SharedCollectionInvite:output_type -> keys.SharedCollectionInviteResponse
+	233, // 284: keys.Keys.SharedCollectionInvites:output_type -> keys.SharedCollectionInvitesResponse
+	235, // 285: keys.Keys.SharedCollectionAccept:output_type -> keys.SharedCollectionAcceptResponse
+	237, // 286: keys.Keys.SharedCollectionMembers:output_type -> keys.SharedCollectionMembersResponse
+	239, // 287: keys.Keys.SharedCollectionRemoveMember:output_type -> keys.SharedCollectionRemoveMemberResponse
+	241, // 288: keys.Keys.SharedCollectionLeave:output_type -> keys.SharedCollectionLeaveResponse
+	243, // 289: keys.Keys.SharedCollectionSync:output_type -> keys.SharedCollectionSyncResponse
+	149, // 290: keys.Keys.Collections:output_type -> keys.CollectionsResponse
+	152, // 291: keys.Keys.Documents:output_type -> keys.DocumentsResponse
+	154, // 292: keys.Keys.DocumentDelete:output_type -> keys.DocumentDeleteResponse
+	215, // 293: keys.Keys.ConfigGet:output_type -> keys.ConfigGetResponse
+	217, // 294: keys.Keys.ConfigSet:output_type -> keys.ConfigSetResponse
+	202, // 295: keys.Keys.Channels:output_type -> keys.ChannelsResponse
+	204, // 296: keys.Keys.ChannelCreate:output_type -> keys.ChannelCreateResponse
+	206, // 297: keys.Keys.ChannelInvitesCreate:output_type -> keys.ChannelInvitesCreateResponse
+	208, // 298: keys.Keys.ChannelInviteAccept:output_type -> keys.ChannelInviteAcceptResponse
+	193, // 299: keys.Keys.MessagePrepare:output_type -> keys.MessagePrepareResponse
+	195, // 300: keys.Keys.MessageCreate:output_type -> keys.MessageCreateResponse
+	197, // 301: keys.Keys.Messages:output_type -> keys.MessagesResponse
+	199, // 302: keys.Keys.NotifyStream:output_type -> keys.NotifyStreamOutput
+	210, // 303: keys.Keys.AdminSignURL:output_type -> keys.AdminSignURLResponse
+	212, // 304: keys.Keys.AdminCheck:output_type -> keys.AdminCheckResponse
+	203, // [203:305] is the sub-list for method output_type
+	101, // [101:203] is the sub-list for method input_type
+	101, // [101:101] is the sub-list for extension type_name
+	101, // [101:101] is the sub-list for extension extendee
+	0,   // [0:101] is the sub-list for field type_name
+}
+
+func init() { file_keys_proto_init() }
+func file_keys_proto_init() {
+	if File_keys_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_keys_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignFileInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignFileOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDetachedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDetachedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyFileInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyFileOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDetachedFileInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDetachedInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Statement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementRevokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementRevokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptFileInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptFileOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptFileInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptFileOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthSetupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthSetupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthVaultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthVaultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthUnlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthUnlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthDeprovisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthDeprovisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthPasswordChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthPasswordChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthRotateSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthRotateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthRotateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenRevokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthTokenRevokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthLockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthLockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthResetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthRecoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthRecoverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyGenerateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyGenerateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11070,8 +17561,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignResponse); i {
+		file_keys_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11082,8 +17573,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignFileInput); i {
+		file_keys_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11094,8 +17585,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignFileOutput); i {
+		file_keys_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSignResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11106,8 +17597,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyRequest); i {
+		file_keys_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11118,8 +17609,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyResponse); i {
+		file_keys_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11130,8 +17621,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyDetachedRequest); i {
+		file_keys_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11142,8 +17633,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyDetachedResponse); i {
+		file_keys_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11154,8 +17645,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyInput); i {
+		file_keys_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyImportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11166,8 +17657,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyOutput); i {
+		file_keys_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyImportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11178,8 +17669,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyFileInput); i {
+		file_keys_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11190,8 +17681,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyFileOutput); i {
+		file_keys_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11202,8 +17693,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyDetachedFileInput); i {
+		file_keys_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Key); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11214,8 +17705,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyDetachedInput); i {
+		file_keys_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11226,8 +17717,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Statement); i {
+		file_keys_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11238,8 +17729,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigchainRequest); i {
+		file_keys_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11250,8 +17741,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigchainResponse); i {
+		file_keys_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11262,8 +17753,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementRequest); i {
+		file_keys_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11274,8 +17765,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementResponse); i {
+		file_keys_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11286,8 +17777,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementCreateRequest); i {
+		file_keys_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11298,8 +17789,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementCreateResponse); i {
+		file_keys_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11310,8 +17801,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementRevokeRequest); i {
+		file_keys_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretSaveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11322,8 +17813,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementRevokeResponse); i {
+		file_keys_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretSaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11334,8 +17825,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInput); i {
+		file_keys_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11346,8 +17837,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignOutput); i {
+		file_keys_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11358,8 +17849,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptOptions); i {
+		file_keys_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11370,8 +17861,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptRequest); i {
+		file_keys_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11382,8 +17873,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptResponse); i {
+		file_keys_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11394,8 +17885,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptFileInput); i {
+		file_keys_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11406,8 +17897,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptFileOutput); i {
+		file_keys_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11418,8 +17909,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptInput); i {
+		file_keys_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretRestoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11430,8 +17921,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptOutput); i {
+		file_keys_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretRestoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11442,8 +17933,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptRequest); i {
+		file_keys_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretOTPRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11454,8 +17945,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptResponse); i {
+		file_keys_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretOTPResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11466,8 +17957,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptFileInput); i {
+		file_keys_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretImportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11478,8 +17969,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptFileOutput); i {
+		file_keys_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretImportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11490,8 +17981,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptInput); i {
+		file_keys_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11502,8 +17993,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptOutput); i {
+		file_keys_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11514,8 +18005,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuntimeStatusRequest); i {
+		file_keys_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsAuditRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11526,8 +18017,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuntimeStatusResponse); i {
+		file_keys_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsAuditFinding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11538,8 +18029,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthSetupRequest); i {
+		file_keys_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsAuditResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11550,8 +18041,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthSetupResponse); i {
+		file_keys_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretShareRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11562,8 +18053,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthVaultRequest); i {
+		file_keys_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretShareResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11574,8 +18065,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthVaultResponse); i {
+		file_keys_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretReceiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11586,8 +18077,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthUnlockRequest); i {
+		file_keys_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretReceiveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11598,8 +18089,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthUnlockResponse); i {
+		file_keys_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11610,8 +18101,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvisionRequest); i {
+		file_keys_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentPutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11622,8 +18113,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvisionResponse); i {
+		file_keys_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentPutResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11634,8 +18125,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthDeprovisionRequest); i {
+		file_keys_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11646,8 +18137,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthDeprovisionResponse); i {
+		file_keys_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentGetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11658,8 +18149,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthPasswordChangeRequest); i {
+		file_keys_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11670,8 +18161,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthPasswordChangeResponse); i {
+		file_keys_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11682,8 +18173,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvision); i {
+		file_keys_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11694,8 +18185,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvisionsRequest); i {
+		file_keys_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandPasswordResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11706,8 +18197,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvisionsResponse); i {
+		file_keys_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11718,8 +18209,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthLockRequest); i {
+		file_keys_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11730,8 +18221,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthLockResponse); i {
+		file_keys_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11742,8 +18233,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthResetRequest); i {
+		file_keys_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11754,8 +18245,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthResetResponse); i {
+		file_keys_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11766,8 +18257,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthRecoverRequest); i {
+		file_keys_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11778,8 +18269,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthRecoverResponse); i {
+		file_keys_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11790,8 +18281,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyGenerateRequest); i {
+		file_keys_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Document); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11802,8 +18293,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyGenerateResponse); i {
+		file_keys_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11814,8 +18305,32 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserServiceRequest); i {
+		file_keys_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keys_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11826,8 +18341,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserServiceResponse); i {
+		file_keys_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11838,8 +18353,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSignRequest); i {
+		file_keys_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11850,8 +18365,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSignResponse); i {
+		file_keys_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11862,8 +18377,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAddRequest); i {
+		file_keys_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11874,8 +18389,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAddResponse); i {
+		file_keys_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11886,8 +18401,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyExportRequest); i {
+		file_keys_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11898,8 +18413,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyExportResponse); i {
+		file_keys_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11910,8 +18425,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyImportRequest); i {
+		file_keys_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11922,8 +18437,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyImportResponse); i {
+		file_keys_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11934,8 +18449,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyRemoveRequest); i {
+		file_keys_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultSyncProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11946,8 +18461,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyRemoveResponse); i {
+		file_keys_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultSyncProgressOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11958,8 +18473,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Key); i {
+		file_keys_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUnsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11970,8 +18485,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyRequest); i {
+		file_keys_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUnsyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11982,8 +18497,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyResponse); i {
+		file_keys_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultAuthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11994,8 +18509,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeysRequest); i {
+		file_keys_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultAuthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12006,8 +18521,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeysResponse); i {
+		file_keys_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12018,8 +18533,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secret); i {
+		file_keys_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12030,8 +18545,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretRequest); i {
+		file_keys_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultRemote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12042,8 +18557,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretResponse); i {
+		file_keys_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultRemoteAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12054,8 +18569,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretSaveRequest); i {
+		file_keys_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultRemoteAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12066,8 +18581,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretSaveResponse); i {
+		file_keys_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultRemoteRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12078,8 +18593,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretRemoveRequest); i {
+		file_keys_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultRemoteRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12090,8 +18605,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretRemoveResponse); i {
+		file_keys_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12102,8 +18617,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretsRequest); i {
+		file_keys_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12114,8 +18629,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecretsResponse); i {
+		file_keys_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultBackupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12126,8 +18641,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandRequest); i {
+		file_keys_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultBackupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12138,8 +18653,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandResponse); i {
+		file_keys_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultRestoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12150,8 +18665,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandPasswordRequest); i {
+		file_keys_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultRestoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12162,8 +18677,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandPasswordResponse); i {
+		file_keys_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12174,8 +18689,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullRequest); i {
+		file_keys_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultConflict); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12186,8 +18701,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullResponse); i {
+		file_keys_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultConflictsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12198,8 +18713,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushRequest); i {
+		file_keys_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultConflictsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12210,8 +18725,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushResponse); i {
+		file_keys_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultResolveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12222,8 +18737,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Collection); i {
+		file_keys_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultResolveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12234,8 +18749,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectionsRequest); i {
+		file_keys_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12246,8 +18761,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectionsResponse); i {
+		file_keys_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12258,8 +18773,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Document); i {
+		file_keys_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12270,8 +18785,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentsRequest); i {
+		file_keys_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagePrepareRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12282,8 +18797,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentsResponse); i {
+		file_keys_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagePrepareResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12294,8 +18809,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentDeleteRequest); i {
+		file_keys_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12306,8 +18821,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentDeleteResponse); i {
+		file_keys_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12318,8 +18833,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_keys_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12330,8 +18845,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserRequest); i {
+		file_keys_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12342,8 +18857,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserResponse); i {
+		file_keys_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12354,8 +18869,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSearchRequest); i {
+		file_keys_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyStreamOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12366,8 +18881,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSearchResponse); i {
+		file_keys_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Channel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12378,8 +18893,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+		file_keys_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12390,8 +18905,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse); i {
+		file_keys_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12402,8 +18917,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultSyncRequest); i {
+		file_keys_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12414,8 +18929,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultSyncResponse); i {
+		file_keys_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12426,8 +18941,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultUnsyncRequest); i {
+		file_keys_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInvitesCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12438,8 +18953,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultUnsyncResponse); i {
+		file_keys_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInvitesCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12450,8 +18965,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultAuthRequest); i {
+		file_keys_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInviteAcceptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12462,8 +18977,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultAuthResponse); i {
+		file_keys_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInviteAcceptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12474,8 +18989,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultStatusRequest); i {
+		file_keys_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSignURLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12486,8 +19001,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultStatusResponse); i {
+		file_keys_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSignURLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12498,8 +19013,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultUpdateRequest); i {
+		file_keys_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12510,8 +19025,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultUpdateResponse); i {
+		file_keys_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCheckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12522,8 +19037,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+		file_keys_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12534,8 +19049,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagePrepareRequest); i {
+		file_keys_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12546,8 +19061,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagePrepareResponse); i {
+		file_keys_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigGetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12558,8 +19073,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageCreateRequest); i {
+		file_keys_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12570,8 +19085,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageCreateResponse); i {
+		file_keys_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12582,8 +19097,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagesRequest); i {
+		file_keys_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12594,8 +19109,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagesResponse); i {
+		file_keys_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12606,8 +19121,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyStreamRequest); i {
+		file_keys_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WormholeInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12618,8 +19133,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyStreamOutput); i {
+		file_keys_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WormholeMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12630,8 +19145,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Channel); i {
+		file_keys_proto_msgTypes[207].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WormholeOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12642,8 +19157,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelsRequest); i {
+		file_keys_proto_msgTypes[208].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12654,8 +19169,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelsResponse); i {
+		file_keys_proto_msgTypes[209].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12666,8 +19181,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelCreateRequest); i {
+		file_keys_proto_msgTypes[210].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionInvite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12678,8 +19193,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelCreateResponse); i {
+		file_keys_proto_msgTypes[211].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12690,8 +19205,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInvitesCreateRequest); i {
+		file_keys_proto_msgTypes[212].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12702,8 +19217,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInvitesCreateResponse); i {
+		file_keys_proto_msgTypes[213].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12714,8 +19229,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInviteAcceptRequest); i {
+		file_keys_proto_msgTypes[214].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12726,8 +19241,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInviteAcceptResponse); i {
+		file_keys_proto_msgTypes[215].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionInviteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12738,8 +19253,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminSignURLRequest); i {
+		file_keys_proto_msgTypes[216].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionInviteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12750,8 +19265,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminSignURLResponse); i {
+		file_keys_proto_msgTypes[217].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionInvitesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12762,8 +19277,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminCheckRequest); i {
+		file_keys_proto_msgTypes[218].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionInvitesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12774,8 +19289,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminCheckResponse); i {
+		file_keys_proto_msgTypes[219].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionAcceptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12786,8 +19301,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+		file_keys_proto_msgTypes[220].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionAcceptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12798,8 +19313,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGetRequest); i {
+		file_keys_proto_msgTypes[221].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12810,8 +19325,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGetResponse); i {
+		file_keys_proto_msgTypes[222].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12822,8 +19337,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigSetRequest); i {
+		file_keys_proto_msgTypes[223].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionRemoveMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12834,8 +19349,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigSetResponse); i {
+		file_keys_proto_msgTypes[224].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionRemoveMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12846,8 +19361,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelayInput); i {
+		file_keys_proto_msgTypes[225].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionLeaveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12858,8 +19373,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelayOutput); i {
+		file_keys_proto_msgTypes[226].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionLeaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12870,8 +19385,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WormholeInput); i {
+		file_keys_proto_msgTypes[227].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12882,8 +19397,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WormholeMessage); i {
+		file_keys_proto_msgTypes[228].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedCollectionSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12894,8 +19409,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WormholeOutput); i {
+		file_keys_proto_msgTypes[229].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_App); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12906,8 +19421,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config_App); i {
+		file_keys_proto_msgTypes[230].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_Encrypt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12918,8 +19433,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config_Encrypt); i {
+		file_keys_proto_msgTypes[231].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_Sign); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12930,8 +19445,8 @@ func file_keys_proto_init() {
 				return nil
 			}
 		}
-		file_keys_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config_Sign); i {
+		file_keys_proto_msgTypes[232].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_Lock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12948,8 +19463,8 @@ func file_keys_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_keys_proto_rawDesc,
-			NumEnums:      12,
-			NumMessages:   157,
+			NumEnums:      15,
+			NumMessages:   233,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
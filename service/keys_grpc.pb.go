@@ -52,6 +52,17 @@ type KeysClient interface {
 	SecretSave(ctx context.Context, in *SecretSaveRequest, opts ...grpc.CallOption) (*SecretSaveResponse, error)
 	SecretRemove(ctx context.Context, in *SecretRemoveRequest, opts ...grpc.CallOption) (*SecretRemoveResponse, error)
 	Secrets(ctx context.Context, in *SecretsRequest, opts ...grpc.CallOption) (*SecretsResponse, error)
+	SecretHistory(ctx context.Context, in *SecretHistoryRequest, opts ...grpc.CallOption) (*SecretHistoryResponse, error)
+	SecretRestore(ctx context.Context, in *SecretRestoreRequest, opts ...grpc.CallOption) (*SecretRestoreResponse, error)
+	SecretOTP(ctx context.Context, in *SecretOTPRequest, opts ...grpc.CallOption) (*SecretOTPResponse, error)
+	SecretImport(ctx context.Context, in *SecretImportRequest, opts ...grpc.CallOption) (*SecretImportResponse, error)
+	SecretsExport(ctx context.Context, in *SecretsExportRequest, opts ...grpc.CallOption) (*SecretsExportResponse, error)
+	SecretsAudit(ctx context.Context, in *SecretsAuditRequest, opts ...grpc.CallOption) (*SecretsAuditResponse, error)
+	SecretShare(ctx context.Context, in *SecretShareRequest, opts ...grpc.CallOption) (*SecretShareResponse, error)
+	SecretReceive(ctx context.Context, in *SecretReceiveRequest, opts ...grpc.CallOption) (*SecretReceiveResponse, error)
+	// Attachments
+	AttachmentPut(ctx context.Context, opts ...grpc.CallOption) (Keys_AttachmentPutClient, error)
+	AttachmentGet(ctx context.Context, in *AttachmentGetRequest, opts ...grpc.CallOption) (Keys_AttachmentGetClient, error)
 	Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (*PullResponse, error)
 	Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error)
 	Wormhole(ctx context.Context, opts ...grpc.CallOption) (Keys_WormholeClient, error)
@@ -71,12 +82,34 @@ type KeysClient interface {
 	AuthDeprovision(ctx context.Context, in *AuthDeprovisionRequest, opts ...grpc.CallOption) (*AuthDeprovisionResponse, error)
 	AuthProvisions(ctx context.Context, in *AuthProvisionsRequest, opts ...grpc.CallOption) (*AuthProvisionsResponse, error)
 	AuthPasswordChange(ctx context.Context, in *AuthPasswordChangeRequest, opts ...grpc.CallOption) (*AuthPasswordChangeResponse, error)
+	AuthRotate(ctx context.Context, in *AuthRotateRequest, opts ...grpc.CallOption) (*AuthRotateResponse, error)
+	AuthLog(ctx context.Context, in *AuthLogRequest, opts ...grpc.CallOption) (*AuthLogResponse, error)
+	AuthTokens(ctx context.Context, in *AuthTokensRequest, opts ...grpc.CallOption) (*AuthTokensResponse, error)
+	AuthTokenRevoke(ctx context.Context, in *AuthTokenRevokeRequest, opts ...grpc.CallOption) (*AuthTokenRevokeResponse, error)
 	// Vault
 	VaultAuth(ctx context.Context, in *VaultAuthRequest, opts ...grpc.CallOption) (*VaultAuthResponse, error)
 	VaultSync(ctx context.Context, in *VaultSyncRequest, opts ...grpc.CallOption) (*VaultSyncResponse, error)
+	VaultSyncProgress(ctx context.Context, in *VaultSyncProgressRequest, opts ...grpc.CallOption) (Keys_VaultSyncProgressClient, error)
 	VaultUnsync(ctx context.Context, in *VaultUnsyncRequest, opts ...grpc.CallOption) (*VaultUnsyncResponse, error)
 	VaultStatus(ctx context.Context, in *VaultStatusRequest, opts ...grpc.CallOption) (*VaultStatusResponse, error)
 	VaultUpdate(ctx context.Context, in *VaultUpdateRequest, opts ...grpc.CallOption) (*VaultUpdateResponse, error)
+	VaultConflicts(ctx context.Context, in *VaultConflictsRequest, opts ...grpc.CallOption) (*VaultConflictsResponse, error)
+	VaultResolve(ctx context.Context, in *VaultResolveRequest, opts ...grpc.CallOption) (*VaultResolveResponse, error)
+	VaultEvents(ctx context.Context, in *VaultEventsRequest, opts ...grpc.CallOption) (Keys_VaultEventsClient, error)
+	VaultBackup(ctx context.Context, in *VaultBackupRequest, opts ...grpc.CallOption) (*VaultBackupResponse, error)
+	VaultRestore(ctx context.Context, in *VaultRestoreRequest, opts ...grpc.CallOption) (*VaultRestoreResponse, error)
+	VaultRemoteAdd(ctx context.Context, in *VaultRemoteAddRequest, opts ...grpc.CallOption) (*VaultRemoteAddResponse, error)
+	VaultRemoteRemove(ctx context.Context, in *VaultRemoteRemoveRequest, opts ...grpc.CallOption) (*VaultRemoteRemoveResponse, error)
+	// Shared collections
+	SharedCollections(ctx context.Context, in *SharedCollectionsRequest, opts ...grpc.CallOption) (*SharedCollectionsResponse, error)
+	SharedCollectionCreate(ctx context.Context, in *SharedCollectionCreateRequest, opts ...grpc.CallOption) (*SharedCollectionCreateResponse, error)
+	SharedCollectionInvite(ctx context.Context, in *SharedCollectionInviteRequest, opts ...grpc.CallOption) (*SharedCollectionInviteResponse, error)
+	SharedCollectionInvites(ctx context.Context, in *SharedCollectionInvitesRequest, opts ...grpc.CallOption) (*SharedCollectionInvitesResponse, error)
+	SharedCollectionAccept(ctx context.Context, in *SharedCollectionAcceptRequest, opts ...grpc.CallOption) (*SharedCollectionAcceptResponse, error)
+	SharedCollectionMembers(ctx context.Context, in *SharedCollectionMembersRequest, opts ...grpc.CallOption) (*SharedCollectionMembersResponse, error)
+	SharedCollectionRemoveMember(ctx context.Context, in *SharedCollectionRemoveMemberRequest, opts ...grpc.CallOption) (*SharedCollectionRemoveMemberResponse, error)
+	SharedCollectionLeave(ctx context.Context, in *SharedCollectionLeaveRequest, opts ...grpc.CallOption) (*SharedCollectionLeaveResponse, error)
+	SharedCollectionSync(ctx context.Context, in *SharedCollectionSyncRequest, opts ...grpc.CallOption) (*SharedCollectionSyncResponse, error)
 	// DB
 	Collections(ctx context.Context, in *CollectionsRequest, opts ...grpc.CallOption) (*CollectionsResponse, error)
 	Documents(ctx context.Context, in *DocumentsRequest, opts ...grpc.CallOption) (*DocumentsResponse, error)
@@ -649,6 +682,144 @@ func (c *keysClient) Secrets(ctx context.Context, in *SecretsRequest, opts ...gr
 	return out, nil
 }
 
+func (c *keysClient) SecretHistory(ctx context.Context, in *SecretHistoryRequest, opts ...grpc.CallOption) (*SecretHistoryResponse, error) {
+	out := new(SecretHistoryResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SecretHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretRestore(ctx context.Context, in *SecretRestoreRequest, opts ...grpc.CallOption) (*SecretRestoreResponse, error) {
+	out := new(SecretRestoreResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SecretRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretOTP(ctx context.Context, in *SecretOTPRequest, opts ...grpc.CallOption) (*SecretOTPResponse, error) {
+	out := new(SecretOTPResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SecretOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretImport(ctx context.Context, in *SecretImportRequest, opts ...grpc.CallOption) (*SecretImportResponse, error) {
+	out := new(SecretImportResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SecretImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretsExport(ctx context.Context, in *SecretsExportRequest, opts ...grpc.CallOption) (*SecretsExportResponse, error) {
+	out := new(SecretsExportResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SecretsExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretsAudit(ctx context.Context, in *SecretsAuditRequest, opts ...grpc.CallOption) (*SecretsAuditResponse, error) {
+	out := new(SecretsAuditResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SecretsAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretShare(ctx context.Context, in *SecretShareRequest, opts ...grpc.CallOption) (*SecretShareResponse, error) {
+	out := new(SecretShareResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SecretShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) SecretReceive(ctx context.Context, in *SecretReceiveRequest, opts ...grpc.CallOption) (*SecretReceiveResponse, error) {
+	out := new(SecretReceiveResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SecretReceive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) AttachmentPut(ctx context.Context, opts ...grpc.CallOption) (Keys_AttachmentPutClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Keys_serviceDesc.Streams[10], "/keys.Keys/AttachmentPut", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keysAttachmentPutClient{stream}
+	return x, nil
+}
+
+type Keys_AttachmentPutClient interface {
+	Send(*AttachmentPutRequest) error
+	CloseAndRecv() (*AttachmentPutResponse, error)
+	grpc.ClientStream
+}
+
+type keysAttachmentPutClient struct {
+	grpc.ClientStream
+}
+
+func (x *keysAttachmentPutClient) Send(m *AttachmentPutRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *keysAttachmentPutClient) CloseAndRecv() (*AttachmentPutResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AttachmentPutResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *keysClient) AttachmentGet(ctx context.Context, in *AttachmentGetRequest, opts ...grpc.CallOption) (Keys_AttachmentGetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Keys_serviceDesc.Streams[11], "/keys.Keys/AttachmentGet", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keysAttachmentGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Keys_AttachmentGetClient interface {
+	Recv() (*AttachmentGetResponse, error)
+	grpc.ClientStream
+}
+
+type keysAttachmentGetClient struct {
+	grpc.ClientStream
+}
+
+func (x *keysAttachmentGetClient) Recv() (*AttachmentGetResponse, error) {
+	m := new(AttachmentGetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *keysClient) Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (*PullResponse, error) {
 	out := new(PullResponse)
 	err := c.cc.Invoke(ctx, "/keys.Keys/Pull", in, out, opts...)
@@ -668,7 +839,7 @@ func (c *keysClient) Push(ctx context.Context, in *PushRequest, opts ...grpc.Cal
 }
 
 func (c *keysClient) Wormhole(ctx context.Context, opts ...grpc.CallOption) (Keys_WormholeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Keys_serviceDesc.Streams[10], "/keys.Keys/Wormhole", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Keys_serviceDesc.Streams[12], "/keys.Keys/Wormhole", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -815,6 +986,42 @@ func (c *keysClient) AuthPasswordChange(ctx context.Context, in *AuthPasswordCha
 	return out, nil
 }
 
+func (c *keysClient) AuthRotate(ctx context.Context, in *AuthRotateRequest, opts ...grpc.CallOption) (*AuthRotateResponse, error) {
+	out := new(AuthRotateResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/AuthRotate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) AuthLog(ctx context.Context, in *AuthLogRequest, opts ...grpc.CallOption) (*AuthLogResponse, error) {
+	out := new(AuthLogResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/AuthLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) AuthTokens(ctx context.Context, in *AuthTokensRequest, opts ...grpc.CallOption) (*AuthTokensResponse, error) {
+	out := new(AuthTokensResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/AuthTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) AuthTokenRevoke(ctx context.Context, in *AuthTokenRevokeRequest, opts ...grpc.CallOption) (*AuthTokenRevokeResponse, error) {
+	out := new(AuthTokenRevokeResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/AuthTokenRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keysClient) VaultAuth(ctx context.Context, in *VaultAuthRequest, opts ...grpc.CallOption) (*VaultAuthResponse, error) {
 	out := new(VaultAuthResponse)
 	err := c.cc.Invoke(ctx, "/keys.Keys/VaultAuth", in, out, opts...)
@@ -833,6 +1040,38 @@ func (c *keysClient) VaultSync(ctx context.Context, in *VaultSyncRequest, opts .
 	return out, nil
 }
 
+func (c *keysClient) VaultSyncProgress(ctx context.Context, in *VaultSyncProgressRequest, opts ...grpc.CallOption) (Keys_VaultSyncProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Keys_serviceDesc.Streams[13], "/keys.Keys/VaultSyncProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keysVaultSyncProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Keys_VaultSyncProgressClient interface {
+	Recv() (*VaultSyncProgressOutput, error)
+	grpc.ClientStream
+}
+
+type keysVaultSyncProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *keysVaultSyncProgressClient) Recv() (*VaultSyncProgressOutput, error) {
+	m := new(VaultSyncProgressOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *keysClient) VaultUnsync(ctx context.Context, in *VaultUnsyncRequest, opts ...grpc.CallOption) (*VaultUnsyncResponse, error) {
 	out := new(VaultUnsyncResponse)
 	err := c.cc.Invoke(ctx, "/keys.Keys/VaultUnsync", in, out, opts...)
@@ -860,169 +1099,336 @@ func (c *keysClient) VaultUpdate(ctx context.Context, in *VaultUpdateRequest, op
 	return out, nil
 }
 
-func (c *keysClient) Collections(ctx context.Context, in *CollectionsRequest, opts ...grpc.CallOption) (*CollectionsResponse, error) {
-	out := new(CollectionsResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/Collections", in, out, opts...)
+func (c *keysClient) VaultConflicts(ctx context.Context, in *VaultConflictsRequest, opts ...grpc.CallOption) (*VaultConflictsResponse, error) {
+	out := new(VaultConflictsResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/VaultConflicts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) Documents(ctx context.Context, in *DocumentsRequest, opts ...grpc.CallOption) (*DocumentsResponse, error) {
-	out := new(DocumentsResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/Documents", in, out, opts...)
+func (c *keysClient) VaultResolve(ctx context.Context, in *VaultResolveRequest, opts ...grpc.CallOption) (*VaultResolveResponse, error) {
+	out := new(VaultResolveResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/VaultResolve", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) DocumentDelete(ctx context.Context, in *DocumentDeleteRequest, opts ...grpc.CallOption) (*DocumentDeleteResponse, error) {
-	out := new(DocumentDeleteResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/DocumentDelete", in, out, opts...)
+func (c *keysClient) VaultEvents(ctx context.Context, in *VaultEventsRequest, opts ...grpc.CallOption) (Keys_VaultEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Keys_serviceDesc.Streams[14], "/keys.Keys/VaultEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &keysVaultEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *keysClient) ConfigGet(ctx context.Context, in *ConfigGetRequest, opts ...grpc.CallOption) (*ConfigGetResponse, error) {
-	out := new(ConfigGetResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/ConfigGet", in, out, opts...)
+type Keys_VaultEventsClient interface {
+	Recv() (*VaultEvent, error)
+	grpc.ClientStream
+}
+
+type keysVaultEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *keysVaultEventsClient) Recv() (*VaultEvent, error) {
+	m := new(VaultEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *keysClient) VaultBackup(ctx context.Context, in *VaultBackupRequest, opts ...grpc.CallOption) (*VaultBackupResponse, error) {
+	out := new(VaultBackupResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/VaultBackup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) ConfigSet(ctx context.Context, in *ConfigSetRequest, opts ...grpc.CallOption) (*ConfigSetResponse, error) {
-	out := new(ConfigSetResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/ConfigSet", in, out, opts...)
+func (c *keysClient) VaultRestore(ctx context.Context, in *VaultRestoreRequest, opts ...grpc.CallOption) (*VaultRestoreResponse, error) {
+	out := new(VaultRestoreResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/VaultRestore", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) Channels(ctx context.Context, in *ChannelsRequest, opts ...grpc.CallOption) (*ChannelsResponse, error) {
-	out := new(ChannelsResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/Channels", in, out, opts...)
+func (c *keysClient) VaultRemoteAdd(ctx context.Context, in *VaultRemoteAddRequest, opts ...grpc.CallOption) (*VaultRemoteAddResponse, error) {
+	out := new(VaultRemoteAddResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/VaultRemoteAdd", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) ChannelCreate(ctx context.Context, in *ChannelCreateRequest, opts ...grpc.CallOption) (*ChannelCreateResponse, error) {
-	out := new(ChannelCreateResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/ChannelCreate", in, out, opts...)
+func (c *keysClient) VaultRemoteRemove(ctx context.Context, in *VaultRemoteRemoveRequest, opts ...grpc.CallOption) (*VaultRemoteRemoveResponse, error) {
+	out := new(VaultRemoteRemoveResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/VaultRemoteRemove", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) ChannelInvitesCreate(ctx context.Context, in *ChannelInvitesCreateRequest, opts ...grpc.CallOption) (*ChannelInvitesCreateResponse, error) {
-	out := new(ChannelInvitesCreateResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/ChannelInvitesCreate", in, out, opts...)
+func (c *keysClient) SharedCollections(ctx context.Context, in *SharedCollectionsRequest, opts ...grpc.CallOption) (*SharedCollectionsResponse, error) {
+	out := new(SharedCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollections", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) ChannelInviteAccept(ctx context.Context, in *ChannelInviteAcceptRequest, opts ...grpc.CallOption) (*ChannelInviteAcceptResponse, error) {
-	out := new(ChannelInviteAcceptResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/ChannelInviteAccept", in, out, opts...)
+func (c *keysClient) SharedCollectionCreate(ctx context.Context, in *SharedCollectionCreateRequest, opts ...grpc.CallOption) (*SharedCollectionCreateResponse, error) {
+	out := new(SharedCollectionCreateResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollectionCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) MessagePrepare(ctx context.Context, in *MessagePrepareRequest, opts ...grpc.CallOption) (*MessagePrepareResponse, error) {
-	out := new(MessagePrepareResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/MessagePrepare", in, out, opts...)
+func (c *keysClient) SharedCollectionInvite(ctx context.Context, in *SharedCollectionInviteRequest, opts ...grpc.CallOption) (*SharedCollectionInviteResponse, error) {
+	out := new(SharedCollectionInviteResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollectionInvite", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) MessageCreate(ctx context.Context, in *MessageCreateRequest, opts ...grpc.CallOption) (*MessageCreateResponse, error) {
-	out := new(MessageCreateResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/MessageCreate", in, out, opts...)
+func (c *keysClient) SharedCollectionInvites(ctx context.Context, in *SharedCollectionInvitesRequest, opts ...grpc.CallOption) (*SharedCollectionInvitesResponse, error) {
+	out := new(SharedCollectionInvitesResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollectionInvites", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error) {
-	out := new(MessagesResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/Messages", in, out, opts...)
+func (c *keysClient) SharedCollectionAccept(ctx context.Context, in *SharedCollectionAcceptRequest, opts ...grpc.CallOption) (*SharedCollectionAcceptResponse, error) {
+	out := new(SharedCollectionAcceptResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollectionAccept", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) NotifyStream(ctx context.Context, in *NotifyStreamRequest, opts ...grpc.CallOption) (Keys_NotifyStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Keys_serviceDesc.Streams[11], "/keys.Keys/NotifyStream", opts...)
+func (c *keysClient) SharedCollectionMembers(ctx context.Context, in *SharedCollectionMembersRequest, opts ...grpc.CallOption) (*SharedCollectionMembersResponse, error) {
+	out := new(SharedCollectionMembersResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollectionMembers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &keysNotifyStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *keysClient) SharedCollectionRemoveMember(ctx context.Context, in *SharedCollectionRemoveMemberRequest, opts ...grpc.CallOption) (*SharedCollectionRemoveMemberResponse, error) {
+	out := new(SharedCollectionRemoveMemberResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollectionRemoveMember", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *keysClient) SharedCollectionLeave(ctx context.Context, in *SharedCollectionLeaveRequest, opts ...grpc.CallOption) (*SharedCollectionLeaveResponse, error) {
+	out := new(SharedCollectionLeaveResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollectionLeave", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type Keys_NotifyStreamClient interface {
-	Recv() (*NotifyStreamOutput, error)
-	grpc.ClientStream
+func (c *keysClient) SharedCollectionSync(ctx context.Context, in *SharedCollectionSyncRequest, opts ...grpc.CallOption) (*SharedCollectionSyncResponse, error) {
+	out := new(SharedCollectionSyncResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/SharedCollectionSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type keysNotifyStreamClient struct {
-	grpc.ClientStream
+func (c *keysClient) Collections(ctx context.Context, in *CollectionsRequest, opts ...grpc.CallOption) (*CollectionsResponse, error) {
+	out := new(CollectionsResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/Collections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *keysNotifyStreamClient) Recv() (*NotifyStreamOutput, error) {
-	m := new(NotifyStreamOutput)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *keysClient) Documents(ctx context.Context, in *DocumentsRequest, opts ...grpc.CallOption) (*DocumentsResponse, error) {
+	out := new(DocumentsResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/Documents", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *keysClient) AdminSignURL(ctx context.Context, in *AdminSignURLRequest, opts ...grpc.CallOption) (*AdminSignURLResponse, error) {
-	out := new(AdminSignURLResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/AdminSignURL", in, out, opts...)
+func (c *keysClient) DocumentDelete(ctx context.Context, in *DocumentDeleteRequest, opts ...grpc.CallOption) (*DocumentDeleteResponse, error) {
+	out := new(DocumentDeleteResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/DocumentDelete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keysClient) AdminCheck(ctx context.Context, in *AdminCheckRequest, opts ...grpc.CallOption) (*AdminCheckResponse, error) {
-	out := new(AdminCheckResponse)
-	err := c.cc.Invoke(ctx, "/keys.Keys/AdminCheck", in, out, opts...)
+func (c *keysClient) ConfigGet(ctx context.Context, in *ConfigGetRequest, opts ...grpc.CallOption) (*ConfigGetResponse, error) {
+	out := new(ConfigGetResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/ConfigGet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// KeysServer is the server API for Keys service.
-// All implementations must embed UnimplementedKeysServer
-// for forward compatibility
-type KeysServer interface {
-	KeyGenerate(context.Context, *KeyGenerateRequest) (*KeyGenerateResponse, error)
+func (c *keysClient) ConfigSet(ctx context.Context, in *ConfigSetRequest, opts ...grpc.CallOption) (*ConfigSetResponse, error) {
+	out := new(ConfigSetResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/ConfigSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) Channels(ctx context.Context, in *ChannelsRequest, opts ...grpc.CallOption) (*ChannelsResponse, error) {
+	out := new(ChannelsResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/Channels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) ChannelCreate(ctx context.Context, in *ChannelCreateRequest, opts ...grpc.CallOption) (*ChannelCreateResponse, error) {
+	out := new(ChannelCreateResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/ChannelCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) ChannelInvitesCreate(ctx context.Context, in *ChannelInvitesCreateRequest, opts ...grpc.CallOption) (*ChannelInvitesCreateResponse, error) {
+	out := new(ChannelInvitesCreateResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/ChannelInvitesCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) ChannelInviteAccept(ctx context.Context, in *ChannelInviteAcceptRequest, opts ...grpc.CallOption) (*ChannelInviteAcceptResponse, error) {
+	out := new(ChannelInviteAcceptResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/ChannelInviteAccept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) MessagePrepare(ctx context.Context, in *MessagePrepareRequest, opts ...grpc.CallOption) (*MessagePrepareResponse, error) {
+	out := new(MessagePrepareResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/MessagePrepare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) MessageCreate(ctx context.Context, in *MessageCreateRequest, opts ...grpc.CallOption) (*MessageCreateResponse, error) {
+	out := new(MessageCreateResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/MessageCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error) {
+	out := new(MessagesResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/Messages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) NotifyStream(ctx context.Context, in *NotifyStreamRequest, opts ...grpc.CallOption) (Keys_NotifyStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Keys_serviceDesc.Streams[15], "/keys.Keys/NotifyStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keysNotifyStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Keys_NotifyStreamClient interface {
+	Recv() (*NotifyStreamOutput, error)
+	grpc.ClientStream
+}
+
+type keysNotifyStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *keysNotifyStreamClient) Recv() (*NotifyStreamOutput, error) {
+	m := new(NotifyStreamOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *keysClient) AdminSignURL(ctx context.Context, in *AdminSignURLRequest, opts ...grpc.CallOption) (*AdminSignURLResponse, error) {
+	out := new(AdminSignURLResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/AdminSignURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keysClient) AdminCheck(ctx context.Context, in *AdminCheckRequest, opts ...grpc.CallOption) (*AdminCheckResponse, error) {
+	out := new(AdminCheckResponse)
+	err := c.cc.Invoke(ctx, "/keys.Keys/AdminCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeysServer is the server API for Keys service.
+// All implementations must embed UnimplementedKeysServer
+// for forward compatibility
+type KeysServer interface {
+	KeyGenerate(context.Context, *KeyGenerateRequest) (*KeyGenerateResponse, error)
 	Keys(context.Context, *KeysRequest) (*KeysResponse, error)
 	Key(context.Context, *KeyRequest) (*KeyResponse, error)
 	KeyImport(context.Context, *KeyImportRequest) (*KeyImportResponse, error)
@@ -1057,6 +1463,17 @@ type KeysServer interface {
 	SecretSave(context.Context, *SecretSaveRequest) (*SecretSaveResponse, error)
 	SecretRemove(context.Context, *SecretRemoveRequest) (*SecretRemoveResponse, error)
 	Secrets(context.Context, *SecretsRequest) (*SecretsResponse, error)
+	SecretHistory(context.Context, *SecretHistoryRequest) (*SecretHistoryResponse, error)
+	SecretRestore(context.Context, *SecretRestoreRequest) (*SecretRestoreResponse, error)
+	SecretOTP(context.Context, *SecretOTPRequest) (*SecretOTPResponse, error)
+	SecretImport(context.Context, *SecretImportRequest) (*SecretImportResponse, error)
+	SecretsExport(context.Context, *SecretsExportRequest) (*SecretsExportResponse, error)
+	SecretsAudit(context.Context, *SecretsAuditRequest) (*SecretsAuditResponse, error)
+	SecretShare(context.Context, *SecretShareRequest) (*SecretShareResponse, error)
+	SecretReceive(context.Context, *SecretReceiveRequest) (*SecretReceiveResponse, error)
+	// Attachments
+	AttachmentPut(Keys_AttachmentPutServer) error
+	AttachmentGet(*AttachmentGetRequest, Keys_AttachmentGetServer) error
 	Pull(context.Context, *PullRequest) (*PullResponse, error)
 	Push(context.Context, *PushRequest) (*PushResponse, error)
 	Wormhole(Keys_WormholeServer) error
@@ -1076,12 +1493,34 @@ type KeysServer interface {
 	AuthDeprovision(context.Context, *AuthDeprovisionRequest) (*AuthDeprovisionResponse, error)
 	AuthProvisions(context.Context, *AuthProvisionsRequest) (*AuthProvisionsResponse, error)
 	AuthPasswordChange(context.Context, *AuthPasswordChangeRequest) (*AuthPasswordChangeResponse, error)
+	AuthRotate(context.Context, *AuthRotateRequest) (*AuthRotateResponse, error)
+	AuthLog(context.Context, *AuthLogRequest) (*AuthLogResponse, error)
+	AuthTokens(context.Context, *AuthTokensRequest) (*AuthTokensResponse, error)
+	AuthTokenRevoke(context.Context, *AuthTokenRevokeRequest) (*AuthTokenRevokeResponse, error)
 	// Vault
 	VaultAuth(context.Context, *VaultAuthRequest) (*VaultAuthResponse, error)
 	VaultSync(context.Context, *VaultSyncRequest) (*VaultSyncResponse, error)
+	VaultSyncProgress(*VaultSyncProgressRequest, Keys_VaultSyncProgressServer) error
 	VaultUnsync(context.Context, *VaultUnsyncRequest) (*VaultUnsyncResponse, error)
 	VaultStatus(context.Context, *VaultStatusRequest) (*VaultStatusResponse, error)
 	VaultUpdate(context.Context, *VaultUpdateRequest) (*VaultUpdateResponse, error)
+	VaultConflicts(context.Context, *VaultConflictsRequest) (*VaultConflictsResponse, error)
+	VaultResolve(context.Context, *VaultResolveRequest) (*VaultResolveResponse, error)
+	VaultEvents(*VaultEventsRequest, Keys_VaultEventsServer) error
+	VaultBackup(context.Context, *VaultBackupRequest) (*VaultBackupResponse, error)
+	VaultRestore(context.Context, *VaultRestoreRequest) (*VaultRestoreResponse, error)
+	VaultRemoteAdd(context.Context, *VaultRemoteAddRequest) (*VaultRemoteAddResponse, error)
+	VaultRemoteRemove(context.Context, *VaultRemoteRemoveRequest) (*VaultRemoteRemoveResponse, error)
+	// Shared collections
+	SharedCollections(context.Context, *SharedCollectionsRequest) (*SharedCollectionsResponse, error)
+	SharedCollectionCreate(context.Context, *SharedCollectionCreateRequest) (*SharedCollectionCreateResponse, error)
+	SharedCollectionInvite(context.Context, *SharedCollectionInviteRequest) (*SharedCollectionInviteResponse, error)
+	SharedCollectionInvites(context.Context, *SharedCollectionInvitesRequest) (*SharedCollectionInvitesResponse, error)
+	SharedCollectionAccept(context.Context, *SharedCollectionAcceptRequest) (*SharedCollectionAcceptResponse, error)
+	SharedCollectionMembers(context.Context, *SharedCollectionMembersRequest) (*SharedCollectionMembersResponse, error)
+	SharedCollectionRemoveMember(context.Context, *SharedCollectionRemoveMemberRequest) (*SharedCollectionRemoveMemberResponse, error)
+	SharedCollectionLeave(context.Context, *SharedCollectionLeaveRequest) (*SharedCollectionLeaveResponse, error)
+	SharedCollectionSync(context.Context, *SharedCollectionSyncRequest) (*SharedCollectionSyncResponse, error)
 	// DB
 	Collections(context.Context, *CollectionsRequest) (*CollectionsResponse, error)
 	Documents(context.Context, *DocumentsRequest) (*DocumentsResponse, error)
@@ -1215,6 +1654,36 @@ func (*UnimplementedKeysServer) SecretRemove(context.Context, *SecretRemoveReque
 func (*UnimplementedKeysServer) Secrets(context.Context, *SecretsRequest) (*SecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Secrets not implemented")
 }
+func (*UnimplementedKeysServer) SecretHistory(context.Context, *SecretHistoryRequest) (*SecretHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretHistory not implemented")
+}
+func (*UnimplementedKeysServer) SecretRestore(context.Context, *SecretRestoreRequest) (*SecretRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretRestore not implemented")
+}
+func (*UnimplementedKeysServer) SecretOTP(context.Context, *SecretOTPRequest) (*SecretOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretOTP not implemented")
+}
+func (*UnimplementedKeysServer) SecretImport(context.Context, *SecretImportRequest) (*SecretImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretImport not implemented")
+}
+func (*UnimplementedKeysServer) SecretsExport(context.Context, *SecretsExportRequest) (*SecretsExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretsExport not implemented")
+}
+func (*UnimplementedKeysServer) SecretsAudit(context.Context, *SecretsAuditRequest) (*SecretsAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretsAudit not implemented")
+}
+func (*UnimplementedKeysServer) SecretShare(context.Context, *SecretShareRequest) (*SecretShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretShare not implemented")
+}
+func (*UnimplementedKeysServer) SecretReceive(context.Context, *SecretReceiveRequest) (*SecretReceiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretReceive not implemented")
+}
+func (*UnimplementedKeysServer) AttachmentPut(Keys_AttachmentPutServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttachmentPut not implemented")
+}
+func (*UnimplementedKeysServer) AttachmentGet(*AttachmentGetRequest, Keys_AttachmentGetServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttachmentGet not implemented")
+}
 func (*UnimplementedKeysServer) Pull(context.Context, *PullRequest) (*PullResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pull not implemented")
 }
@@ -1263,12 +1732,27 @@ func (*UnimplementedKeysServer) AuthProvisions(context.Context, *AuthProvisionsR
 func (*UnimplementedKeysServer) AuthPasswordChange(context.Context, *AuthPasswordChangeRequest) (*AuthPasswordChangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthPasswordChange not implemented")
 }
+func (*UnimplementedKeysServer) AuthRotate(context.Context, *AuthRotateRequest) (*AuthRotateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthRotate not implemented")
+}
+func (*UnimplementedKeysServer) AuthLog(context.Context, *AuthLogRequest) (*AuthLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthLog not implemented")
+}
+func (*UnimplementedKeysServer) AuthTokens(context.Context, *AuthTokensRequest) (*AuthTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTokens not implemented")
+}
+func (*UnimplementedKeysServer) AuthTokenRevoke(context.Context, *AuthTokenRevokeRequest) (*AuthTokenRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenRevoke not implemented")
+}
 func (*UnimplementedKeysServer) VaultAuth(context.Context, *VaultAuthRequest) (*VaultAuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VaultAuth not implemented")
 }
 func (*UnimplementedKeysServer) VaultSync(context.Context, *VaultSyncRequest) (*VaultSyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VaultSync not implemented")
 }
+func (*UnimplementedKeysServer) VaultSyncProgress(*VaultSyncProgressRequest, Keys_VaultSyncProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method VaultSyncProgress not implemented")
+}
 func (*UnimplementedKeysServer) VaultUnsync(context.Context, *VaultUnsyncRequest) (*VaultUnsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VaultUnsync not implemented")
 }
@@ -1278,6 +1762,54 @@ func (*UnimplementedKeysServer) VaultStatus(context.Context, *VaultStatusRequest
 func (*UnimplementedKeysServer) VaultUpdate(context.Context, *VaultUpdateRequest) (*VaultUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VaultUpdate not implemented")
 }
+func (*UnimplementedKeysServer) VaultConflicts(context.Context, *VaultConflictsRequest) (*VaultConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultConflicts not implemented")
+}
+func (*UnimplementedKeysServer) VaultResolve(context.Context, *VaultResolveRequest) (*VaultResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultResolve not implemented")
+}
+func (*UnimplementedKeysServer) VaultEvents(*VaultEventsRequest, Keys_VaultEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method VaultEvents not implemented")
+}
+func (*UnimplementedKeysServer) VaultBackup(context.Context, *VaultBackupRequest) (*VaultBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultBackup not implemented")
+}
+func (*UnimplementedKeysServer) VaultRestore(context.Context, *VaultRestoreRequest) (*VaultRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultRestore not implemented")
+}
+func (*UnimplementedKeysServer) VaultRemoteAdd(context.Context, *VaultRemoteAddRequest) (*VaultRemoteAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultRemoteAdd not implemented")
+}
+func (*UnimplementedKeysServer) VaultRemoteRemove(context.Context, *VaultRemoteRemoveRequest) (*VaultRemoteRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultRemoteRemove not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollections(context.Context, *SharedCollectionsRequest) (*SharedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollections not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollectionCreate(context.Context, *SharedCollectionCreateRequest) (*SharedCollectionCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollectionCreate not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollectionInvite(context.Context, *SharedCollectionInviteRequest) (*SharedCollectionInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollectionInvite not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollectionInvites(context.Context, *SharedCollectionInvitesRequest) (*SharedCollectionInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollectionInvites not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollectionAccept(context.Context, *SharedCollectionAcceptRequest) (*SharedCollectionAcceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollectionAccept not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollectionMembers(context.Context, *SharedCollectionMembersRequest) (*SharedCollectionMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollectionMembers not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollectionRemoveMember(context.Context, *SharedCollectionRemoveMemberRequest) (*SharedCollectionRemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollectionRemoveMember not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollectionLeave(context.Context, *SharedCollectionLeaveRequest) (*SharedCollectionLeaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollectionLeave not implemented")
+}
+func (*UnimplementedKeysServer) SharedCollectionSync(context.Context, *SharedCollectionSyncRequest) (*SharedCollectionSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedCollectionSync not implemented")
+}
 func (*UnimplementedKeysServer) Collections(context.Context, *CollectionsRequest) (*CollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Collections not implemented")
 }
@@ -1944,483 +2476,1058 @@ func _Keys_UserAdd_Handler(srv interface{}, ctx context.Context, dec func(interf
 		FullMethod: "/keys.Keys/UserAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).UserAdd(ctx, req.(*UserAddRequest))
+		return srv.(KeysServer).UserAdd(ctx, req.(*UserAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_Secret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).Secret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/Secret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).Secret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretSave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretSave(ctx, req.(*SecretSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretRemove(ctx, req.(*SecretRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_Secrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).Secrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/Secrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).Secrets(ctx, req.(*SecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretHistory(ctx, req.(*SecretHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretRestore(ctx, req.(*SecretRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretOTP(ctx, req.(*SecretOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretImport(ctx, req.(*SecretImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretsExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretsExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretsExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretsExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretsExport(ctx, req.(*SecretsExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretsAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretsAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretsAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretsAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretsAudit(ctx, req.(*SecretsAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretShare(ctx, req.(*SecretShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_SecretReceive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).SecretReceive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/SecretReceive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).SecretReceive(ctx, req.(*SecretReceiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AttachmentPut_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KeysServer).AttachmentPut(&keysAttachmentPutServer{stream})
+}
+
+type Keys_AttachmentPutServer interface {
+	SendAndClose(*AttachmentPutResponse) error
+	Recv() (*AttachmentPutRequest, error)
+	grpc.ServerStream
+}
+
+type keysAttachmentPutServer struct {
+	grpc.ServerStream
+}
+
+func (x *keysAttachmentPutServer) SendAndClose(m *AttachmentPutResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *keysAttachmentPutServer) Recv() (*AttachmentPutRequest, error) {
+	m := new(AttachmentPutRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Keys_AttachmentGet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AttachmentGetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeysServer).AttachmentGet(m, &keysAttachmentGetServer{stream})
+}
+
+type Keys_AttachmentGetServer interface {
+	Send(*AttachmentGetResponse) error
+	grpc.ServerStream
+}
+
+type keysAttachmentGetServer struct {
+	grpc.ServerStream
+}
+
+func (x *keysAttachmentGetServer) Send(m *AttachmentGetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Keys_Pull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).Pull(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/Pull",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).Pull(ctx, req.(*PullRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_Push_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).Push(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/Push",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).Push(ctx, req.(*PushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_Wormhole_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KeysServer).Wormhole(&keysWormholeServer{stream})
+}
+
+type Keys_WormholeServer interface {
+	Send(*WormholeOutput) error
+	Recv() (*WormholeInput, error)
+	grpc.ServerStream
+}
+
+type keysWormholeServer struct {
+	grpc.ServerStream
+}
+
+func (x *keysWormholeServer) Send(m *WormholeOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *keysWormholeServer) Recv() (*WormholeInput, error) {
+	m := new(WormholeInput)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Keys_AuthSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthSetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthSetup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthSetup(ctx, req.(*AuthSetupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthVault(ctx, req.(*AuthVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthUnlock(ctx, req.(*AuthUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthLock(ctx, req.(*AuthLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthReset(ctx, req.(*AuthResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthRecover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRecoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthRecover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthRecover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthRecover(ctx, req.(*AuthRecoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_RuntimeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuntimeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).RuntimeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/RuntimeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).RuntimeStatus(ctx, req.(*RuntimeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_Rand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).Rand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/Rand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).Rand(ctx, req.(*RandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_RandPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).RandPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/RandPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).RandPassword(ctx, req.(*RandPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthProvision(ctx, req.(*AuthProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthDeprovision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthDeprovisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthDeprovision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthDeprovision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthDeprovision(ctx, req.(*AuthDeprovisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keys_AuthProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeysServer).AuthProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keys.Keys/AuthProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeysServer).AuthProvisions(ctx, req.(*AuthProvisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
+func _Keys_AuthPasswordChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthPasswordChangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).Search(ctx, in)
+		return srv.(KeysServer).AuthPasswordChange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/Search",
+		FullMethod: "/keys.Keys/AuthPasswordChange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).Search(ctx, req.(*SearchRequest))
+		return srv.(KeysServer).AuthPasswordChange(ctx, req.(*AuthPasswordChangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_Secret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SecretRequest)
+func _Keys_AuthRotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRotateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).Secret(ctx, in)
+		return srv.(KeysServer).AuthRotate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/Secret",
+		FullMethod: "/keys.Keys/AuthRotate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).Secret(ctx, req.(*SecretRequest))
+		return srv.(KeysServer).AuthRotate(ctx, req.(*AuthRotateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_SecretSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SecretSaveRequest)
+func _Keys_AuthLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).SecretSave(ctx, in)
+		return srv.(KeysServer).AuthLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/SecretSave",
+		FullMethod: "/keys.Keys/AuthLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).SecretSave(ctx, req.(*SecretSaveRequest))
+		return srv.(KeysServer).AuthLog(ctx, req.(*AuthLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_SecretRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SecretRemoveRequest)
+func _Keys_AuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).SecretRemove(ctx, in)
+		return srv.(KeysServer).AuthTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/SecretRemove",
+		FullMethod: "/keys.Keys/AuthTokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).SecretRemove(ctx, req.(*SecretRemoveRequest))
+		return srv.(KeysServer).AuthTokens(ctx, req.(*AuthTokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_Secrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SecretsRequest)
+func _Keys_AuthTokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTokenRevokeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).Secrets(ctx, in)
+		return srv.(KeysServer).AuthTokenRevoke(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/Secrets",
+		FullMethod: "/keys.Keys/AuthTokenRevoke",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).Secrets(ctx, req.(*SecretsRequest))
+		return srv.(KeysServer).AuthTokenRevoke(ctx, req.(*AuthTokenRevokeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_Pull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullRequest)
+func _Keys_VaultAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultAuthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).Pull(ctx, in)
+		return srv.(KeysServer).VaultAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/Pull",
+		FullMethod: "/keys.Keys/VaultAuth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).Pull(ctx, req.(*PullRequest))
+		return srv.(KeysServer).VaultAuth(ctx, req.(*VaultAuthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_Push_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushRequest)
+func _Keys_VaultSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultSyncRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).Push(ctx, in)
+		return srv.(KeysServer).VaultSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/Push",
+		FullMethod: "/keys.Keys/VaultSync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).Push(ctx, req.(*PushRequest))
+		return srv.(KeysServer).VaultSync(ctx, req.(*VaultSyncRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_Wormhole_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(KeysServer).Wormhole(&keysWormholeServer{stream})
+func _Keys_VaultSyncProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VaultSyncProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeysServer).VaultSyncProgress(m, &keysVaultSyncProgressServer{stream})
 }
 
-type Keys_WormholeServer interface {
-	Send(*WormholeOutput) error
-	Recv() (*WormholeInput, error)
+type Keys_VaultSyncProgressServer interface {
+	Send(*VaultSyncProgressOutput) error
 	grpc.ServerStream
 }
 
-type keysWormholeServer struct {
+type keysVaultSyncProgressServer struct {
 	grpc.ServerStream
 }
 
-func (x *keysWormholeServer) Send(m *WormholeOutput) error {
+func (x *keysVaultSyncProgressServer) Send(m *VaultSyncProgressOutput) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *keysWormholeServer) Recv() (*WormholeInput, error) {
-	m := new(WormholeInput)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _Keys_AuthSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthSetupRequest)
+func _Keys_VaultUnsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultUnsyncRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthSetup(ctx, in)
+		return srv.(KeysServer).VaultUnsync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthSetup",
+		FullMethod: "/keys.Keys/VaultUnsync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthSetup(ctx, req.(*AuthSetupRequest))
+		return srv.(KeysServer).VaultUnsync(ctx, req.(*VaultUnsyncRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthVaultRequest)
+func _Keys_VaultStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthVault(ctx, in)
+		return srv.(KeysServer).VaultStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthVault",
+		FullMethod: "/keys.Keys/VaultStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthVault(ctx, req.(*AuthVaultRequest))
+		return srv.(KeysServer).VaultStatus(ctx, req.(*VaultStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthUnlockRequest)
+func _Keys_VaultUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthUnlock(ctx, in)
+		return srv.(KeysServer).VaultUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthUnlock",
+		FullMethod: "/keys.Keys/VaultUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthUnlock(ctx, req.(*AuthUnlockRequest))
+		return srv.(KeysServer).VaultUpdate(ctx, req.(*VaultUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthLockRequest)
+func _Keys_VaultConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultConflictsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthLock(ctx, in)
+		return srv.(KeysServer).VaultConflicts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthLock",
+		FullMethod: "/keys.Keys/VaultConflicts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthLock(ctx, req.(*AuthLockRequest))
+		return srv.(KeysServer).VaultConflicts(ctx, req.(*VaultConflictsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthResetRequest)
+func _Keys_VaultResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultResolveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthReset(ctx, in)
+		return srv.(KeysServer).VaultResolve(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthReset",
+		FullMethod: "/keys.Keys/VaultResolve",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthReset(ctx, req.(*AuthResetRequest))
+		return srv.(KeysServer).VaultResolve(ctx, req.(*VaultResolveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthRecover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRecoverRequest)
+func _Keys_VaultEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VaultEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeysServer).VaultEvents(m, &keysVaultEventsServer{stream})
+}
+
+type Keys_VaultEventsServer interface {
+	Send(*VaultEvent) error
+	grpc.ServerStream
+}
+
+type keysVaultEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *keysVaultEventsServer) Send(m *VaultEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Keys_VaultBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthRecover(ctx, in)
+		return srv.(KeysServer).VaultBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthRecover",
+		FullMethod: "/keys.Keys/VaultBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthRecover(ctx, req.(*AuthRecoverRequest))
+		return srv.(KeysServer).VaultBackup(ctx, req.(*VaultBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_RuntimeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RuntimeStatusRequest)
+func _Keys_VaultRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultRestoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).RuntimeStatus(ctx, in)
+		return srv.(KeysServer).VaultRestore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/RuntimeStatus",
+		FullMethod: "/keys.Keys/VaultRestore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).RuntimeStatus(ctx, req.(*RuntimeStatusRequest))
+		return srv.(KeysServer).VaultRestore(ctx, req.(*VaultRestoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_Rand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RandRequest)
+func _Keys_VaultRemoteAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultRemoteAddRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).Rand(ctx, in)
+		return srv.(KeysServer).VaultRemoteAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/Rand",
+		FullMethod: "/keys.Keys/VaultRemoteAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).Rand(ctx, req.(*RandRequest))
+		return srv.(KeysServer).VaultRemoteAdd(ctx, req.(*VaultRemoteAddRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_RandPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RandPasswordRequest)
+func _Keys_VaultRemoteRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultRemoteRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).RandPassword(ctx, in)
+		return srv.(KeysServer).VaultRemoteRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/RandPassword",
+		FullMethod: "/keys.Keys/VaultRemoteRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).RandPassword(ctx, req.(*RandPasswordRequest))
+		return srv.(KeysServer).VaultRemoteRemove(ctx, req.(*VaultRemoteRemoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthProvisionRequest)
+func _Keys_SharedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthProvision(ctx, in)
+		return srv.(KeysServer).SharedCollections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthProvision",
+		FullMethod: "/keys.Keys/SharedCollections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthProvision(ctx, req.(*AuthProvisionRequest))
+		return srv.(KeysServer).SharedCollections(ctx, req.(*SharedCollectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthDeprovision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthDeprovisionRequest)
+func _Keys_SharedCollectionCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthDeprovision(ctx, in)
+		return srv.(KeysServer).SharedCollectionCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthDeprovision",
+		FullMethod: "/keys.Keys/SharedCollectionCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthDeprovision(ctx, req.(*AuthDeprovisionRequest))
+		return srv.(KeysServer).SharedCollectionCreate(ctx, req.(*SharedCollectionCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthProvisionsRequest)
+func _Keys_SharedCollectionInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionInviteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthProvisions(ctx, in)
+		return srv.(KeysServer).SharedCollectionInvite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthProvisions",
+		FullMethod: "/keys.Keys/SharedCollectionInvite",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthProvisions(ctx, req.(*AuthProvisionsRequest))
+		return srv.(KeysServer).SharedCollectionInvite(ctx, req.(*SharedCollectionInviteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_AuthPasswordChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthPasswordChangeRequest)
+func _Keys_SharedCollectionInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionInvitesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).AuthPasswordChange(ctx, in)
+		return srv.(KeysServer).SharedCollectionInvites(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/AuthPasswordChange",
+		FullMethod: "/keys.Keys/SharedCollectionInvites",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).AuthPasswordChange(ctx, req.(*AuthPasswordChangeRequest))
+		return srv.(KeysServer).SharedCollectionInvites(ctx, req.(*SharedCollectionInvitesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_VaultAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VaultAuthRequest)
+func _Keys_SharedCollectionAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionAcceptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).VaultAuth(ctx, in)
+		return srv.(KeysServer).SharedCollectionAccept(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/VaultAuth",
+		FullMethod: "/keys.Keys/SharedCollectionAccept",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).VaultAuth(ctx, req.(*VaultAuthRequest))
+		return srv.(KeysServer).SharedCollectionAccept(ctx, req.(*SharedCollectionAcceptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_VaultSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VaultSyncRequest)
+func _Keys_SharedCollectionMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).VaultSync(ctx, in)
+		return srv.(KeysServer).SharedCollectionMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/VaultSync",
+		FullMethod: "/keys.Keys/SharedCollectionMembers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).VaultSync(ctx, req.(*VaultSyncRequest))
+		return srv.(KeysServer).SharedCollectionMembers(ctx, req.(*SharedCollectionMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_VaultUnsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VaultUnsyncRequest)
+func _Keys_SharedCollectionRemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionRemoveMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).VaultUnsync(ctx, in)
+		return srv.(KeysServer).SharedCollectionRemoveMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/VaultUnsync",
+		FullMethod: "/keys.Keys/SharedCollectionRemoveMember",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).VaultUnsync(ctx, req.(*VaultUnsyncRequest))
+		return srv.(KeysServer).SharedCollectionRemoveMember(ctx, req.(*SharedCollectionRemoveMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_VaultStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VaultStatusRequest)
+func _Keys_SharedCollectionLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionLeaveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).VaultStatus(ctx, in)
+		return srv.(KeysServer).SharedCollectionLeave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/VaultStatus",
+		FullMethod: "/keys.Keys/SharedCollectionLeave",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).VaultStatus(ctx, req.(*VaultStatusRequest))
+		return srv.(KeysServer).SharedCollectionLeave(ctx, req.(*SharedCollectionLeaveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Keys_VaultUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VaultUpdateRequest)
+func _Keys_SharedCollectionSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedCollectionSyncRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeysServer).VaultUpdate(ctx, in)
+		return srv.(KeysServer).SharedCollectionSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keys.Keys/VaultUpdate",
+		FullMethod: "/keys.Keys/SharedCollectionSync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeysServer).VaultUpdate(ctx, req.(*VaultUpdateRequest))
+		return srv.(KeysServer).SharedCollectionSync(ctx, req.(*SharedCollectionSyncRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2802,6 +3909,38 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Secrets",
 			Handler:    _Keys_Secrets_Handler,
 		},
+		{
+			MethodName: "SecretHistory",
+			Handler:    _Keys_SecretHistory_Handler,
+		},
+		{
+			MethodName: "SecretRestore",
+			Handler:    _Keys_SecretRestore_Handler,
+		},
+		{
+			MethodName: "SecretOTP",
+			Handler:    _Keys_SecretOTP_Handler,
+		},
+		{
+			MethodName: "SecretImport",
+			Handler:    _Keys_SecretImport_Handler,
+		},
+		{
+			MethodName: "SecretsExport",
+			Handler:    _Keys_SecretsExport_Handler,
+		},
+		{
+			MethodName: "SecretsAudit",
+			Handler:    _Keys_SecretsAudit_Handler,
+		},
+		{
+			MethodName: "SecretShare",
+			Handler:    _Keys_SecretShare_Handler,
+		},
+		{
+			MethodName: "SecretReceive",
+			Handler:    _Keys_SecretReceive_Handler,
+		},
 		{
 			MethodName: "Pull",
 			Handler:    _Keys_Pull_Handler,
@@ -2862,6 +4001,22 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AuthPasswordChange",
 			Handler:    _Keys_AuthPasswordChange_Handler,
 		},
+		{
+			MethodName: "AuthRotate",
+			Handler:    _Keys_AuthRotate_Handler,
+		},
+		{
+			MethodName: "AuthLog",
+			Handler:    _Keys_AuthLog_Handler,
+		},
+		{
+			MethodName: "AuthTokens",
+			Handler:    _Keys_AuthTokens_Handler,
+		},
+		{
+			MethodName: "AuthTokenRevoke",
+			Handler:    _Keys_AuthTokenRevoke_Handler,
+		},
 		{
 			MethodName: "VaultAuth",
 			Handler:    _Keys_VaultAuth_Handler,
@@ -2882,6 +4037,66 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VaultUpdate",
 			Handler:    _Keys_VaultUpdate_Handler,
 		},
+		{
+			MethodName: "VaultConflicts",
+			Handler:    _Keys_VaultConflicts_Handler,
+		},
+		{
+			MethodName: "VaultResolve",
+			Handler:    _Keys_VaultResolve_Handler,
+		},
+		{
+			MethodName: "VaultBackup",
+			Handler:    _Keys_VaultBackup_Handler,
+		},
+		{
+			MethodName: "VaultRestore",
+			Handler:    _Keys_VaultRestore_Handler,
+		},
+		{
+			MethodName: "VaultRemoteAdd",
+			Handler:    _Keys_VaultRemoteAdd_Handler,
+		},
+		{
+			MethodName: "VaultRemoteRemove",
+			Handler:    _Keys_VaultRemoteRemove_Handler,
+		},
+		{
+			MethodName: "SharedCollections",
+			Handler:    _Keys_SharedCollections_Handler,
+		},
+		{
+			MethodName: "SharedCollectionCreate",
+			Handler:    _Keys_SharedCollectionCreate_Handler,
+		},
+		{
+			MethodName: "SharedCollectionInvite",
+			Handler:    _Keys_SharedCollectionInvite_Handler,
+		},
+		{
+			MethodName: "SharedCollectionInvites",
+			Handler:    _Keys_SharedCollectionInvites_Handler,
+		},
+		{
+			MethodName: "SharedCollectionAccept",
+			Handler:    _Keys_SharedCollectionAccept_Handler,
+		},
+		{
+			MethodName: "SharedCollectionMembers",
+			Handler:    _Keys_SharedCollectionMembers_Handler,
+		},
+		{
+			MethodName: "SharedCollectionRemoveMember",
+			Handler:    _Keys_SharedCollectionRemoveMember_Handler,
+		},
+		{
+			MethodName: "SharedCollectionLeave",
+			Handler:    _Keys_SharedCollectionLeave_Handler,
+		},
+		{
+			MethodName: "SharedCollectionSync",
+			Handler:    _Keys_SharedCollectionSync_Handler,
+		},
 		{
 			MethodName: "Collections",
 			Handler:    _Keys_Collections_Handler,
@@ -2998,12 +4213,32 @@ var _Keys_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "AttachmentPut",
+			Handler:       _Keys_AttachmentPut_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "AttachmentGet",
+			Handler:       _Keys_AttachmentGet_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Wormhole",
 			Handler:       _Keys_Wormhole_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "VaultSyncProgress",
+			Handler:       _Keys_VaultSyncProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "VaultEvents",
+			Handler:       _Keys_VaultEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "NotifyStream",
 			Handler:       _Keys_NotifyStream_Handler,
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"sync"
+
 	wsapi "github.com/keys-pub/keys-ext/ws/api"
 	wsclient "github.com/keys-pub/keys-ext/ws/client"
 )
@@ -9,6 +11,34 @@ import (
 func (s *service) NotifyStream(req *NotifyStreamRequest, srv Keys_NotifyStreamServer) error {
 	ctx := srv.Context()
 
+	// Local notifications (from the service) are sent from a separate
+	// goroutine, so we lock around Send.
+	var sendMtx sync.Mutex
+	send := func(out *NotifyStreamOutput) error {
+		sendMtx.Lock()
+		defer sendMtx.Unlock()
+		return srv.Send(out)
+	}
+	sub, ch := s.notifier.subscribe()
+	defer s.notifier.unsubscribe(sub)
+	sendErr := make(chan error, 1)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case out := <-ch:
+				if err := send(out); err != nil {
+					sendErr <- err
+					return
+				}
+			}
+		}
+	}()
+	if err := s.checkExpiringSecrets(); err != nil {
+		logger.Warningf("Failed to check expiring secrets: %v", err)
+	}
+
 	cl, err := wsclient.New("wss://relay.keys.pub/ws")
 	if err != nil {
 		return err
@@ -31,6 +61,8 @@ func (s *service) NotifyStream(req *NotifyStreamRequest, srv Keys_NotifyStreamSe
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case err := <-sendErr:
+			return err
 		default:
 		}
 		events, err := cl.ReadEvents()
@@ -71,9 +103,77 @@ func (s *service) NotifyStream(req *NotifyStreamRequest, srv Keys_NotifyStreamSe
 				continue
 			}
 
-			if err := srv.Send(out); err != nil {
+			if err := send(out); err != nil {
 				return err
 			}
 		}
 	}
 }
+
+// notifier sends local notifications (from the service, not the relay) to
+// NotifyStream subscribers.
+type notifier struct {
+	sync.Mutex
+	subs map[int]chan *NotifyStreamOutput
+	next int
+	// sent are keys for notifications that should only be sent once.
+	sent map[string]bool
+}
+
+func newNotifier() *notifier {
+	return &notifier{
+		subs: map[int]chan *NotifyStreamOutput{},
+		sent: map[string]bool{},
+	}
+}
+
+func (n *notifier) subscribe() (int, chan *NotifyStreamOutput) {
+	n.Lock()
+	defer n.Unlock()
+	n.next++
+	ch := make(chan *NotifyStreamOutput, 100)
+	n.subs[n.next] = ch
+	return n.next, ch
+}
+
+func (n *notifier) unsubscribe(id int) {
+	n.Lock()
+	defer n.Unlock()
+	delete(n.subs, id)
+}
+
+// notify subscribers, returns the number of subscribers notified.
+// If a subscriber isn't keeping up, the notification is dropped (for that
+// subscriber).
+func (n *notifier) notify(out *NotifyStreamOutput) int {
+	n.Lock()
+	defer n.Unlock()
+	return n.notifyLocked(out)
+}
+
+func (n *notifier) notifyLocked(out *NotifyStreamOutput) int {
+	count := 0
+	for _, ch := range n.subs {
+		select {
+		case ch <- out:
+			count++
+		default:
+		}
+	}
+	return count
+}
+
+// notifyOnce notifies subscribers, unless a notification with the same key
+// was already sent (to any subscriber).
+func (n *notifier) notifyOnce(key string, out *NotifyStreamOutput) bool {
+	n.Lock()
+	defer n.Unlock()
+	if n.sent[key] {
+		return false
+	}
+	if n.notifyLocked(out) == 0 {
+		return false
+	}
+	n.sent[key] = true
+	return true
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Token scopes.
+//
+// A token can be scoped (at AuthUnlock) to method groups, so for example a
+// browser extension that only needs secrets can't export keys.
+// A token without scopes has full access.
+const (
+	// KeysReadScope to list and get keys, users and sigchains.
+	KeysReadScope = "keys:read"
+	// SignScope to sign and verify.
+	SignScope = "sign"
+	// EncryptScope to encrypt and decrypt.
+	EncryptScope = "encrypt"
+	// SecretsReadScope to list and get secrets.
+	SecretsReadScope = "secrets:read"
+	// SecretsWriteScope to save and remove secrets (and generate OTP codes).
+	SecretsWriteScope = "secrets:write"
+	// AdminScope for everything else.
+	AdminScope = "admin"
+)
+
+var scopes = []string{KeysReadScope, SignScope, EncryptScope, SecretsReadScope, SecretsWriteScope, AdminScope}
+
+// methodScopes is the scope required for a method.
+// Methods not listed require AdminScope.
+var methodScopes = map[string]string{
+	"Keys":       KeysReadScope,
+	"Key":        KeysReadScope,
+	"Sigchain":   KeysReadScope,
+	"Statement":  KeysReadScope,
+	"User":       KeysReadScope,
+	"UserSearch": KeysReadScope,
+	"Search":     KeysReadScope,
+
+	"Sign":                 SignScope,
+	"SignFile":             SignScope,
+	"SignStream":           SignScope,
+	"Verify":               SignScope,
+	"VerifyFile":           SignScope,
+	"VerifyStream":         SignScope,
+	"VerifyDetached":       SignScope,
+	"VerifyDetachedFile":   SignScope,
+	"VerifyDetachedStream": SignScope,
+
+	"Encrypt":       EncryptScope,
+	"EncryptFile":   EncryptScope,
+	"EncryptStream": EncryptScope,
+	"Decrypt":       EncryptScope,
+	"DecryptFile":   EncryptScope,
+	"DecryptStream": EncryptScope,
+
+	"Secret":                  SecretsReadScope,
+	"Secrets":                 SecretsReadScope,
+	"SecretHistory":           SecretsReadScope,
+	"SecretsExport":           SecretsReadScope,
+	"SecretsAudit":            SecretsReadScope,
+	"SecretShare":             SecretsReadScope,
+	"AttachmentGet":           SecretsReadScope,
+	"SharedCollections":       SecretsReadScope,
+	"SharedCollectionMembers": SecretsReadScope,
+
+	"SecretSave":    SecretsWriteScope,
+	"SecretRemove":  SecretsWriteScope,
+	"SecretRestore": SecretsWriteScope,
+	"SecretImport":  SecretsWriteScope,
+	"SecretReceive": SecretsWriteScope,
+	"AttachmentPut": SecretsWriteScope,
+	// SecretOTP saves the counter for HOTP.
+	"SecretOTP": SecretsWriteScope,
+}
+
+// freshUnlockMethods require the token to be from a recent unlock, since they
+// export or remove keys or secrets.
+var freshUnlockMethods = map[string]bool{
+	"KeyExport":       true,
+	"KeyRemove":       true,
+	"SecretRemove":    true,
+	"SecretsExport":   true,
+	"SecretShare":     true,
+	"VaultBackup":     true,
+	"AuthDeprovision": true,
+}
+
+// freshUnlockDuration is how long after unlock a token is "fresh".
+const freshUnlockDuration = time.Minute * 5
+
+// methodName returns the method name from the full method, for example,
+// "/keys.Keys/SecretSave" returns "SecretSave".
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func methodScope(fullMethod string) string {
+	scope, ok := methodScopes[methodName(fullMethod)]
+	if !ok {
+		return AdminScope
+	}
+	return scope
+}
+
+func checkScopes(s []string) error {
+	for _, scope := range s {
+		if !containsString(scopes, scope) {
+			return errors.Errorf("invalid scope %q", scope)
+		}
+	}
+	return nil
+}
+
+func containsString(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+	return false
+}
@@ -2,13 +2,15 @@ package service
 
 import "context"
 
-// Search (RPC) ...
+// Search (RPC) searches for keys, users (on the server) and keys in the vault
+// (by ID, type or notes, from the vault search index).
 func (s *service) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
 	res, err := s.searchUsersRemote(ctx, req.Query, 0)
 	if err != nil {
 		return nil, err
 	}
 	keys := make([]*Key, 0, len(res))
+	found := map[string]bool{}
 	for _, u := range res {
 		kid := u.KID
 		typ := string(kid.PublicKeyType())
@@ -18,6 +20,27 @@ func (s *service) Search(ctx context.Context, req *SearchRequest) (*SearchRespon
 			Type: typ,
 		}
 		keys = append(keys, key)
+		found[key.ID] = true
+	}
+
+	items, err := s.vault.Search(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		vk, err := item.Key()
+		if err != nil {
+			return nil, err
+		}
+		if vk == nil || found[vk.ID.String()] {
+			continue
+		}
+		key, err := s.keyToRPC(ctx, vk)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+		found[key.ID] = true
 	}
 
 	return &SearchResponse{
@@ -26,4 +26,17 @@ func TestSearch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(resp.Keys))
 	require.Equal(t, "kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077", resp.Keys[0].ID)
+
+	// Vault keys (notes)
+	key, err := service.vault.Key(bob.ID())
+	require.NoError(t, err)
+	key.Notes = "Work laptop"
+	_, _, err = service.vault.SaveKey(key)
+	require.NoError(t, err)
+
+	resp, err = service.Search(ctx, &SearchRequest{Query: "laptop"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Keys))
+	require.Equal(t, bob.ID().String(), resp.Keys[0].ID)
+	require.Equal(t, "bob", resp.Keys[0].User.Name)
 }
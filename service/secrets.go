@@ -1,17 +1,33 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"strings"
+	"time"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
 	"github.com/keys-pub/keys-ext/vault/secrets"
 	"github.com/keys-pub/keys/tsutil"
 	"github.com/pkg/errors"
 )
 
 func (s *service) Secret(ctx context.Context, req *SecretRequest) (*SecretResponse, error) {
-	secret, err := secrets.Get(s.vault, req.ID)
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	if req.ID == "" {
+		secret, err := findSecretByName(vlt, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &SecretResponse{
+			Secret: secretToRPC(secret),
+		}, nil
+	}
+	secret, err := secrets.Get(vlt, req.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -33,8 +49,21 @@ func (s *service) SecretSave(ctx context.Context, req *SecretSaveRequest) (*Secr
 	if name == "" {
 		return nil, errors.Errorf("no name specified")
 	}
+	if secret.OTP != "" {
+		otp, err := secrets.ParseOTP(secret.OTP)
+		if err != nil {
+			return nil, err
+		}
+		secret.OTP = otp.URI()
+	} else if secret.Type == secrets.OTPType {
+		return nil, errors.Errorf("no otp specified")
+	}
 
-	out, _, err := secrets.Save(s.vault, secret)
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	out, _, err := secrets.Save(vlt, secret)
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +77,12 @@ func (s *service) SecretRemove(ctx context.Context, req *SecretRemoveRequest) (*
 	if req.ID == "" {
 		return nil, errors.Errorf("id not specified")
 	}
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
 	// TODO: What if ID isn't for secret?
-	ok, err := s.vault.Delete(req.ID)
+	ok, err := vlt.Delete(req.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -64,11 +97,21 @@ func (s *service) Secrets(ctx context.Context, req *SecretsRequest) (*SecretsRes
 		req.SortField = "name"
 	}
 
-	secrets, err := secrets.List(s.vault,
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	opts := []secrets.Option{
 		secrets.WithQuery(req.Query),
 		secrets.WithSort(req.SortField),
 		secrets.WithSortDirection(sortDirectionToVault(req.SortDirection)),
-	)
+		secrets.WithTags(req.Tags...),
+		secrets.WithFolder(req.Folder),
+	}
+	if req.SkipExpired {
+		opts = append(opts, secrets.WithSkipExpired())
+	}
+	secrets, err := secrets.List(vlt, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +124,140 @@ func (s *service) Secrets(ctx context.Context, req *SecretsRequest) (*SecretsRes
 	}, nil
 }
 
+func (s *service) SecretHistory(ctx context.Context, req *SecretHistoryRequest) (*SecretHistoryResponse, error) {
+	if req.ID == "" {
+		return nil, errors.Errorf("id not specified")
+	}
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := secrets.History(vlt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, keys.NewErrNotFound(req.ID)
+	}
+	out := make([]*SecretVersion, 0, len(versions))
+	for _, v := range versions {
+		out = append(out, secretVersionToRPC(v))
+	}
+	return &SecretHistoryResponse{
+		Versions: out,
+	}, nil
+}
+
+func (s *service) SecretRestore(ctx context.Context, req *SecretRestoreRequest) (*SecretRestoreResponse, error) {
+	if req.ID == "" {
+		return nil, errors.Errorf("id not specified")
+	}
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := secrets.Restore(vlt, req.ID, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretRestoreResponse{
+		Secret: secretToRPC(secret),
+	}, nil
+}
+
+func (s *service) SecretOTP(ctx context.Context, req *SecretOTPRequest) (*SecretOTPResponse, error) {
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	id := req.ID
+	if id == "" {
+		secret, err := findSecretByName(vlt, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		id = secret.ID
+	}
+	s.otpMtx.Lock()
+	defer s.otpMtx.Unlock()
+	code, err := secrets.GenerateOTP(vlt, id)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretOTPResponse{
+		Code:      code.Code,
+		Type:      string(code.Kind),
+		Remaining: int64(code.Remaining / time.Millisecond),
+		Counter:   code.Counter,
+	}, nil
+}
+
+func (s *service) SecretImport(ctx context.Context, req *SecretImportRequest) (*SecretImportResponse, error) {
+	if req.Format == "" {
+		return nil, errors.Errorf("no format specified")
+	}
+	ss, err := secrets.ParseImport(bytes.NewReader(req.In), secrets.ImportFormat(req.Format))
+	if err != nil {
+		return nil, err
+	}
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	opts := []secrets.ImportOption{}
+	if req.DryRun {
+		opts = append(opts, secrets.ImportDryRun())
+	}
+	result, err := secrets.Import(vlt, ss, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !req.DryRun {
+		logger.Infof("Imported %d secrets (%d skipped)", len(result.Added), len(result.Skipped))
+	}
+	return &SecretImportResponse{
+		Added:   secretsToRPC(result.Added),
+		Skipped: secretsToRPC(result.Skipped),
+	}, nil
+}
+
+// findSecretByName finds a secret with the exact name.
+func findSecretByName(vlt *vault.Vault, name string) (*secrets.Secret, error) {
+	if name == "" {
+		return nil, errors.Errorf("no id or name specified")
+	}
+	ss, err := secrets.List(vlt, secrets.WithQuery(name))
+	if err != nil {
+		return nil, err
+	}
+	var found *secrets.Secret
+	for _, secret := range ss {
+		if secret.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, errors.Errorf("multiple secrets named %q, specify an id", name)
+		}
+		found = secret
+	}
+	if found == nil {
+		return nil, keys.NewErrNotFound(name)
+	}
+	return found, nil
+}
+
+func secretVersionToRPC(v *secrets.Version) *SecretVersion {
+	out := &SecretVersion{
+		Version:   v.Version,
+		Deleted:   v.Secret == nil,
+		UpdatedAt: tsutil.Millis(v.UpdatedAt),
+	}
+	if v.Secret != nil {
+		out.Secret = secretToRPC(v.Secret)
+	}
+	return out
+}
+
 func sortDirectionToVault(d SortDirection) secrets.SortDirection {
 	switch d {
 	case SortAsc:
@@ -109,6 +286,11 @@ func secretToRPC(s *secrets.Secret) *Secret {
 		Password:  s.Password,
 		URL:       s.URL,
 		Notes:     s.Notes,
+		OTP:       s.OTP,
+		Fields:    secretFieldsToRPC(s.Fields),
+		Tags:      s.Tags,
+		Folder:    s.Folder,
+		ExpireAt:  tsutil.Millis(s.ExpireAt),
 		CreatedAt: tsutil.Millis(s.CreatedAt),
 		UpdatedAt: tsutil.Millis(s.UpdatedAt),
 	}
@@ -123,6 +305,11 @@ func secretFromRPC(s *Secret) *secrets.Secret {
 		Password:  s.Password,
 		URL:       s.URL,
 		Notes:     s.Notes,
+		OTP:       s.OTP,
+		Fields:    secretFieldsFromRPC(s.Fields),
+		Tags:      s.Tags,
+		Folder:    s.Folder,
+		ExpireAt:  tsutil.ConvertMillis(s.ExpireAt),
 		CreatedAt: tsutil.ConvertMillis(s.CreatedAt),
 		UpdatedAt: tsutil.ConvertMillis(s.UpdatedAt),
 	}
@@ -134,6 +321,8 @@ func secretTypeToRPC(t secrets.Type) SecretType {
 		return PasswordSecret
 	case secrets.NoteType:
 		return NoteSecret
+	case secrets.OTPType:
+		return OTPSecret
 	default:
 		return UnknownSecret
 	}
@@ -145,7 +334,65 @@ func secretTypeFromRPC(s SecretType) secrets.Type {
 		return secrets.PasswordType
 	case NoteSecret:
 		return secrets.NoteType
+	case OTPSecret:
+		return secrets.OTPType
 	default:
 		return secrets.UnknownType
 	}
 }
+
+func secretFieldsToRPC(fields []*secrets.Field) []*SecretField {
+	if len(fields) == 0 {
+		return nil
+	}
+	out := make([]*SecretField, 0, len(fields))
+	for _, f := range fields {
+		out = append(out, &SecretField{
+			Name:  f.Name,
+			Type:  secretFieldTypeToRPC(f.Type),
+			Value: f.Value,
+		})
+	}
+	return out
+}
+
+func secretFieldsFromRPC(fields []*SecretField) []*secrets.Field {
+	if len(fields) == 0 {
+		return nil
+	}
+	out := make([]*secrets.Field, 0, len(fields))
+	for _, f := range fields {
+		out = append(out, &secrets.Field{
+			Name:  f.Name,
+			Type:  secretFieldTypeFromRPC(f.Type),
+			Value: f.Value,
+		})
+	}
+	return out
+}
+
+func secretFieldTypeToRPC(t secrets.FieldType) SecretFieldType {
+	switch t {
+	case secrets.HiddenField:
+		return HiddenField
+	case secrets.URLField:
+		return URLField
+	case secrets.DateField:
+		return DateField
+	default:
+		return TextField
+	}
+}
+
+func secretFieldTypeFromRPC(t SecretFieldType) secrets.FieldType {
+	switch t {
+	case HiddenField:
+		return secrets.HiddenField
+	case URLField:
+		return secrets.URLField
+	case DateField:
+		return secrets.DateField
+	default:
+		return secrets.TextField
+	}
+}
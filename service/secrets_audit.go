@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault/secrets"
+)
+
+// SecretsAudit (RPC) checks password secrets for problems.
+func (s *service) SecretsAudit(ctx context.Context, req *SecretsAuditRequest) (*SecretsAuditResponse, error) {
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	opts := []secrets.AuditOption{}
+	if req.StaleDays > 0 {
+		opts = append(opts, secrets.AuditStaleAge(time.Duration(req.StaleDays)*time.Hour*24))
+	}
+	if req.MinEntropy > 0 {
+		opts = append(opts, secrets.AuditMinEntropy(float64(req.MinEntropy)))
+	}
+	if req.Breached != "" {
+		f, err := os.Open(req.Breached)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if req.BreachedPrefix != "" {
+			opts = append(opts, secrets.AuditBreachedRange(req.BreachedPrefix, f))
+		} else {
+			opts = append(opts, secrets.AuditBreached(f))
+		}
+	}
+
+	findings, err := secrets.Audit(vlt, opts...)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*SecretsAuditFinding, 0, len(findings))
+	for _, f := range findings {
+		out = append(out, &SecretsAuditFinding{
+			SecretID: f.Secret.ID,
+			Name:     f.Secret.Name,
+			Issue:    string(f.Issue),
+			Severity: f.Severity.String(),
+			Detail:   f.Detail,
+		})
+	}
+	return &SecretsAuditResponse{Findings: out}, nil
+}
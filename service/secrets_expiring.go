@@ -0,0 +1,36 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/keys-pub/keys/tsutil"
+)
+
+// secretExpiringWithin is how far ahead we notify about expiring secrets.
+const secretExpiringWithin = time.Hour * 24 * 7
+
+// checkExpiringSecrets notifies (SecretExpiringNotification) about secrets that
+// are expired, or expire soon.
+// We only notify once for a secret (expiry).
+func (s *service) checkExpiringSecrets() error {
+	ss, err := secrets.List(s.vault, secrets.WithExpiring(secretExpiringWithin))
+	if err != nil {
+		return err
+	}
+	for _, secret := range ss {
+		expireAt := tsutil.Millis(secret.ExpireAt)
+		key := fmt.Sprintf("secret-expiring-%s-%d", secret.ID, expireAt)
+		out := &NotifyStreamOutput{
+			Type:       SecretExpiringNotification,
+			SecretID:   secret.ID,
+			SecretName: secret.Name,
+			ExpireAt:   expireAt,
+		}
+		if s.notifier.notifyOnce(key, out) {
+			logger.Infof("Secret expiring %s", secret.ID)
+		}
+	}
+	return nil
+}
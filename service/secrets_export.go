@@ -0,0 +1,69 @@
+package service
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/pkg/errors"
+)
+
+// SecretsExport (RPC) exports secrets, encrypted (json) or plaintext (csv).
+func (s *service) SecretsExport(ctx context.Context, req *SecretsExportRequest) (*SecretsExportResponse, error) {
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	types := make([]secrets.Type, 0, len(req.Types))
+	for _, t := range req.Types {
+		typ := secretTypeFromRPC(t)
+		if typ == secrets.UnknownType {
+			return nil, errors.Errorf("invalid secret type %s", t)
+		}
+		types = append(types, typ)
+	}
+	ss, err := secrets.List(vlt, secrets.WithTypes(types...), secrets.WithName(req.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	switch req.Format {
+	case "", "json":
+		if len(req.Recipients) == 0 {
+			return nil, errors.Errorf("no recipients specified")
+		}
+		enc, err := s.newEncrypt(ctx, req.Recipients, req.Sender, req.Options)
+		if err != nil {
+			return nil, err
+		}
+		b, err := secrets.ExportJSON(ss, s.clock.Now())
+		if err != nil {
+			return nil, err
+		}
+		out, err = s.encryptData(b, enc)
+		if err != nil {
+			return nil, err
+		}
+	case "csv":
+		if len(req.Recipients) > 0 {
+			return nil, errors.Errorf("csv export isn't encrypted, use json to encrypt to recipients")
+		}
+		if !req.Plaintext {
+			return nil, errors.Errorf("csv export is unencrypted, plaintext must be set")
+		}
+		var buf bytes.Buffer
+		if err := secrets.ExportCSV(&buf, ss); err != nil {
+			return nil, err
+		}
+		out = buf.Bytes()
+	default:
+		return nil, errors.Errorf("invalid export format %q", req.Format)
+	}
+
+	logger.Infof("Exported %d secrets (%s)", len(ss), req.Format)
+	return &SecretsExportResponse{
+		Data:  out,
+		Count: int32(len(ss)),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretsExport(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+	testImportID(t, aliceService, bob.ID())
+
+	_, err := aliceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "keys.pub", Type: PasswordSecret, Username: "alice", Password: "alicepassword"},
+	})
+	require.NoError(t, err)
+	_, err = aliceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "Note", Type: NoteSecret, Notes: "my note"},
+	})
+	require.NoError(t, err)
+
+	// JSON (encrypted to bob)
+	resp, err := aliceService.SecretsExport(ctx, &SecretsExportRequest{
+		Recipients: []string{bob.ID().String()},
+		Sender:     alice.ID().String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.Count)
+	decryptResp, err := bobService.Decrypt(ctx, &DecryptRequest{Data: resp.Data})
+	require.NoError(t, err)
+	export, err := secrets.ReadExport(bytes.NewReader(decryptResp.Data))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(export.Secrets))
+	require.Equal(t, "Note", export.Secrets[0].Name)
+	require.Equal(t, "alicepassword", export.Secrets[1].Password)
+
+	_, err = aliceService.SecretsExport(ctx, &SecretsExportRequest{})
+	require.EqualError(t, err, "no recipients specified")
+
+	// CSV (filtered)
+	_, err = aliceService.SecretsExport(ctx, &SecretsExportRequest{Format: "csv"})
+	require.EqualError(t, err, "csv export is unencrypted, plaintext must be set")
+	resp, err = aliceService.SecretsExport(ctx, &SecretsExportRequest{
+		Format:    "csv",
+		Types:     []SecretType{PasswordSecret},
+		Plaintext: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.Count)
+	require.Equal(t, "name,type,url,username,password,notes,otp,folder,tags\nkeys.pub,password,,alice,alicepassword,,,,\n", string(resp.Data))
+
+	resp, err = aliceService.SecretsExport(ctx, &SecretsExportRequest{
+		Format:    "csv",
+		Name:      "NOTE",
+		Plaintext: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.Count)
+}
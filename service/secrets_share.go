@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// secretShareMaxExpire is the max expire for a secret share (from the server).
+const secretShareMaxExpire = time.Minute * 15
+
+// secretShareFormat identifies a shared secret.
+const secretShareFormat = "keys.pub/secret-share"
+
+type secretShare struct {
+	Format string          `json:"format"`
+	Secret *secrets.Secret `json:"secret"`
+}
+
+// SecretShare (RPC) shares a secret (once).
+//
+// The secret is sealed to a one time key (otk) and uploaded via the share API
+// with an expiry. The remote deletes the share after it is read.
+// The phrase is the BIP39 encoded otk seed.
+func (s *service) SecretShare(ctx context.Context, req *SecretShareRequest) (*SecretShareResponse, error) {
+	vlt, err := s.secretsVault(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+	expire := secretShareMaxExpire
+	if req.Expire != "" {
+		e, err := time.ParseDuration(req.Expire)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid expire")
+		}
+		if e <= 0 || e > secretShareMaxExpire {
+			return nil, errors.Errorf("invalid expire, max is %s", secretShareMaxExpire)
+		}
+		expire = e
+	}
+
+	var secret *secrets.Secret
+	if req.ID != "" {
+		secret, err = secrets.Get(vlt, req.ID)
+		if err != nil {
+			return nil, err
+		}
+		if secret == nil {
+			return nil, keys.NewErrNotFound(req.ID)
+		}
+	} else {
+		secret, err = findSecretByName(vlt, req.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Don't share our ID or timestamps.
+	shared := *secret
+	shared.ID = ""
+	shared.CreatedAt = time.Time{}
+	shared.UpdatedAt = time.Time{}
+
+	b, err := json.Marshal(&secretShare{Format: secretShareFormat, Secret: &shared})
+	if err != nil {
+		return nil, err
+	}
+	otk := keys.GenerateEdX25519Key()
+	if err := s.client.ShareSeal(ctx, otk, b, expire); err != nil {
+		return nil, err
+	}
+	phrase, err := encoding.Encode(otk.Seed()[:], encoding.BIP39)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretShareResponse{
+		Phrase: phrase,
+		Expire: tsutil.Millis(s.clock.Now().Add(expire)),
+	}, nil
+}
+
+// SecretReceive (RPC) opens a shared secret, and optionally saves it.
+func (s *service) SecretReceive(ctx context.Context, req *SecretReceiveRequest) (*SecretReceiveResponse, error) {
+	if req.Phrase == "" {
+		return nil, errors.Errorf("no phrase specified")
+	}
+	otkSeed, err := encoding.Decode(req.Phrase, encoding.BIP39)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid phrase")
+	}
+	if len(otkSeed) != 32 {
+		return nil, errors.Errorf("invalid phrase")
+	}
+	otk := keys.NewEdX25519KeyFromSeed(keys.Bytes32(otkSeed))
+
+	b, err := s.client.ShareOpen(ctx, otk)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, errors.Errorf("share not found (expired or already received)")
+	}
+	var share secretShare
+	if err := json.Unmarshal(b, &share); err != nil {
+		return nil, errors.Wrapf(err, "invalid secret share")
+	}
+	if share.Format != secretShareFormat || share.Secret == nil {
+		return nil, errors.Errorf("invalid secret share")
+	}
+	secret := share.Secret
+	secret.ID = ""
+
+	if req.Save {
+		vlt, err := s.secretsVault(req.Collection)
+		if err != nil {
+			return nil, err
+		}
+		out, _, err := secrets.Save(vlt, secret)
+		if err != nil {
+			return nil, err
+		}
+		secret = out
+	}
+
+	return &SecretReceiveResponse{
+		Secret: secretToRPC(secret),
+	}, nil
+}
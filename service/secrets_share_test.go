@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretShare(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+
+	saveResp, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "keys.pub", Type: PasswordSecret, Username: "alice", Password: "alicepassword", Tags: []string{"work"}},
+	})
+	require.NoError(t, err)
+
+	resp, err := service.SecretShare(ctx, &SecretShareRequest{Name: "keys.pub", Expire: "1m"})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Phrase)
+
+	receiveResp, err := service.SecretReceive(ctx, &SecretReceiveRequest{Phrase: resp.Phrase, Save: true})
+	require.NoError(t, err)
+	require.NotEqual(t, saveResp.Secret.ID, receiveResp.Secret.ID)
+	require.Equal(t, "keys.pub", receiveResp.Secret.Name)
+	require.Equal(t, "alicepassword", receiveResp.Secret.Password)
+	require.Equal(t, []string{"work"}, receiveResp.Secret.Tags)
+
+	secretsResp, err := service.Secrets(ctx, &SecretsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(secretsResp.Secrets))
+
+	// Burned after read
+	_, err = service.SecretReceive(ctx, &SecretReceiveRequest{Phrase: resp.Phrase})
+	require.EqualError(t, err, "share not found (expired or already received)")
+	_, err = service.SecretReceive(ctx, &SecretReceiveRequest{Phrase: resp.Phrase, Save: true})
+	require.EqualError(t, err, "share not found (expired or already received)")
+	secretsResp, err = service.Secrets(ctx, &SecretsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(secretsResp.Secrets))
+
+	_, err = service.SecretShare(ctx, &SecretShareRequest{ID: saveResp.Secret.ID, Expire: "1h"})
+	require.EqualError(t, err, "invalid expire, max is 15m0s")
+	_, err = service.SecretShare(ctx, &SecretShareRequest{Name: "notfound"})
+	require.EqualError(t, err, "notfound not found")
+}
@@ -2,8 +2,14 @@ package service
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +38,322 @@ func TestSecrets(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(resp.Secrets))
 }
+
+func TestSecretHistory(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+
+	testAuthSetup(t, service)
+
+	saveResp, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "testing", Type: PasswordSecret, Password: "password1"},
+	})
+	require.NoError(t, err)
+	secret := saveResp.Secret
+	secret.Password = "password2"
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{Secret: secret})
+	require.NoError(t, err)
+
+	resp, err := service.SecretHistory(ctx, &SecretHistoryRequest{ID: secret.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Versions))
+	require.Equal(t, "password1", resp.Versions[0].Secret.Password)
+	require.Equal(t, "password2", resp.Versions[1].Secret.Password)
+
+	restoreResp, err := service.SecretRestore(ctx, &SecretRestoreRequest{ID: secret.ID, Version: resp.Versions[0].Version})
+	require.NoError(t, err)
+	require.Equal(t, "password1", restoreResp.Secret.Password)
+}
+
+func TestSecretOTP(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+
+	testAuthSetup(t, service)
+
+	_, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "otp", Type: OTPSecret},
+	})
+	require.EqualError(t, err, "no otp specified")
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "otp", Type: OTPSecret, OTP: "otpauth://totp/alice"},
+	})
+	require.EqualError(t, err, "no otp secret")
+
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "totp", Type: OTPSecret, OTP: "otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"},
+	})
+	require.NoError(t, err)
+	resp, err := service.SecretOTP(ctx, &SecretOTPRequest{Name: "totp"})
+	require.NoError(t, err)
+	require.Equal(t, "totp", resp.Type)
+	require.Equal(t, 6, len(resp.Code))
+	require.True(t, resp.Remaining > 0)
+
+	saveResp, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "hotp", Type: OTPSecret, OTP: "otpauth://hotp/alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"},
+	})
+	require.NoError(t, err)
+	resp, err = service.SecretOTP(ctx, &SecretOTPRequest{ID: saveResp.Secret.ID})
+	require.NoError(t, err)
+	require.Equal(t, "755224", resp.Code)
+	resp, err = service.SecretOTP(ctx, &SecretOTPRequest{ID: saveResp.Secret.ID})
+	require.NoError(t, err)
+	require.Equal(t, "287082", resp.Code)
+	require.Equal(t, int64(1), resp.Counter)
+
+	// Concurrent HOTP codes use different counters
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	counters := make([]int64, 10)
+	errs := make([]error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			resp, err := service.SecretOTP(ctx, &SecretOTPRequest{ID: saveResp.Secret.ID})
+			errs[i] = err
+			if err == nil {
+				counters[i] = resp.Counter
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	seen := map[int64]bool{}
+	for i := 0; i < 10; i++ {
+		require.NoError(t, errs[i])
+		require.False(t, seen[counters[i]])
+		seen[counters[i]] = true
+	}
+	resp, err = service.SecretOTP(ctx, &SecretOTPRequest{ID: saveResp.Secret.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(12), resp.Counter)
+
+	_, err = service.SecretOTP(ctx, &SecretOTPRequest{Name: "unknown"})
+	require.EqualError(t, err, "unknown not found")
+}
+
+func TestSecretImport(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+
+	testAuthSetup(t, service)
+
+	in := []byte("name,url,username,password\n" +
+		"keys.pub,https://keys.pub,alice,alicepassword\n" +
+		"Example,https://example.com,bob,bobpassword\n")
+
+	resp, err := service.SecretImport(ctx, &SecretImportRequest{Format: "csv", In: in, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Added))
+	secretsResp, err := service.Secrets(ctx, &SecretsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(secretsResp.Secrets))
+
+	resp, err = service.SecretImport(ctx, &SecretImportRequest{Format: "csv", In: in})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Added))
+	secretsResp, err = service.Secrets(ctx, &SecretsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(secretsResp.Secrets))
+	require.Equal(t, "Example", secretsResp.Secrets[0].Name)
+	require.Equal(t, PasswordSecret, secretsResp.Secrets[0].Type)
+
+	resp, err = service.SecretImport(ctx, &SecretImportRequest{Format: "csv", In: in})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp.Added))
+	require.Equal(t, 2, len(resp.Skipped))
+
+	_, err = service.SecretImport(ctx, &SecretImportRequest{In: in})
+	require.EqualError(t, err, "no format specified")
+}
+
+func TestSecretsAudit(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+
+	testAuthSetup(t, service)
+
+	_, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "alpha", Type: PasswordSecret, Password: "password", URL: "https://alpha.com"},
+	})
+	require.NoError(t, err)
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "bravo", Type: PasswordSecret, Password: "password", URL: "https://bravo.com"},
+	})
+	require.NoError(t, err)
+
+	resp, err := service.SecretsAudit(ctx, &SecretsAuditRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.Findings))
+	require.Equal(t, "alpha", resp.Findings[0].Name)
+	require.Equal(t, "reused", resp.Findings[0].Issue)
+	require.Equal(t, "high", resp.Findings[0].Severity)
+	require.Equal(t, "password also used by bravo", resp.Findings[0].Detail)
+	require.Equal(t, "weak", resp.Findings[2].Issue)
+	require.Equal(t, "medium", resp.Findings[2].Severity)
+
+	// Breached (range)
+	dir, err := ioutil.TempDir("", "KeysTest-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "range.txt")
+	err = ioutil.WriteFile(path, []byte("1E4C9B93F3F0682250B6CF8331B7EE68FD8:3861493\r\n"), 0600)
+	require.NoError(t, err)
+	_, err = service.SecretsAudit(ctx, &SecretsAuditRequest{Breached: path})
+	require.EqualError(t, err, "invalid breached password hash (line 1)")
+	resp, err = service.SecretsAudit(ctx, &SecretsAuditRequest{Breached: path, BreachedPrefix: "5BAA6"})
+	require.NoError(t, err)
+	breached := []string{}
+	for _, f := range resp.Findings {
+		if f.Issue == "breached" {
+			breached = append(breached, f.Name)
+		}
+	}
+	require.Equal(t, []string{"alpha", "bravo"}, breached)
+
+	_, err = service.SecretsAudit(ctx, &SecretsAuditRequest{Breached: "notfound.txt"})
+	require.EqualError(t, err, "open notfound.txt: no such file or directory")
+}
+
+func TestSecretFields(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+
+	testAuthSetup(t, service)
+
+	saveResp, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{
+			Name:     "Server",
+			Type:     PasswordSecret,
+			Password: "serverpassword",
+			Fields: []*SecretField{
+				{Name: "API Key", Type: HiddenField, Value: "apikey123"},
+				{Name: "Expires", Type: DateField, Value: "2030-01-02"},
+			},
+			Tags:   []string{"Work", "ssh", "work"},
+			Folder: "/Work/Servers/",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"ssh", "work"}, saveResp.Secret.Tags)
+	require.Equal(t, "Work/Servers", saveResp.Secret.Folder)
+	require.Equal(t, 2, len(saveResp.Secret.Fields))
+	require.Equal(t, HiddenField, saveResp.Secret.Fields[0].Type)
+
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{Name: "Note", Type: NoteSecret, Notes: "a note", Tags: []string{"personal"}},
+	})
+	require.NoError(t, err)
+
+	resp, err := service.Secrets(ctx, &SecretsRequest{Query: "tag:work"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Secrets))
+	require.Equal(t, "Server", resp.Secrets[0].Name)
+	require.Equal(t, "apikey123", resp.Secrets[0].Fields[0].Value)
+
+	resp, err = service.Secrets(ctx, &SecretsRequest{Folder: "Work"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Secrets))
+
+	resp, err = service.Secrets(ctx, &SecretsRequest{Tags: []string{"personal"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Secrets))
+	require.Equal(t, "Note", resp.Secrets[0].Name)
+
+	// Hidden field values aren't searchable
+	resp, err = service.Secrets(ctx, &SecretsRequest{Query: "apikey"})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp.Secrets))
+
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{
+			Name:   "Invalid",
+			Type:   NoteSecret,
+			Fields: []*SecretField{{Name: "Expires", Type: DateField, Value: "tomorrow"}},
+		},
+	})
+	require.EqualError(t, err, `invalid date for field "Expires", should be YYYY-MM-DD`)
+}
+
+func TestSecretExpiring(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+
+	testAuthSetup(t, service)
+
+	now := env.clock.Now()
+	saveResp, err := service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{
+			Name:     "Server",
+			Type:     PasswordSecret,
+			Password: "serverpassword",
+			ExpireAt: tsutil.Millis(now.Add(time.Hour * 24)),
+		},
+	})
+	require.NoError(t, err)
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{
+			Name:     "Later",
+			Type:     PasswordSecret,
+			Password: "laterpassword",
+			ExpireAt: tsutil.Millis(now.Add(time.Hour * 24 * 30)),
+		},
+	})
+	require.NoError(t, err)
+	_, err = service.SecretSave(ctx, &SecretSaveRequest{
+		Secret: &Secret{
+			Name:     "Expired",
+			Type:     PasswordSecret,
+			Password: "expiredpassword",
+			ExpireAt: tsutil.Millis(now.Add(-time.Hour)),
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := service.Secrets(ctx, &SecretsRequest{SkipExpired: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Secrets))
+
+	sub, ch := service.notifier.subscribe()
+	defer service.notifier.unsubscribe(sub)
+
+	err = service.checkExpiringSecrets()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ch))
+	out := []*NotifyStreamOutput{<-ch, <-ch}
+	names := []string{out[0].SecretName, out[1].SecretName}
+	require.ElementsMatch(t, []string{"Expired", "Server"}, names)
+	for _, o := range out {
+		require.Equal(t, SecretExpiringNotification, o.Type)
+		if o.SecretName == "Server" {
+			require.Equal(t, saveResp.Secret.ID, o.SecretID)
+			require.Equal(t, saveResp.Secret.ExpireAt, o.ExpireAt)
+		}
+	}
+
+	// Only notify once
+	err = service.checkExpiringSecrets()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(ch))
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"os"
 	"sync"
 	"time"
 
@@ -9,6 +10,8 @@ import (
 	httpclient "github.com/keys-pub/keys-ext/http/client"
 	"github.com/keys-pub/keys-ext/sdb"
 	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/keys-pub/keys/dstore"
 	"github.com/keys-pub/keys/request"
 	"github.com/keys-pub/keys/tsutil"
 	"github.com/keys-pub/keys/users"
@@ -33,6 +36,13 @@ type service struct {
 	checkMtx      sync.Mutex
 	checking      bool
 	checkCancelFn func()
+
+	// otpMtx serializes OTP generation, so a HOTP counter is never reused.
+	otpMtx sync.Mutex
+
+	autoLock autoLock
+
+	notifier *notifier
 }
 
 const cdbPath = "cache.sdb"
@@ -49,7 +59,7 @@ func newService(env *Env, build Build, auth *auth, req request.Requestor, clock
 	if err != nil {
 		return nil, err
 	}
-	vlt := vault.New(vault.NewDB(path), vault.WithClock(clock))
+	vlt := vault.New(vault.NewDB(path), vault.WithClock(clock), secrets.IndexOption())
 	vlt.SetClient(client)
 
 	db := sdb.New()
@@ -57,7 +67,7 @@ func newService(env *Env, build Build, auth *auth, req request.Requestor, clock
 	scs := keys.NewSigchains(db)
 	usrs := users.New(db, scs, users.Requestor(req), users.Clock(clock))
 
-	return &service{
+	svc := &service{
 		auth:          auth,
 		build:         build,
 		env:           env,
@@ -68,7 +78,12 @@ func newService(env *Env, build Build, auth *auth, req request.Requestor, clock
 		vault:         vlt,
 		clock:         clock,
 		checkCancelFn: func() {},
-	}, nil
+		notifier:      newNotifier(),
+	}
+	auth.onAuthorized = svc.touch
+	auth.clock = clock
+	vlt.SetRotateHook(svc.rotateDB)
+	return svc, nil
 }
 
 func (s *service) Open() error {
@@ -107,27 +122,11 @@ func (s *service) unlock(ctx context.Context, req *AuthUnlockRequest) (string, e
 
 	// DB
 	if !s.db.IsOpen() {
-		logger.Infof("Opening %s...", cdbPath)
-		path, err := s.env.AppPath(cdbPath, true)
+		created, err := s.openDB(ctx)
 		if err != nil {
 			return "", err
 		}
-
-		exists, err := pathExists(path)
-		if err != nil {
-			return "", err
-		}
-		if !exists {
-			isNew = true
-		}
-
-		// Derive sdb key
-		// TODO: Check if key is wrong
-		mk := s.vault.MasterKey()
-		dbk := keys.Bytes32(keys.HKDFSHA256(mk[:], 32, nil, []byte("keys.pub/cache")))
-		if err := s.db.OpenAtPath(ctx, path, dbk); err != nil {
-			return "", err
-		}
+		isNew = created
 	}
 
 	// If database is new, we are either in a new state or from a uninstalled
@@ -140,12 +139,191 @@ func (s *service) unlock(ctx context.Context, req *AuthUnlockRequest) (string, e
 	}
 
 	s.startCheck()
+	s.startAutoLock(ctx)
 
 	s.unlocked = true
 
 	return token, nil
 }
 
+// dbKey derives the sdb key from the vault master key.
+func (s *service) dbKey() *[32]byte {
+	return dbKeyFor(s.vault.MasterKey())
+}
+
+func dbKeyFor(mk *[32]byte) *[32]byte {
+	return keys.Bytes32(keys.HKDFSHA256(mk[:], 32, nil, []byte("keys.pub/cache")))
+}
+
+// openDB opens the sdb, returning true if it was created.
+// If the key is wrong (for example, if we failed to re-key after a master key
+// rotation), the sdb is re-created, since it's a cache.
+// Requires unlockMtx.
+func (s *service) openDB(ctx context.Context) (bool, error) {
+	logger.Infof("Opening %s...", cdbPath)
+	path, err := s.env.AppPath(cdbPath, true)
+	if err != nil {
+		return false, err
+	}
+	if err := recoverDB(path); err != nil {
+		return false, err
+	}
+	exists, err := pathExists(path)
+	if err != nil {
+		return false, err
+	}
+	if err := s.db.OpenAtPath(ctx, path, s.dbKey()); err != nil {
+		return false, err
+	}
+	if !exists {
+		return true, nil
+	}
+	if checkDBKey(ctx, s.db) {
+		return false, nil
+	}
+
+	logger.Warningf("Wrong key for %s, re-creating...", cdbPath)
+	s.db.Close()
+	if err := os.RemoveAll(path); err != nil {
+		return false, err
+	}
+	if err := s.db.OpenAtPath(ctx, path, s.dbKey()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// checkDBKey returns false if the sdb key is wrong.
+// Every document is encrypted with the same key, so we only need to check the
+// first one.
+func checkDBKey(ctx context.Context, db *sdb.DB) bool {
+	if _, err := db.Documents(ctx, "", dstore.Limit(1)); err != nil {
+		logger.Debugf("Failed to read %s: %v", cdbPath, err)
+		return false
+	}
+	return true
+}
+
+// rotateDB is the vault rotate hook, which re-keys the sdb.
+// The vault calls this from Unlock when resuming a rotation, and from
+// RotateMasterKey, so we require unlockMtx in both cases.
+func (s *service) rotateDB(mk *[32]byte, nmk *[32]byte) {
+	if err := s.rekeyDB(context.TODO(), mk, nmk); err != nil {
+		// If this fails, the sdb is re-created on open (see openDB).
+		logger.Errorf("Failed to re-key %s: %v", cdbPath, err)
+	}
+}
+
+// rekeyDB re-encrypts the sdb from the key for master key mk to the key for
+// the new master key nmk.
+// The documents are copied to a new sdb (at a temporary path), which then
+// replaces the sdb, so if we are interrupted, we have the old or new sdb (see
+// recoverDB).
+// If the sdb isn't using the old key (for example, it was already re-keyed),
+// it's left as is.
+// Requires unlockMtx.
+func (s *service) rekeyDB(ctx context.Context, mk *[32]byte, nmk *[32]byte) error {
+	path, err := s.env.AppPath(cdbPath, false)
+	if err != nil {
+		return err
+	}
+	if s.db.IsOpen() {
+		s.db.Close()
+		// Re-open (with the key for the current master key) when done.
+		defer func() {
+			if _, err := s.openDB(ctx); err != nil {
+				logger.Errorf("Failed to open %s: %v", cdbPath, err)
+			}
+		}()
+	}
+
+	if err := recoverDB(path); err != nil {
+		return err
+	}
+	exists, err := pathExists(path)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	db := sdb.New()
+	db.SetClock(s.clock)
+	if err := db.OpenAtPath(ctx, path, dbKeyFor(mk)); err != nil {
+		return err
+	}
+	if !checkDBKey(ctx, db) {
+		db.Close()
+		return nil
+	}
+	docs, err := db.Documents(ctx, "")
+	db.Close()
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Re-keying %s...", cdbPath)
+	rekeyPath := path + ".rekey"
+	if err := os.RemoveAll(rekeyPath); err != nil {
+		return err
+	}
+	ndb := sdb.New()
+	ndb.SetClock(s.clock)
+	if err := ndb.OpenAtPath(ctx, rekeyPath, dbKeyFor(nmk)); err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		var values map[string]interface{}
+		if err := doc.To(&values); err != nil {
+			ndb.Close()
+			return err
+		}
+		if err := ndb.Set(ctx, doc.Path, values); err != nil {
+			ndb.Close()
+			return err
+		}
+	}
+	ndb.Close()
+
+	oldPath := path + ".old"
+	if err := os.Rename(path, oldPath); err != nil {
+		return err
+	}
+	if err := os.Rename(rekeyPath, path); err != nil {
+		return err
+	}
+	return os.RemoveAll(oldPath)
+}
+
+// recoverDB finishes (or discards) an interrupted rekeyDB.
+// The re-keyed sdb is only moved into place once complete, so if the sdb is
+// missing, we were interrupted between renames and the re-keyed sdb is
+// complete, otherwise it's discarded.
+func recoverDB(path string) error {
+	rekeyPath, oldPath := path+".rekey", path+".old"
+	exists, err := pathExists(path)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		rekeyed, err := pathExists(rekeyPath)
+		if err != nil {
+			return err
+		}
+		if rekeyed {
+			logger.Infof("Recovering re-keyed %s...", cdbPath)
+			if err := os.Rename(rekeyPath, path); err != nil {
+				return err
+			}
+		}
+	}
+	if err := os.RemoveAll(rekeyPath); err != nil {
+		return err
+	}
+	return os.RemoveAll(oldPath)
+}
+
 func (s *service) lock() {
 	s.unlockMtx.Lock()
 	defer s.unlockMtx.Unlock()
@@ -156,6 +334,7 @@ func (s *service) lock() {
 	}
 
 	s.stopCheck()
+	s.stopAutoLock()
 
 	s.auth.lock(s.vault)
 
@@ -178,6 +357,9 @@ func (s *service) tryCheck(ctx context.Context) {
 	if err := s.checkKeys(ctx); err != nil {
 		logger.Warningf("Failed to check keys: %v", err)
 	}
+	if err := s.checkExpiringSecrets(); err != nil {
+		logger.Warningf("Failed to check expiring secrets: %v", err)
+	}
 }
 
 func (s *service) startCheck() {
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// SharedCollections (RPC) lists shared collections.
+func (s *service) SharedCollections(ctx context.Context, req *SharedCollectionsRequest) (*SharedCollectionsResponse, error) {
+	cols, err := s.vault.Collections()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*SharedCollection, 0, len(cols))
+	for _, col := range cols {
+		out = append(out, sharedCollectionToRPC(col))
+	}
+	return &SharedCollectionsResponse{Collections: out}, nil
+}
+
+// SharedCollectionCreate (RPC) creates a shared collection.
+func (s *service) SharedCollectionCreate(ctx context.Context, req *SharedCollectionCreateRequest) (*SharedCollectionCreateResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.Errorf("no name specified")
+	}
+	user, err := s.lookupEdX25519Key(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
+	col, err := s.vault.CollectionCreate(ctx, name, user)
+	if err != nil {
+		return nil, err
+	}
+	return &SharedCollectionCreateResponse{Collection: sharedCollectionToRPC(col)}, nil
+}
+
+// SharedCollectionInvite (RPC) invites users to a shared collection.
+func (s *service) SharedCollectionInvite(ctx context.Context, req *SharedCollectionInviteRequest) (*SharedCollectionInviteResponse, error) {
+	cid, err := keys.ParseID(req.Collection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid collection")
+	}
+	sender, err := s.lookupEdX25519Key(ctx, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Recipients) == 0 {
+		return nil, errors.Errorf("no recipients specified")
+	}
+	rids, err := s.lookupAll(ctx, req.Recipients, &lookupOpts{Verify: true})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.vault.CollectionInvite(ctx, cid, sender, rids...); err != nil {
+		return nil, err
+	}
+	return &SharedCollectionInviteResponse{}, nil
+}
+
+// SharedCollectionInvites (RPC) lists pending invites to shared collections.
+func (s *service) SharedCollectionInvites(ctx context.Context, req *SharedCollectionInvitesRequest) (*SharedCollectionInvitesResponse, error) {
+	user, err := s.lookupEdX25519Key(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
+	invites, err := s.vault.CollectionInvites(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*SharedCollectionInvite, 0, len(invites))
+	for _, invite := range invites {
+		key, sender, err := invite.Key(user)
+		if err != nil {
+			logger.Warningf("Invalid collection invite %s: %v", invite.Vault, err)
+			continue
+		}
+		out = append(out, &SharedCollectionInvite{
+			Collection: invite.Vault.String(),
+			Name:       key.Notes,
+			Sender:     sender.String(),
+		})
+	}
+	return &SharedCollectionInvitesResponse{Invites: out}, nil
+}
+
+// SharedCollectionAccept (RPC) accepts an invite to a shared collection.
+func (s *service) SharedCollectionAccept(ctx context.Context, req *SharedCollectionAcceptRequest) (*SharedCollectionAcceptResponse, error) {
+	cid, err := keys.ParseID(req.Collection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid collection")
+	}
+	user, err := s.lookupEdX25519Key(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
+	col, err := s.vault.CollectionAccept(ctx, user, cid)
+	if err != nil {
+		return nil, err
+	}
+	cv, err := s.vault.CollectionVault(col.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := cv.Sync(ctx); err != nil {
+		return nil, err
+	}
+	return &SharedCollectionAcceptResponse{Collection: sharedCollectionToRPC(col)}, nil
+}
+
+// SharedCollectionMembers (RPC) lists members of a shared collection.
+func (s *service) SharedCollectionMembers(ctx context.Context, req *SharedCollectionMembersRequest) (*SharedCollectionMembersResponse, error) {
+	cid, err := keys.ParseID(req.Collection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid collection")
+	}
+	members, err := s.vault.CollectionMembers(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*SharedCollectionMember, 0, len(members))
+	for _, member := range members {
+		out = append(out, &SharedCollectionMember{
+			KID:    member.KID.String(),
+			Sender: member.Sender.String(),
+		})
+	}
+	return &SharedCollectionMembersResponse{Members: out}, nil
+}
+
+// SharedCollectionRemoveMember (RPC) removes a member from a shared
+// collection, rotating the collection key.
+func (s *service) SharedCollectionRemoveMember(ctx context.Context, req *SharedCollectionRemoveMemberRequest) (*SharedCollectionRemoveMemberResponse, error) {
+	cid, err := keys.ParseID(req.Collection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid collection")
+	}
+	sender, err := s.lookupEdX25519Key(ctx, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.lookup(ctx, req.Member, nil)
+	if err != nil {
+		return nil, err
+	}
+	col, err := s.vault.CollectionRemoveMember(ctx, cid, sender, member)
+	if err != nil {
+		return nil, err
+	}
+	return &SharedCollectionRemoveMemberResponse{Collection: sharedCollectionToRPC(col)}, nil
+}
+
+// SharedCollectionLeave (RPC) leaves a shared collection.
+func (s *service) SharedCollectionLeave(ctx context.Context, req *SharedCollectionLeaveRequest) (*SharedCollectionLeaveResponse, error) {
+	cid, err := keys.ParseID(req.Collection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid collection")
+	}
+	user, err := s.lookupEdX25519Key(ctx, req.User)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.vault.CollectionLeave(ctx, user, cid); err != nil {
+		return nil, err
+	}
+	return &SharedCollectionLeaveResponse{}, nil
+}
+
+// SharedCollectionSync (RPC) syncs a shared collection.
+func (s *service) SharedCollectionSync(ctx context.Context, req *SharedCollectionSyncRequest) (*SharedCollectionSyncResponse, error) {
+	cid, err := keys.ParseID(req.Collection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid collection")
+	}
+	cv, err := s.vault.CollectionVault(cid)
+	if err != nil {
+		return nil, err
+	}
+	if err := cv.Sync(ctx); err != nil {
+		return nil, err
+	}
+	return &SharedCollectionSyncResponse{}, nil
+}
+
+// secretsVault returns the vault for secrets, either our vault or a shared
+// collection (if specified).
+func (s *service) secretsVault(collection string) (*vault.Vault, error) {
+	if collection == "" {
+		return s.vault, nil
+	}
+	cid, err := keys.ParseID(collection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid collection")
+	}
+	return s.vault.CollectionVault(cid)
+}
+
+func (s *service) lookupEdX25519Key(ctx context.Context, user string) (*keys.EdX25519Key, error) {
+	kid, err := s.lookup(ctx, user, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.edx25519Key(kid)
+}
+
+func sharedCollectionToRPC(col *vault.Collection) *SharedCollection {
+	return &SharedCollection{
+		ID:        col.ID.String(),
+		Name:      col.Name,
+		CreatedAt: tsutil.Millis(col.CreatedAt),
+	}
+}
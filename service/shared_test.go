@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedCollection(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+	testUserSetupGithub(t, env, aliceService, alice, "alice")
+	testPush(t, aliceService, alice)
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+	testUserSetupGithub(t, env, bobService, bob, "bob")
+	testPush(t, bobService, bob)
+	testPull(t, aliceService, bob.ID())
+
+	// Alice creates a collection
+	create, err := aliceService.SharedCollectionCreate(ctx, &SharedCollectionCreateRequest{
+		Name: "Deploy",
+		User: alice.ID().String(),
+	})
+	require.NoError(t, err)
+	cid := create.Collection.ID
+
+	save, err := aliceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret:     &Secret{Name: "Token", Type: PasswordSecret, Password: "secret1"},
+		Collection: cid,
+	})
+	require.NoError(t, err)
+	_, err = aliceService.SharedCollectionSync(ctx, &SharedCollectionSyncRequest{Collection: cid})
+	require.NoError(t, err)
+
+	// Not in alice's vault
+	list, err := aliceService.Secrets(ctx, &SecretsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list.Secrets))
+
+	// History, restore
+	_, err = aliceService.SecretSave(ctx, &SecretSaveRequest{
+		Secret:     &Secret{ID: save.Secret.ID, Name: "Token", Type: PasswordSecret, Password: "secret2"},
+		Collection: cid,
+	})
+	require.NoError(t, err)
+	_, err = aliceService.SecretHistory(ctx, &SecretHistoryRequest{ID: save.Secret.ID})
+	require.EqualError(t, err, save.Secret.ID+" not found")
+	history, err := aliceService.SecretHistory(ctx, &SecretHistoryRequest{ID: save.Secret.ID, Collection: cid})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history.Versions))
+	restored, err := aliceService.SecretRestore(ctx, &SecretRestoreRequest{ID: save.Secret.ID, Version: history.Versions[0].Version, Collection: cid})
+	require.NoError(t, err)
+	require.Equal(t, "secret1", restored.Secret.Password)
+	list, err = aliceService.Secrets(ctx, &SecretsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list.Secrets))
+	_, err = aliceService.SharedCollectionSync(ctx, &SharedCollectionSyncRequest{Collection: cid})
+	require.NoError(t, err)
+
+	// Alice invites bob
+	_, err = aliceService.SharedCollectionInvite(ctx, &SharedCollectionInviteRequest{
+		Collection: cid,
+		Sender:     alice.ID().String(),
+		Recipients: []string{"bob@github"},
+	})
+	require.NoError(t, err)
+
+	invites, err := bobService.SharedCollectionInvites(ctx, &SharedCollectionInvitesRequest{User: bob.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(invites.Invites))
+	require.Equal(t, "Deploy", invites.Invites[0].Name)
+	require.Equal(t, alice.ID().String(), invites.Invites[0].Sender)
+
+	_, err = bobService.SharedCollectionAccept(ctx, &SharedCollectionAcceptRequest{
+		Collection: cid,
+		User:       bob.ID().String(),
+	})
+	require.NoError(t, err)
+	secret, err := bobService.Secret(ctx, &SecretRequest{ID: save.Secret.ID, Collection: cid})
+	require.NoError(t, err)
+	require.Equal(t, "secret1", secret.Secret.Password)
+
+	members, err := aliceService.SharedCollectionMembers(ctx, &SharedCollectionMembersRequest{Collection: cid})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(members.Members))
+
+	// Alice removes bob
+	removed, err := aliceService.SharedCollectionRemoveMember(ctx, &SharedCollectionRemoveMemberRequest{
+		Collection: cid,
+		Sender:     alice.ID().String(),
+		Member:     bob.ID().String(),
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, cid, removed.Collection.ID)
+	members, err = aliceService.SharedCollectionMembers(ctx, &SharedCollectionMembersRequest{Collection: removed.Collection.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(members.Members))
+
+	_, err = bobService.SharedCollectionSync(ctx, &SharedCollectionSyncRequest{Collection: cid})
+	require.Error(t, err)
+}
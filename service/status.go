@@ -41,6 +41,9 @@ func (s *service) RuntimeStatus(ctx context.Context, req *RuntimeStatusRequest)
 		Sync:       sync,
 		FIDO2:      s.auth.fas != nil,
 	}
+	if remaining := s.autoLockRemaining(); remaining > 0 {
+		resp.LockIn = int64(remaining / time.Millisecond)
+	}
 	logger.Infof("Runtime status, %s", resp.String())
 	return &resp, nil
 }
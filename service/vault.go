@@ -3,9 +3,13 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"net/url"
+	"os"
 	"time"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/secrets"
 	"github.com/keys-pub/keys/encoding"
 	"github.com/keys-pub/keys/tsutil"
 	"github.com/pkg/errors"
@@ -14,6 +18,15 @@ import (
 // VaultSync (RPC) ...
 func (s *service) VaultSync(ctx context.Context, req *VaultSyncRequest) (*VaultSyncResponse, error) {
 	logger.Infof("Vault sync...")
+	if req.URL != "" {
+		u, err := url.Parse(req.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid url")
+		}
+		if err := s.vault.SetRemoteURL(u); err != nil {
+			return nil, err
+		}
+	}
 	if err := s.vault.Sync(ctx); err != nil {
 		return nil, errors.Wrapf(err, "failed to sync")
 	}
@@ -23,6 +36,56 @@ func (s *service) VaultSync(ctx context.Context, req *VaultSyncRequest) (*VaultS
 	return &VaultSyncResponse{}, nil
 }
 
+// VaultSyncProgress (RPC) syncs the vault, streaming progress as events are
+// pulled from the remote.
+func (s *service) VaultSyncProgress(req *VaultSyncProgressRequest, srv Keys_VaultSyncProgressServer) error {
+	ctx := srv.Context()
+
+	topic := encoding.MustEncode(keys.RandBytes(16), encoding.Base62)
+	ch := s.vault.Subscribe(topic)
+	defer s.vault.Unsubscribe(topic)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.VaultSync(ctx, &VaultSyncRequest{URL: req.URL})
+		errCh <- err
+	}()
+
+	send := func(event vault.Event) error {
+		e, ok := event.(vault.PullProgressEvent)
+		if !ok {
+			return nil
+		}
+		return srv.Send(&VaultSyncProgressOutput{
+			Events:    int64(e.Events),
+			Bytes:     e.Bytes,
+			Index:     e.Index,
+			Max:       e.Max,
+			Remaining: e.Remaining.Milliseconds(),
+		})
+	}
+
+	for {
+		select {
+		case event := <-ch:
+			if err := send(event); err != nil {
+				return err
+			}
+		case err := <-errCh:
+			if err != nil {
+				return err
+			}
+			// Send any remaining progress (notified before sync returned).
+			for len(ch) > 0 {
+				if err := send(<-ch); err != nil {
+					return err
+				}
+			}
+			return srv.Send(&VaultSyncProgressOutput{Done: true})
+		}
+	}
+}
+
 // VaultAuth (RPC) creates an auth phrase for the vault.
 func (s *service) VaultAuth(ctx context.Context, req *VaultAuthRequest) (*VaultAuthResponse, error) {
 	remote := s.vault.Remote()
@@ -92,8 +155,286 @@ func (s *service) VaultStatus(ctx context.Context, req *VaultStatusRequest) (*Va
 	if status == nil {
 		return &VaultStatusResponse{}, nil
 	}
+	remotes, err := s.vault.RemotesStatus()
+	if err != nil {
+		return nil, err
+	}
 	return &VaultStatusResponse{
 		KID:      status.KID.String(),
 		SyncedAt: tsutil.Millis(status.SyncedAt),
+		URL:      s.vaultURL(status.URL),
+		Remotes:  s.vaultRemotesToRPC(remotes),
+	}, nil
+}
+
+// VaultRemoteAdd (RPC) adds an additional remote to sync to.
+func (s *service) VaultRemoteAdd(ctx context.Context, req *VaultRemoteAddRequest) (*VaultRemoteAddResponse, error) {
+	if req.URL == "" {
+		return nil, errors.Errorf("no url specified")
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid url")
+	}
+	remote, err := s.vault.AddRemote(req.Name, u)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultRemoteAddResponse{
+		Remote: &VaultRemote{
+			Name: remote.Name,
+			URL:  s.vaultURL(remote.URL),
+			KID:  remote.Key.ID().String(),
+		},
+	}, nil
+}
+
+// VaultRemoteRemove (RPC) removes an additional remote.
+func (s *service) VaultRemoteRemove(ctx context.Context, req *VaultRemoteRemoveRequest) (*VaultRemoteRemoveResponse, error) {
+	ok, err := s.vault.RemoveRemote(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, keys.NewErrNotFound(req.Name)
+	}
+	return &VaultRemoteRemoveResponse{}, nil
+}
+
+// vaultURL returns the URL string, or the service server URL if nil.
+func (s *service) vaultURL(u *url.URL) string {
+	if u == nil {
+		return s.client.URL().String()
+	}
+	return u.String()
+}
+
+func (s *service) vaultRemotesToRPC(remotes []*vault.RemoteStatus) []*VaultRemote {
+	out := make([]*VaultRemote, 0, len(remotes))
+	for _, r := range remotes {
+		out = append(out, &VaultRemote{
+			Name:        r.Name,
+			URL:         s.vaultURL(r.URL),
+			KID:         r.KID.String(),
+			PullIndex:   r.PullIndex,
+			PushedIndex: r.PushedIndex,
+			SyncedAt:    tsutil.Millis(r.SyncedAt),
+			Error:       r.Err,
+		})
+	}
+	return out
+}
+
+// VaultConflicts (RPC) lists unresolved conflicts.
+func (s *service) VaultConflicts(ctx context.Context, req *VaultConflictsRequest) (*VaultConflictsResponse, error) {
+	conflicts, err := s.vault.Conflicts()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*VaultConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		c, err := vaultConflictToRPC(conflict)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return &VaultConflictsResponse{
+		Conflicts: out,
+	}, nil
+}
+
+// VaultResolve (RPC) resolves a conflict.
+func (s *service) VaultResolve(ctx context.Context, req *VaultResolveRequest) (*VaultResolveResponse, error) {
+	if req.ID == "" {
+		return nil, errors.Errorf("id not specified")
+	}
+	resolver, err := conflictResolverFromRPC(req.Resolution)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.vault.Resolve(req.ID, resolver); err != nil {
+		return nil, err
+	}
+	return &VaultResolveResponse{}, nil
+}
+
+func conflictResolverFromRPC(r ConflictResolution) (vault.ConflictResolver, error) {
+	switch r {
+	case LastWriterWins:
+		return vault.LastWriterWins, nil
+	case KeepBoth:
+		return secrets.KeepBoth, nil
+	case Merge:
+		return secrets.Merge, nil
+	default:
+		return nil, errors.Errorf("unknown conflict resolution %v", r)
+	}
+}
+
+func vaultConflictToRPC(conflict *vault.Conflict) (*VaultConflict, error) {
+	base, err := vaultItemToRPC(conflict.Base)
+	if err != nil {
+		return nil, err
+	}
+	current, err := vaultItemToRPC(conflict.Current)
+	if err != nil {
+		return nil, err
+	}
+	incoming, err := vaultItemToRPC(conflict.Incoming)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultConflict{
+		ID:       conflict.ID,
+		Base:     base,
+		Current:  current,
+		Incoming: incoming,
 	}, nil
 }
+
+func vaultItemToRPC(item *vault.Item) (*VaultItem, error) {
+	if item == nil {
+		return nil, nil
+	}
+	out := &VaultItem{
+		ID:        item.ID,
+		Type:      item.Type,
+		Version:   item.Version,
+		Deleted:   len(item.Data) == 0,
+		CreatedAt: tsutil.Millis(item.CreatedAt),
+	}
+	if !out.Deleted && secrets.IsSecret(item) {
+		secret, err := secrets.FromItem(item)
+		if err != nil {
+			return nil, err
+		}
+		out.Secret = secretToRPC(secret)
+	}
+	return out, nil
+}
+
+// VaultEvents (RPC) streams vault events.
+func (s *service) VaultEvents(req *VaultEventsRequest, srv Keys_VaultEventsServer) error {
+	ctx := srv.Context()
+
+	topic := encoding.MustEncode(keys.RandBytes(16), encoding.Base62)
+	ch := s.vault.Subscribe(topic)
+	defer s.vault.Unsubscribe(topic)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			out := vaultEventToRPC(event)
+			if out == nil {
+				continue
+			}
+			if err := srv.Send(out); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func vaultEventToRPC(event vault.Event) *VaultEvent {
+	switch e := event.(type) {
+	case vault.UnlockEvent:
+		return &VaultEvent{Type: UnlockVaultEvent}
+	case vault.LockEvent:
+		return &VaultEvent{Type: LockVaultEvent}
+	case vault.SetEvent:
+		return &VaultEvent{Type: SetVaultEvent, ID: e.ID}
+	case vault.DeleteEvent:
+		return &VaultEvent{Type: DeleteVaultEvent, ID: e.ID}
+	case vault.SyncStartEvent:
+		return &VaultEvent{Type: SyncStartVaultEvent}
+	case vault.SyncFinishEvent:
+		out := &VaultEvent{Type: SyncFinishVaultEvent}
+		if e.Err != nil {
+			out.Error = e.Err.Error()
+		}
+		return out
+	case vault.PullEvent:
+		return &VaultEvent{Type: PullVaultEvent, ID: e.ID, Deleted: e.Deleted}
+	default:
+		return nil
+	}
+}
+
+// VaultBackup (RPC) writes a vault backup archive.
+func (s *service) VaultBackup(ctx context.Context, req *VaultBackupRequest) (*VaultBackupResponse, error) {
+	if req.Path == "" {
+		return nil, errors.Errorf("no path specified")
+	}
+	f, err := os.OpenFile(req.Path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, filePerms)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.vault.Backup(f); err != nil {
+		_ = f.Close()
+		_ = os.Remove(req.Path)
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return &VaultBackupResponse{Path: req.Path}, nil
+}
+
+// VaultRestore (RPC) restores a vault backup archive into an empty vault.
+func (s *service) VaultRestore(ctx context.Context, req *VaultRestoreRequest) (*VaultRestoreResponse, error) {
+	if req.Path == "" {
+		return nil, errors.Errorf("no path specified")
+	}
+	f, err := os.Open(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	backup, err := vault.ReadBackup(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var key *[32]byte
+	switch req.Type {
+	case PasswordAuth:
+		k, err := keys.KeyForPassword(req.Secret, backup.Salt)
+		if err != nil {
+			return nil, err
+		}
+		key = k
+	case PaperKeyAuth:
+		k, err := encoding.PhraseToBytes(req.Secret, true)
+		if err != nil {
+			return nil, err
+		}
+		key = k
+	case KeyFileAuth:
+		k, err := keyFileSecretToKey(req.Secret)
+		if err != nil {
+			return nil, err
+		}
+		key = k
+	default:
+		return nil, errors.Errorf("unsupported auth type for restore")
+	}
+
+	opts := []vault.CopyOption{}
+	if req.DryRun {
+		opts = append(opts, vault.DryRun())
+	}
+	paths, err := s.vault.RestoreBackup(backup, key, opts...)
+	if err != nil {
+		if errors.Cause(err) == vault.ErrInvalidAuth {
+			return nil, errors.Errorf("invalid auth")
+		}
+		return nil, err
+	}
+	return &VaultRestoreResponse{Paths: paths}, nil
+}
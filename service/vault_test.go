@@ -104,3 +104,82 @@ func TestVault(t *testing.T) {
 	require.NotNil(t, status)
 	require.NotEqual(t, rkid, status.KID)
 }
+
+func TestVaultRemotes(t *testing.T) {
+	var err error
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	backupEnv := newTestServerEnv(t, env)
+	defer backupEnv.closeFn()
+
+	_, err = service.VaultRemoteAdd(ctx, &VaultRemoteAddRequest{Name: "backup"})
+	require.EqualError(t, err, "no url specified")
+	add, err := service.VaultRemoteAdd(ctx, &VaultRemoteAddRequest{Name: "backup", URL: backupEnv.url})
+	require.NoError(t, err)
+	require.Equal(t, "backup", add.Remote.Name)
+	require.Equal(t, backupEnv.url, add.Remote.URL)
+
+	_, err = service.VaultSync(ctx, &VaultSyncRequest{})
+	require.NoError(t, err)
+
+	status, err := service.VaultStatus(ctx, &VaultStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(status.Remotes))
+	require.Equal(t, "", status.Remotes[0].Name)
+	require.Equal(t, status.KID, status.Remotes[0].KID)
+	require.Equal(t, "backup", status.Remotes[1].Name)
+	require.Equal(t, add.Remote.KID, status.Remotes[1].KID)
+	require.Equal(t, "", status.Remotes[1].Error)
+	require.NotEmpty(t, status.Remotes[1].SyncedAt)
+
+	_, err = service.VaultRemoteRemove(ctx, &VaultRemoteRemoveRequest{Name: "backup"})
+	require.NoError(t, err)
+	_, err = service.VaultRemoteRemove(ctx, &VaultRemoteRemoveRequest{Name: "backup"})
+	require.EqualError(t, err, "backup not found")
+
+	status, err = service.VaultStatus(ctx, &VaultStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(status.Remotes))
+}
+
+func TestVaultSyncProgress(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	client, closeClFn := newTestRPCClient(t, service, env, "", nil)
+	defer closeClFn()
+	ctx := context.TODO()
+
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	syncClient, err := client.KeysClient().VaultSyncProgress(ctx, &VaultSyncProgressRequest{})
+	require.NoError(t, err)
+	outs := []*VaultSyncProgressOutput{}
+	for {
+		out, err := syncClient.Recv()
+		require.NoError(t, err)
+		outs = append(outs, out)
+		if out.Done {
+			break
+		}
+	}
+	require.True(t, len(outs) > 1)
+	last := outs[len(outs)-2]
+	require.True(t, last.Events > 0)
+	require.True(t, last.Bytes > 0)
+	require.Equal(t, last.Max, last.Index)
+
+	status, err := service.VaultStatus(ctx, &VaultStatusRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, status.KID)
+}
+
+func TestVaultSyncProgressString(t *testing.T) {
+	require.Equal(t, "Pulled 10 events (512B), 10/10", vaultSyncProgressString(&VaultSyncProgressOutput{Events: 10, Bytes: 512, Index: 10, Max: 10}))
+	require.Equal(t, "Pulled 500 events (1.5MiB), 500/1000, about 3s remaining", vaultSyncProgressString(&VaultSyncProgressOutput{Events: 500, Bytes: 1572864, Index: 500, Max: 1000, Remaining: 2600}))
+}
@@ -0,0 +1,280 @@
+// Package attachments stores large binary data in the vault.
+package attachments
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/pkg/errors"
+)
+
+// ChunkSize is the size of attachment chunks.
+// Each chunk is stored as a vault item, so it must be less than the max item
+// size.
+const ChunkSize = 24 * 1024
+
+// Attachment is binary data stored in the vault.
+//
+// The attachment is a manifest item, with the data split across chunk items,
+// so attachments aren't limited by the vault item size, and are synced
+// (through the push and pull logs) like any other item.
+//
+// Chunk items are "{id}.{gen}.{index}", where the generation (gen) is new for
+// each Put, so replacing an attachment never changes the chunks of the
+// current manifest.
+type Attachment struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// Size of data.
+	Size int64 `json:"size"`
+	// Chunks is the number of chunk items.
+	Chunks int `json:"chunks"`
+	// Gen is the generation of the chunk items.
+	Gen string `json:"gen"`
+	// Digest (SHA256) of the data.
+	Digest []byte `json:"digest"`
+
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// ErrInvalidDigest if attachment data doesn't match its digest.
+var ErrInvalidDigest = errors.New("attachment digest mismatch")
+
+const (
+	attachmentItemType = "attachment"
+	chunkItemType      = "attachment-chunk"
+)
+
+func newAttachmentID() string {
+	return encoding.MustEncode(keys.RandBytes(32), encoding.Base62)
+}
+
+func chunkID(id string, gen string, index int) string {
+	return fmt.Sprintf("%s.%s.%d", id, gen, index)
+}
+
+// Put saves an attachment from a reader.
+// If id is empty, a new attachment is created, otherwise an existing
+// attachment (with that id) is replaced.
+// The chunks are saved (as a new generation) before the manifest, so an
+// attachment is never visible with missing chunks, and if Put fails, an
+// existing attachment is unchanged.
+func Put(v *vault.Vault, id string, name string, r io.Reader) (*Attachment, error) {
+	if id == "" {
+		id = newAttachmentID()
+	}
+	existing, err := Get(v, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := v.Now()
+	att := &Attachment{
+		ID:        id,
+		Name:      name,
+		Gen:       encoding.MustEncode(keys.RandBytes(8), encoding.Base62),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if existing != nil {
+		att.CreatedAt = existing.CreatedAt
+	}
+
+	if err := putChunks(v, att, r, now); err != nil {
+		// Remove the chunks we saved (best effort), the existing attachment is
+		// unchanged.
+		_ = deleteChunks(v, att)
+		return nil, err
+	}
+
+	if err := setAttachment(v, att); err != nil {
+		return nil, err
+	}
+
+	// Remove chunks from the previous version
+	if existing != nil {
+		if err := deleteChunks(v, existing); err != nil {
+			return nil, err
+		}
+	}
+	return att, nil
+}
+
+// putChunks saves chunks from the reader, and sets the attachment size, chunks
+// and digest.
+func putChunks(v *vault.Vault, att *Attachment, r io.Reader, now time.Time) error {
+	hash := sha256.New()
+	buf := make([]byte, ChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			b := make([]byte, n)
+			copy(b, buf[:n])
+			if err := v.Set(vault.NewItem(chunkID(att.ID, att.Gen, att.Chunks), b, chunkItemType, now)); err != nil {
+				return err
+			}
+			_, _ = hash.Write(b)
+			att.Chunks++
+			att.Size += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	att.Digest = hash.Sum(nil)
+	return nil
+}
+
+func deleteChunks(v *vault.Vault, att *Attachment) error {
+	for i := 0; i < att.Chunks; i++ {
+		if _, err := v.Delete(chunkID(att.ID, att.Gen, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get an attachment (manifest).
+// Returns nil if not found.
+func Get(v *vault.Vault, id string) (*Attachment, error) {
+	item, err := v.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, nil
+	}
+	return asAttachment(item)
+}
+
+// Read attachment data into a writer.
+// The chunks are checked against the attachment digest before any data is
+// written, so a partially synced or corrupted attachment returns
+// ErrInvalidDigest (or a missing chunk error) and writes nothing.
+func Read(v *vault.Vault, id string, w io.Writer) (*Attachment, error) {
+	att, err := Get(v, id)
+	if err != nil {
+		return nil, err
+	}
+	if att == nil {
+		return nil, keys.NewErrNotFound(id)
+	}
+
+	hash := sha256.New()
+	if err := readChunks(v, att, hash); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hash.Sum(nil), att.Digest) {
+		return nil, ErrInvalidDigest
+	}
+
+	if err := readChunks(v, att, w); err != nil {
+		return nil, err
+	}
+	return att, nil
+}
+
+func readChunks(v *vault.Vault, att *Attachment, w io.Writer) error {
+	var size int64
+	for i := 0; i < att.Chunks; i++ {
+		item, err := v.Get(chunkID(att.ID, att.Gen, i))
+		if err != nil {
+			return err
+		}
+		if item == nil || item.Type != chunkItemType {
+			return errors.Errorf("missing attachment chunk %d", i)
+		}
+		n, err := w.Write(item.Data)
+		if err != nil {
+			return err
+		}
+		size += int64(n)
+	}
+	if size != att.Size {
+		return ErrInvalidDigest
+	}
+	return nil
+}
+
+// Delete an attachment and its chunks.
+// Returns false if not found.
+func Delete(v *vault.Vault, id string) (bool, error) {
+	att, err := Get(v, id)
+	if err != nil {
+		return false, err
+	}
+	if att == nil {
+		return false, nil
+	}
+	if _, err := v.Delete(id); err != nil {
+		return false, err
+	}
+	if err := deleteChunks(v, att); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// List attachments.
+func List(v *vault.Vault) ([]*Attachment, error) {
+	items, err := v.Items()
+	if err != nil {
+		return nil, err
+	}
+	out := []*Attachment{}
+	for _, item := range items {
+		if item.Type != attachmentItemType {
+			continue
+		}
+		att, err := asAttachment(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, att)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name == out[j].Name {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+// IsAttachment returns true if vault item is an attachment (manifest or
+// chunk).
+func IsAttachment(item *vault.Item) bool {
+	return item.Type == attachmentItemType || item.Type == chunkItemType
+}
+
+func setAttachment(v *vault.Vault, att *Attachment) error {
+	b, err := json.Marshal(att)
+	if err != nil {
+		return err
+	}
+	return v.Set(vault.NewItem(att.ID, b, attachmentItemType, att.CreatedAt))
+}
+
+func asAttachment(item *vault.Item) (*Attachment, error) {
+	if item.Type != attachmentItemType {
+		return nil, errors.Errorf("item type %s != %s", item.Type, attachmentItemType)
+	}
+	var att Attachment
+	if err := json.Unmarshal(item.Data, &att); err != nil {
+		return nil, err
+	}
+	return &att, nil
+}
@@ -0,0 +1,124 @@
+package attachments_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/attachments"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachments(t *testing.T) {
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn()
+
+	data := keys.RandBytes(attachments.ChunkSize*3 + 100)
+	att, err := attachments.Put(vlt, "", "test.bin", bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, "test.bin", att.Name)
+	require.Equal(t, int64(len(data)), att.Size)
+	require.Equal(t, 4, att.Chunks)
+
+	var out bytes.Buffer
+	_, err = attachments.Read(vlt, att.ID, &out)
+	require.NoError(t, err)
+	require.Equal(t, data, out.Bytes())
+
+	list, err := attachments.List(vlt)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, att.ID, list[0].ID)
+
+	// Replace (smaller)
+	data2 := []byte("small")
+	att2, err := attachments.Put(vlt, att.ID, "test.txt", bytes.NewReader(data2))
+	require.NoError(t, err)
+	require.Equal(t, 1, att2.Chunks)
+	require.Equal(t, att.CreatedAt, att2.CreatedAt)
+	items, err := vlt.Items()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+
+	out.Reset()
+	_, err = attachments.Read(vlt, att.ID, &out)
+	require.NoError(t, err)
+	require.Equal(t, data2, out.Bytes())
+
+	// Corrupt chunk
+	chunk := chunkItem(t, vlt)
+	err = vlt.Set(vault.NewItem(chunk.ID, []byte("smell"), chunk.Type, time.Now()))
+	require.NoError(t, err)
+	out.Reset()
+	_, err = attachments.Read(vlt, att.ID, &out)
+	require.Equal(t, attachments.ErrInvalidDigest, err)
+	require.Equal(t, 0, out.Len())
+
+	// Missing chunk
+	_, err = vlt.Delete(chunk.ID)
+	require.NoError(t, err)
+	_, err = attachments.Read(vlt, att.ID, &out)
+	require.EqualError(t, err, "missing attachment chunk 0")
+
+	ok, err := attachments.Delete(vlt, att.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	items, err = vlt.Items()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(items))
+
+	// Empty
+	att3, err := attachments.Put(vlt, "", "empty", bytes.NewReader([]byte{}))
+	require.NoError(t, err)
+	require.Equal(t, 0, att3.Chunks)
+	out.Reset()
+	_, err = attachments.Read(vlt, att3.ID, &out)
+	require.NoError(t, err)
+	require.Equal(t, 0, out.Len())
+}
+
+func TestAttachmentsPutFailed(t *testing.T) {
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn()
+
+	data := keys.RandBytes(attachments.ChunkSize*2 + 100)
+	att, err := attachments.Put(vlt, "", "test.bin", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	// Replace fails after the first chunk
+	r := io.MultiReader(bytes.NewReader(keys.RandBytes(attachments.ChunkSize+10)), errReader{})
+	_, err = attachments.Put(vlt, att.ID, "test2.bin", r)
+	require.EqualError(t, err, "aborted")
+
+	// Existing attachment is unchanged
+	var out bytes.Buffer
+	existing, err := attachments.Read(vlt, att.ID, &out)
+	require.NoError(t, err)
+	require.Equal(t, "test.bin", existing.Name)
+	require.Equal(t, data, out.Bytes())
+	items, err := vlt.Items()
+	require.NoError(t, err)
+	require.Equal(t, 4, len(items))
+}
+
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) {
+	return 0, errors.Errorf("aborted")
+}
+
+func chunkItem(t *testing.T, vlt *vault.Vault) *vault.Item {
+	items, err := vlt.Items()
+	require.NoError(t, err)
+	for _, item := range items {
+		if item.Type == "attachment-chunk" {
+			return item
+		}
+	}
+	t.Fatal("no chunk")
+	return nil
+}
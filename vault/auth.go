@@ -47,6 +47,8 @@ const (
 	PasswordAuth AuthType = "password"
 	// FIDO2HMACSecretAuth ...
 	FIDO2HMACSecretAuth AuthType = "fido2-hmac-secret" // #nosec
+	// KeyFileAuth ...
+	KeyFileAuth AuthType = "key-file"
 )
 
 // Status for vault.
@@ -102,25 +104,46 @@ func (v *Vault) Setup(key *[32]byte, provision *Provision) error {
 		return err
 	}
 	logger.Infof("Vault auth setup with %s", provision.ID)
+	v.authLog(AuthLogSetup, provision)
 	return nil
 }
 
 // Unlock with auth.
 // Returns provision used to unlock.
+// After repeated failed unlocks, unlocking is throttled (with exponential
+// backoff), returning UnlockThrottledError.
 func (v *Vault) Unlock(key *[32]byte) (*Provision, error) {
 	// TODO: This can be called while already unlocked, which should be ok,
 	// but maybe be more explicit about it?
 	logger.Infof("Unlocking...")
+	if err := v.checkUnlockThrottle(); err != nil {
+		if _, ok := err.(UnlockThrottledError); ok {
+			logger.Infof("Unlock throttled")
+			v.authLog(AuthLogUnlockThrottled, nil)
+		}
+		return nil, err
+	}
 	id, mk, err := v.authUnlock(key)
 	if err != nil {
 		return nil, err
 	}
 	if mk == nil {
 		logger.Infof("Unlock failed")
+		if err := v.unlockFailed(); err != nil {
+			return nil, err
+		}
+		v.authLog(AuthLogUnlockFailed, nil)
 		return nil, ErrInvalidAuth
 	}
+	if err := v.unlockSucceeded(); err != nil {
+		return nil, err
+	}
 
 	logger.Infof("Unlocked with %s", id)
+	mk, err = v.checkRotate(mk)
+	if err != nil {
+		return nil, err
+	}
 	if err := v.setMasterKey(mk); err != nil {
 		return nil, err
 	}
@@ -133,13 +156,22 @@ func (v *Vault) Unlock(key *[32]byte) (*Provision, error) {
 		provision = &Provision{ID: id}
 	}
 
+	v.authLog(AuthLogUnlock, provision)
+	v.subs.notify(UnlockEvent{Provision: provision})
+
 	return provision, nil
 }
 
 // Lock the vault.
 func (v *Vault) Lock() {
+	if v.mk != nil {
+		v.authLog(AuthLogLock, nil)
+	}
 	v.mk = nil
 	v.remote = nil
+	v.clearIndexTerms()
+	v.closeCollections()
+	v.subs.notify(LockEvent{})
 }
 
 // authSetup creates master key and encrypts it with the auth key.
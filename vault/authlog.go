@@ -0,0 +1,201 @@
+package vault
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/keys-pub/keys/dstore"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+// Auth log (audit).
+//
+// The auth log is an append-only record of auth events, stored unencrypted
+// (since failed unlocks are recorded while locked):
+//
+//   /audit/{index}        AuthLogEntry (msgpack)
+//   /config/audit         Last auth log index
+//
+// Unlock throttling state is also stored in the vault config, so it persists
+// across restarts:
+//
+//   /config/unlock-failures   Number of consecutive failed unlocks
+//   /config/unlock-failed     Time of the last failed unlock
+
+// AuthLogType is the type of auth log entry.
+type AuthLogType string
+
+const (
+	// AuthLogSetup when auth is setup.
+	AuthLogSetup AuthLogType = "setup"
+	// AuthLogUnlock when unlocked.
+	AuthLogUnlock AuthLogType = "unlock"
+	// AuthLogUnlockFailed when an unlock failed (invalid auth).
+	AuthLogUnlockFailed AuthLogType = "unlock-failed"
+	// AuthLogUnlockThrottled when an unlock was attempted while throttled.
+	AuthLogUnlockThrottled AuthLogType = "unlock-throttled"
+	// AuthLogLock when locked.
+	AuthLogLock AuthLogType = "lock"
+	// AuthLogProvision when an auth is provisioned.
+	AuthLogProvision AuthLogType = "provision"
+	// AuthLogDeprovision when an auth is deprovisioned.
+	AuthLogDeprovision AuthLogType = "deprovision"
+	// AuthLogToken when a (client) token is issued.
+	AuthLogToken AuthLogType = "token"
+)
+
+// AuthLogEntry is an entry in the auth log.
+type AuthLogEntry struct {
+	Index int64       `msgpack:"-"`
+	Type  AuthLogType `msgpack:"type"`
+	// Provision ID, if known.
+	Provision string `msgpack:"pid,omitempty"`
+	// AuthType, if known.
+	AuthType AuthType `msgpack:"atype,omitempty"`
+	// Client name (for tokens).
+	Client    string    `msgpack:"client,omitempty"`
+	Timestamp time.Time `msgpack:"ts"`
+}
+
+// AuthLogAdd adds an entry to the auth log.
+// The timestamp is set (from the vault clock) if not specified.
+// Doesn't require Unlock().
+func (v *Vault) AuthLogAdd(entry *AuthLogEntry) error {
+	v.authLogMtx.Lock()
+	defer v.authLogMtx.Unlock()
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = v.clock.Now()
+	}
+	index, err := v.getInt64("/config/audit")
+	if err != nil {
+		return err
+	}
+	index++
+	b, err := msgpack.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := v.store.Set(dstore.Path("audit", pad(index)), b); err != nil {
+		return err
+	}
+	if err := v.setInt64("/config/audit", index); err != nil {
+		return err
+	}
+	entry.Index = index
+	return nil
+}
+
+// authLog adds to the auth log, logging (instead of returning) an error, for
+// places we don't want the auth log to fail the operation.
+func (v *Vault) authLog(typ AuthLogType, provision *Provision) {
+	entry := &AuthLogEntry{Type: typ}
+	if provision != nil {
+		entry.Provision = provision.ID
+		entry.AuthType = provision.Type
+	}
+	if err := v.AuthLogAdd(entry); err != nil {
+		logger.Warningf("Failed to add to auth log: %v", err)
+	}
+}
+
+// AuthLog returns auth log entries, most recent first.
+// If limit is > 0, at most limit entries are returned.
+// Doesn't require Unlock().
+func (v *Vault) AuthLog(limit int) ([]*AuthLogEntry, error) {
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("audit")})
+	if err != nil {
+		return nil, err
+	}
+	out := []*AuthLogEntry{}
+	for i := len(entries) - 1; i >= 0; i-- {
+		if limit > 0 && len(out) >= limit {
+			break
+		}
+		var entry AuthLogEntry
+		if err := msgpack.Unmarshal(entries[i].Data, &entry); err != nil {
+			return nil, err
+		}
+		index, err := unpad(dstore.PathLast(entries[i].Path))
+		if err != nil {
+			return nil, err
+		}
+		entry.Index = index
+		out = append(out, &entry)
+	}
+	return out, nil
+}
+
+// UnlockThrottledError is returned from Unlock if there were too many
+// failed unlocks.
+type UnlockThrottledError struct {
+	Wait time.Duration
+}
+
+func (e UnlockThrottledError) Error() string {
+	return fmt.Sprintf("too many failed unlock attempts, try again in %s", e.Wait)
+}
+
+const (
+	// unlockFailuresAllowed is the number of failed unlocks before throttling.
+	unlockFailuresAllowed = 3
+	// unlockBackoff is the initial throttling delay (doubled for each failure).
+	unlockBackoff = time.Second
+	// unlockBackoffMax is the maximum throttling delay.
+	unlockBackoffMax = time.Hour
+)
+
+// unlockDelay is the time to wait after the number of failed unlocks.
+func unlockDelay(failures int64) time.Duration {
+	if failures < unlockFailuresAllowed {
+		return 0
+	}
+	delay := unlockBackoff
+	for i := int64(unlockFailuresAllowed); i < failures; i++ {
+		delay *= 2
+		if delay >= unlockBackoffMax {
+			return unlockBackoffMax
+		}
+	}
+	return delay
+}
+
+// checkUnlockThrottle returns UnlockThrottledError if we have to wait before
+// another unlock attempt.
+func (v *Vault) checkUnlockThrottle() error {
+	failures, err := v.getInt64("/config/unlock-failures")
+	if err != nil {
+		return err
+	}
+	delay := unlockDelay(failures)
+	if delay == 0 {
+		return nil
+	}
+	failed, err := v.getTime("/config/unlock-failed")
+	if err != nil {
+		return err
+	}
+	wait := failed.Add(delay).Sub(v.clock.Now())
+	if wait > 0 {
+		// Round up to the second
+		return UnlockThrottledError{Wait: (wait + time.Second - 1).Truncate(time.Second)}
+	}
+	return nil
+}
+
+func (v *Vault) unlockFailed() error {
+	failures, err := v.getInt64("/config/unlock-failures")
+	if err != nil {
+		return err
+	}
+	if err := v.setInt64("/config/unlock-failures", failures+1); err != nil {
+		return err
+	}
+	return v.setTime("/config/unlock-failed", v.clock.Now())
+}
+
+func (v *Vault) unlockSucceeded() error {
+	if err := v.setInt64("/config/unlock-failures", 0); err != nil {
+		return err
+	}
+	return v.setInt64("/config/unlock-failed", 0)
+}
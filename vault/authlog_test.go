@@ -0,0 +1,111 @@
+package vault_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnlockThrottle(t *testing.T) {
+	var err error
+	clock := tsutil.NewTestClock()
+	mem, closeFn := newTestMem(t)
+	defer closeFn()
+	vlt := vault.New(mem, vault.WithClock(clock))
+	key, provision := NewTestVaultKey(t, clock)
+	err = vlt.Setup(key, provision)
+	require.NoError(t, err)
+
+	invalid := keys.Rand32()
+	for i := 0; i < 3; i++ {
+		_, err = vlt.Unlock(invalid)
+		require.Equal(t, vault.ErrInvalidAuth, err)
+	}
+	// Throttled (even with valid key)
+	_, err = vlt.Unlock(key)
+	require.EqualError(t, err, "too many failed unlock attempts, try again in 1s")
+	_, ok := err.(vault.UnlockThrottledError)
+	require.True(t, ok)
+
+	clock.Add(time.Second)
+	_, err = vlt.Unlock(invalid)
+	require.Equal(t, vault.ErrInvalidAuth, err)
+	_, err = vlt.Unlock(key)
+	require.EqualError(t, err, "too many failed unlock attempts, try again in 2s")
+
+	// Persisted (across restarts)
+	vlt2 := vault.New(mem, vault.WithClock(clock))
+	_, err = vlt2.Unlock(key)
+	require.EqualError(t, err, "too many failed unlock attempts, try again in 2s")
+
+	clock.Add(2 * time.Second)
+	_, err = vlt2.Unlock(key)
+	require.NoError(t, err)
+	vlt2.Lock()
+
+	// Reset after unlock
+	_, err = vlt2.Unlock(invalid)
+	require.Equal(t, vault.ErrInvalidAuth, err)
+	_, err = vlt2.Unlock(key)
+	require.NoError(t, err)
+}
+
+func TestAuthLog(t *testing.T) {
+	var err error
+	clock := tsutil.NewTestClock()
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Clock: clock})
+	defer closeFn()
+	key, provision := NewTestVaultKey(t, clock)
+	err = vlt.Setup(key, provision)
+	require.NoError(t, err)
+
+	_, err = vlt.Unlock(keys.Rand32())
+	require.Equal(t, vault.ErrInvalidAuth, err)
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
+
+	err = vlt.AuthLogAdd(&vault.AuthLogEntry{Type: vault.AuthLogToken, Client: "cli"})
+	require.NoError(t, err)
+
+	key2 := keys.Rand32()
+	provision2 := vault.NewProvision(vault.PasswordAuth)
+	err = vlt.Provision(key2, provision2)
+	require.NoError(t, err)
+	ok, err := vlt.Deprovision(provision2.ID, false)
+	require.NoError(t, err)
+	require.True(t, ok)
+	vlt.Lock()
+	// Already locked (not logged)
+	vlt.Lock()
+
+	entries, err := vlt.AuthLog(0)
+	require.NoError(t, err)
+	types := []vault.AuthLogType{}
+	for _, entry := range entries {
+		types = append(types, entry.Type)
+	}
+	require.Equal(t, []vault.AuthLogType{
+		vault.AuthLogLock,
+		vault.AuthLogDeprovision,
+		vault.AuthLogProvision,
+		vault.AuthLogToken,
+		vault.AuthLogUnlock,
+		vault.AuthLogUnlockFailed,
+		vault.AuthLogSetup,
+	}, types)
+	require.Equal(t, int64(7), entries[0].Index)
+	require.Equal(t, provision2.ID, entries[1].Provision)
+	require.Equal(t, vault.PasswordAuth, entries[1].AuthType)
+	require.Equal(t, "cli", entries[3].Client)
+	require.Equal(t, provision.ID, entries[4].Provision)
+	require.False(t, entries[0].Timestamp.IsZero())
+
+	entries, err = vlt.AuthLog(2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, vault.AuthLogLock, entries[0].Type)
+}
@@ -0,0 +1,208 @@
+package vault
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+// BackupFormat identifies a vault backup archive.
+const BackupFormat = "keys.pub/vault-backup"
+
+// BackupVersion is the current backup archive version.
+const BackupVersion = 1
+
+// Backup is a vault backup archive.
+//
+// The archive has every entry in the vault store (auths, provisions, items,
+// push/pull logs and sync state), encrypted with the master key. The auths and
+// salt are also available unencrypted (the auths are the master key encrypted
+// with each auth key), so the master key can be recovered by any auth that
+// could unlock the vault when it was backed up.
+type Backup struct {
+	Format    string    `msgpack:"fmt"`
+	Version   int       `msgpack:"v"`
+	CreatedAt time.Time `msgpack:"cts"`
+
+	// Salt (/config/salt) for password auth.
+	Salt []byte `msgpack:"salt,omitempty"`
+	// Auths (master key encrypted with auth keys).
+	Auths []*backupEntry `msgpack:"auths"`
+	// Count is the number of entries.
+	Count int `msgpack:"count"`
+	// Data is the entries encrypted with the master key.
+	Data []byte `msgpack:"dat"`
+}
+
+type backupEntry struct {
+	Path string `msgpack:"p"`
+	Data []byte `msgpack:"d"`
+}
+
+// ErrInvalidBackup if the backup archive is invalid.
+var ErrInvalidBackup = errors.New("invalid backup")
+
+// Backup writes a backup archive of the vault.
+// Requires Unlock.
+func (v *Vault) Backup(w io.Writer) error {
+	if v.mk == nil {
+		return ErrLocked
+	}
+	entries, err := v.store.List(nil)
+	if err != nil {
+		return err
+	}
+	backup := &Backup{
+		Format:    BackupFormat,
+		Version:   BackupVersion,
+		CreatedAt: v.clock.Now(),
+		Auths:     []*backupEntry{},
+		Count:     len(entries),
+	}
+	data := make([]*backupEntry, 0, len(entries))
+	for _, entry := range entries {
+		be := &backupEntry{Path: entry.Path, Data: entry.Data}
+		switch dstore.PathFirst(entry.Path) {
+		case "auth":
+			backup.Auths = append(backup.Auths, be)
+		case "config":
+			if entry.Path == dstore.Path("config", "salt") {
+				backup.Salt = entry.Data
+			}
+		}
+		data = append(data, be)
+	}
+	b, err := msgpack.Marshal(data)
+	if err != nil {
+		return err
+	}
+	backup.Data = secretBoxSeal(b, v.mk)
+
+	out, err := msgpack.Marshal(backup)
+	if err != nil {
+		return err
+	}
+	logger.Infof("Backup (%d entries)", len(entries))
+	if _, err := w.Write(out); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReadBackup reads a backup archive.
+// Use Backup.Salt to derive a password auth key for RestoreBackup.
+func ReadBackup(r io.Reader) (*Backup, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var backup Backup
+	if err := msgpack.Unmarshal(b, &backup); err != nil {
+		return nil, ErrInvalidBackup
+	}
+	if backup.Format != BackupFormat {
+		return nil, ErrInvalidBackup
+	}
+	if backup.Version > BackupVersion {
+		return nil, errors.Errorf("unsupported backup version %d", backup.Version)
+	}
+	return &backup, nil
+}
+
+// RestoreBackup restores a backup archive into an empty vault (that hasn't
+// been setup).
+// The key is an auth key (for an auth in the backup) used to get the master
+// key.
+// Returns the paths restored (or that would be restored, with DryRun).
+// The vault remains locked, use Unlock with the same auth after restoring.
+func (v *Vault) RestoreBackup(backup *Backup, key *[32]byte, opt ...CopyOption) ([]string, error) {
+	opts := newCopyOptions(opt...)
+
+	status, err := v.Status()
+	if err != nil {
+		return nil, err
+	}
+	if status != SetupNeeded {
+		return nil, ErrAlreadySetup
+	}
+
+	entries, err := backup.open(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// The salt may already be set on the empty vault, so we replace it.
+	saltPath := dstore.Path("config", "salt")
+	restore := make([]*Entry, 0, len(entries))
+	var salt *Entry
+	for _, entry := range entries {
+		if entry.Path == saltPath {
+			salt = entry
+			continue
+		}
+		restore = append(restore, entry)
+	}
+	paths, err := copyEntries(restore, v.store, opts)
+	if err != nil {
+		return nil, err
+	}
+	if salt != nil {
+		if !opts.DryRun {
+			if err := v.store.Set(salt.Path, salt.Data); err != nil {
+				return nil, err
+			}
+		}
+		paths = append(paths, salt.Path)
+	}
+	logger.Infof("Restored backup (%d entries)", len(paths))
+	return paths, nil
+}
+
+// open decrypts and checks the backup entries.
+func (b *Backup) open(key *[32]byte) ([]*Entry, error) {
+	var mk *[32]byte
+	for _, auth := range b.Auths {
+		item, err := decryptItem(auth.Data, key, "")
+		if err != nil || item == nil || len(item.Data) != 32 {
+			continue
+		}
+		mk = keys.Bytes32(item.Data)
+		break
+	}
+	if mk == nil {
+		return nil, ErrInvalidAuth
+	}
+
+	decrypted, ok := secretBoxOpen(b.Data, mk)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidBackup, "failed to decrypt")
+	}
+	var data []*backupEntry
+	if err := msgpack.Unmarshal(decrypted, &data); err != nil {
+		return nil, errors.Wrapf(ErrInvalidBackup, "failed to decode")
+	}
+	if len(data) != b.Count {
+		return nil, errors.Wrapf(ErrInvalidBackup, "expected %d entries, got %d", b.Count, len(data))
+	}
+
+	entries := make([]*Entry, 0, len(data))
+	for _, be := range data {
+		if !strings.HasPrefix(be.Path, "/") {
+			return nil, errors.Wrapf(ErrInvalidBackup, "invalid path %q", be.Path)
+		}
+		// Items should be encrypted with this master key.
+		if dstore.PathFirst(be.Path) == "item" {
+			if _, err := decryptItem(be.Data, mk, dstore.PathLast(be.Path)); err != nil {
+				return nil, errors.Wrapf(ErrInvalidBackup, "invalid item %s", be.Path)
+			}
+		}
+		entries = append(entries, &Entry{Path: be.Path, Data: be.Data})
+	}
+	return entries, nil
+}
@@ -0,0 +1,82 @@
+package vault_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackup(t *testing.T) {
+	var err error
+	clock := tsutil.NewTestClock()
+
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+
+	err = vlt.Set(vault.NewItem("key1", []byte("value1"), "", time.Now()))
+	require.NoError(t, err)
+	err = vlt.Set(vault.NewItem("key2", []byte("value2"), "", time.Now()))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = vlt.Backup(&buf)
+	require.NoError(t, err)
+
+	backup, err := vault.ReadBackup(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, vault.BackupVersion, backup.Version)
+	require.Equal(t, 1, len(backup.Auths))
+
+	expected, err := vaultPaths(vlt, "")
+	require.NoError(t, err)
+
+	// Restore (dry-run)
+	vlt2, closeFn2 := NewTestVault(t, nil)
+	defer closeFn2()
+	key, _ := NewTestVaultKey(t, clock)
+	paths, err := vlt2.RestoreBackup(backup, key, vault.DryRun())
+	require.NoError(t, err)
+	require.Equal(t, len(expected), len(paths))
+	out, err := vaultPaths(vlt2, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, out)
+
+	// Restore (invalid auth)
+	_, err = vlt2.RestoreBackup(backup, keys.Rand32())
+	require.Equal(t, vault.ErrInvalidAuth, err)
+
+	// Restore
+	_, err = vlt2.RestoreBackup(backup, key)
+	require.NoError(t, err)
+	out, err = vaultPaths(vlt2, "")
+	require.NoError(t, err)
+	require.Equal(t, expected, out)
+
+	_, err = vlt2.Unlock(key)
+	require.NoError(t, err)
+	item, err := vlt2.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), item.Data)
+
+	// Restore (not empty)
+	_, err = vlt2.RestoreBackup(backup, key)
+	require.Equal(t, vault.ErrAlreadySetup, err)
+
+	// Corrupted
+	b := buf.Bytes()
+	b[len(b)-1] ^= 0xFF
+	backup, err = vault.ReadBackup(bytes.NewReader(b))
+	require.NoError(t, err)
+	vlt3, closeFn3 := NewTestVault(t, nil)
+	defer closeFn3()
+	_, err = vlt3.RestoreBackup(backup, key)
+	require.EqualError(t, err, "failed to decrypt: invalid backup")
+
+	_, err = vault.ReadBackup(bytes.NewReader([]byte("notabackup")))
+	require.Equal(t, vault.ErrInvalidBackup, err)
+}
@@ -0,0 +1,476 @@
+package vault
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	httpclient "github.com/keys-pub/keys-ext/http/client"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+const collectionItemType = "collection"
+
+// Collection is a vault shared between keys.pub users.
+//
+// The collection key (EdX25519) is the remote (API) key for a collection
+// scoped vault log, and items are encrypted with a key derived from it.
+// The collection key is saved as an item in this vault, and shared with
+// members by invite (the key encrypted to each member).
+// Removing a member rotates the collection key.
+type Collection struct {
+	ID        keys.ID
+	Name      string
+	Key       *keys.EdX25519Key
+	CreatedAt time.Time
+}
+
+// CollectionMember is a user (key) a collection is shared with.
+type CollectionMember struct {
+	KID    keys.ID
+	Sender keys.ID
+}
+
+// CollectionCreate creates a new shared collection.
+// The user is invited to the collection, so it is available from the user's
+// invites, and they can be re-invited if the collection key is rotated by
+// another member.
+func (v *Vault) CollectionCreate(ctx context.Context, name string, user *keys.EdX25519Key) (*Collection, error) {
+	if v.client == nil {
+		return nil, errors.Errorf("no vault client set")
+	}
+	col := &Collection{
+		Name:      name,
+		Key:       keys.GenerateEdX25519Key(),
+		CreatedAt: v.Now(),
+	}
+	col.ID = col.Key.ID()
+	if err := v.client.VaultInvite(ctx, col.Key, user, name, user.ID()); err != nil {
+		return nil, err
+	}
+	if err := v.saveCollection(col); err != nil {
+		return nil, err
+	}
+	return col, nil
+}
+
+// Collection returns a shared collection, or nil if not found.
+func (v *Vault) Collection(id keys.ID) (*Collection, error) {
+	item, err := v.Get(id.String())
+	if err != nil {
+		return nil, err
+	}
+	if item == nil || item.Type != collectionItemType {
+		return nil, nil
+	}
+	return item.collection()
+}
+
+// Collections returns shared collections.
+func (v *Vault) Collections() ([]*Collection, error) {
+	items, err := v.Items()
+	if err != nil {
+		return nil, err
+	}
+	out := []*Collection{}
+	for _, item := range items {
+		if item.Type != collectionItemType {
+			continue
+		}
+		col, err := item.collection()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, col)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name == out[j].Name {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+// CollectionVault returns the vault for a shared collection.
+// Use Set, Get, Items, Sync etc. on the collection vault, which has its own
+// (local) store entries and remote log.
+// Requires Unlock.
+func (v *Vault) CollectionVault(id keys.ID) (*Vault, error) {
+	col, err := v.Collection(id)
+	if err != nil {
+		return nil, err
+	}
+	if col == nil {
+		return nil, keys.NewErrNotFound(id.String())
+	}
+	return v.collectionVault(col), nil
+}
+
+// collectionVault returns the vault for a collection.
+// The vault is cached (until Lock), so concurrent callers share the same vault
+// and its locks.
+func (v *Vault) collectionVault(col *Collection) *Vault {
+	v.collectionsMtx.Lock()
+	defer v.collectionsMtx.Unlock()
+	if cv, ok := v.collections[col.ID]; ok {
+		return cv
+	}
+	cv := New(newPrefixStore(v.store, dstore.Path("collection", col.ID)), WithClock(v.clock), WithConflictResolver(v.resolver))
+	cv.indexers = v.indexers
+	cv.client = v.client
+	cv.mk = collectionKey(col.Key)
+	cv.remote = &Remote{Key: col.Key}
+	v.collections[col.ID] = cv
+	return cv
+}
+
+// closeCollection stops and locks a cached collection vault.
+func (v *Vault) closeCollection(id keys.ID) {
+	v.collectionsMtx.Lock()
+	defer v.collectionsMtx.Unlock()
+	if cv, ok := v.collections[id]; ok {
+		cv.closeCollectionVault()
+		delete(v.collections, id)
+	}
+}
+
+// closeCollections stops and locks the cached collection vaults.
+func (v *Vault) closeCollections() {
+	v.collectionsMtx.Lock()
+	defer v.collectionsMtx.Unlock()
+	for id, cv := range v.collections {
+		cv.closeCollectionVault()
+		delete(v.collections, id)
+	}
+}
+
+// closeCollectionVault stops auto sync, and clears the keys.
+// The store is the parent vault store, so it isn't closed.
+func (v *Vault) closeCollectionVault() {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if v.auto != nil {
+		v.auto.Stop()
+		v.auto = nil
+	}
+	v.mk = nil
+	v.remote = nil
+	v.clearIndexTerms()
+}
+
+// collectionKey derives the (item) encryption key from the collection key.
+func collectionKey(key *keys.EdX25519Key) *[32]byte {
+	return keys.Bytes32(keys.HKDFSHA256(key.Seed()[:], 32, nil, []byte("keys.pub/ck")))
+}
+
+// CollectionInvite invites recipients to a shared collection.
+func (v *Vault) CollectionInvite(ctx context.Context, id keys.ID, sender *keys.EdX25519Key, recipients ...keys.ID) error {
+	if v.client == nil {
+		return errors.Errorf("no vault client set")
+	}
+	col, err := v.Collection(id)
+	if err != nil {
+		return err
+	}
+	if col == nil {
+		return keys.NewErrNotFound(id.String())
+	}
+	return v.client.VaultInvite(ctx, col.Key, sender, col.Name, recipients...)
+}
+
+// CollectionMembers returns the users a collection is shared with.
+func (v *Vault) CollectionMembers(ctx context.Context, id keys.ID) ([]*CollectionMember, error) {
+	if v.client == nil {
+		return nil, errors.Errorf("no vault client set")
+	}
+	col, err := v.Collection(id)
+	if err != nil {
+		return nil, err
+	}
+	if col == nil {
+		return nil, keys.NewErrNotFound(id.String())
+	}
+	invites, err := v.client.VaultInvites(ctx, col.Key)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]*CollectionMember, 0, len(invites))
+	for _, invite := range invites {
+		members = append(members, &CollectionMember{KID: invite.Recipient, Sender: invite.Sender})
+	}
+	return members, nil
+}
+
+// CollectionInvites returns pending invites for a user, for collections that
+// haven't been accepted.
+func (v *Vault) CollectionInvites(ctx context.Context, user *keys.EdX25519Key) ([]*api.VaultInvite, error) {
+	if v.client == nil {
+		return nil, errors.Errorf("no vault client set")
+	}
+	invites, err := v.client.UserVaultInvites(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	out := []*api.VaultInvite{}
+	for _, invite := range invites {
+		col, err := v.Collection(invite.Vault)
+		if err != nil {
+			return nil, err
+		}
+		if col != nil {
+			continue
+		}
+		out = append(out, invite)
+	}
+	return out, nil
+}
+
+// CollectionAccept accepts an invite to a shared collection.
+func (v *Vault) CollectionAccept(ctx context.Context, user *keys.EdX25519Key, id keys.ID) (*Collection, error) {
+	if v.client == nil {
+		return nil, errors.Errorf("no vault client set")
+	}
+	invite, err := v.client.UserVaultInvite(ctx, user, id)
+	if err != nil {
+		return nil, err
+	}
+	if invite == nil {
+		return nil, keys.NewErrNotFound(id.String())
+	}
+	key, _, err := invite.Key(user)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := key.AsEdX25519()
+	if err != nil {
+		return nil, err
+	}
+	if sk == nil {
+		return nil, errors.Errorf("invalid collection key type")
+	}
+	col := &Collection{
+		ID:        sk.ID(),
+		Name:      key.Notes,
+		Key:       sk,
+		CreatedAt: v.Now(),
+	}
+	if err := v.saveCollection(col); err != nil {
+		return nil, err
+	}
+	return col, nil
+}
+
+// CollectionLeave removes the user from a shared collection, and removes the
+// collection (and its local data) from this vault.
+func (v *Vault) CollectionLeave(ctx context.Context, user *keys.EdX25519Key, id keys.ID) error {
+	if v.client == nil {
+		return errors.Errorf("no vault client set")
+	}
+	if err := v.client.UserVaultInviteDelete(ctx, user, id); err != nil {
+		var rerr httpclient.Error
+		if !errors.As(err, &rerr) || rerr.StatusCode != http.StatusNotFound {
+			return err
+		}
+	}
+	return v.collectionRemove(id)
+}
+
+// CollectionRemoveMember removes a member from a shared collection.
+//
+// The member has the collection key, so the collection is rotated: the items
+// are copied to a collection with a new key, the remaining members are
+// invited, and the previous collection is deleted from the remote. Members
+// need to accept the invite for the new collection.
+// Returns the new collection.
+func (v *Vault) CollectionRemoveMember(ctx context.Context, id keys.ID, sender *keys.EdX25519Key, member keys.ID) (*Collection, error) {
+	if v.client == nil {
+		return nil, errors.Errorf("no vault client set")
+	}
+	col, err := v.Collection(id)
+	if err != nil {
+		return nil, err
+	}
+	if col == nil {
+		return nil, keys.NewErrNotFound(id.String())
+	}
+
+	invites, err := v.client.VaultInvites(ctx, col.Key)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	recipients := []keys.ID{}
+	for _, invite := range invites {
+		if invite.Recipient == member {
+			found = true
+			continue
+		}
+		recipients = append(recipients, invite.Recipient)
+	}
+	if !found {
+		return nil, errors.Errorf("%s is not a member", member)
+	}
+
+	cv := v.collectionVault(col)
+	if err := cv.Sync(ctx); err != nil {
+		return nil, err
+	}
+	items, err := cv.Items()
+	if err != nil {
+		return nil, err
+	}
+
+	rotated := &Collection{
+		Name:      col.Name,
+		Key:       keys.GenerateEdX25519Key(),
+		CreatedAt: col.CreatedAt,
+	}
+	rotated.ID = rotated.Key.ID()
+	if err := v.saveCollection(rotated); err != nil {
+		return nil, err
+	}
+	rv := v.collectionVault(rotated)
+	for _, item := range items {
+		if err := rv.Set(item); err != nil {
+			return nil, err
+		}
+	}
+	if err := rv.Sync(ctx); err != nil {
+		return nil, err
+	}
+	if len(recipients) > 0 {
+		if err := v.client.VaultInvite(ctx, rotated.Key, sender, rotated.Name, recipients...); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Infof("Rotated collection %s to %s", col.ID, rotated.ID)
+	if err := v.client.VaultDelete(ctx, col.Key); err != nil {
+		var rerr httpclient.Error
+		if !errors.As(err, &rerr) || rerr.StatusCode != http.StatusNotFound {
+			return nil, err
+		}
+	}
+	if err := v.collectionRemove(col.ID); err != nil {
+		return nil, err
+	}
+	return rotated, nil
+}
+
+func (v *Vault) collectionRemove(id keys.ID) error {
+	v.closeCollection(id)
+	if _, err := v.Delete(id.String()); err != nil {
+		return err
+	}
+	st := newPrefixStore(v.store, dstore.Path("collection", id))
+	return st.Reset()
+}
+
+type collectionData struct {
+	Name string `msgpack:"name"`
+	Seed []byte `msgpack:"seed"`
+}
+
+func (v *Vault) saveCollection(col *Collection) error {
+	b, err := msgpack.Marshal(&collectionData{Name: col.Name, Seed: col.Key.Seed()[:]})
+	if err != nil {
+		return err
+	}
+	return v.Set(NewItem(col.ID.String(), b, collectionItemType, col.CreatedAt))
+}
+
+func (i *Item) collection() (*Collection, error) {
+	var data collectionData
+	if err := msgpack.Unmarshal(i.Data, &data); err != nil {
+		return nil, err
+	}
+	if len(data.Seed) != 32 {
+		return nil, errors.Errorf("invalid collection key")
+	}
+	key := keys.NewEdX25519KeyFromSeed(keys.Bytes32(data.Seed))
+	if key.ID().String() != i.ID {
+		return nil, errors.Errorf("collection key id mismatch")
+	}
+	return &Collection{
+		ID:        key.ID(),
+		Name:      data.Name,
+		Key:       key,
+		CreatedAt: i.CreatedAt,
+	}, nil
+}
+
+// prefixStore is a Store for entries (with a path prefix) in another Store.
+type prefixStore struct {
+	st     Store
+	prefix string
+}
+
+func newPrefixStore(st Store, prefix string) *prefixStore {
+	return &prefixStore{st: st, prefix: prefix}
+}
+
+func (s *prefixStore) Name() string {
+	return s.st.Name()
+}
+
+func (s *prefixStore) Get(path string) ([]byte, error) {
+	return s.st.Get(s.prefix + path)
+}
+
+func (s *prefixStore) Set(path string, b []byte) error {
+	return s.st.Set(s.prefix+path, b)
+}
+
+func (s *prefixStore) Delete(path string) (bool, error) {
+	return s.st.Delete(s.prefix + path)
+}
+
+func (s *prefixStore) List(opts *ListOptions) ([]*Entry, error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	prefix := s.prefix + opts.Prefix
+	if opts.Prefix == "" {
+		prefix = s.prefix + "/"
+	}
+	entries, err := s.st.List(&ListOptions{Prefix: prefix, NoData: opts.NoData, Limit: opts.Limit})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Entry, 0, len(entries))
+	for _, entry := range entries {
+		out = append(out, &Entry{Path: strings.TrimPrefix(entry.Path, s.prefix), Data: entry.Data})
+	}
+	return out, nil
+}
+
+// Open is a no-op, the parent store is opened by its vault.
+func (s *prefixStore) Open() error {
+	return nil
+}
+
+// Close is a no-op, the parent store is closed by its vault.
+func (s *prefixStore) Close() error {
+	return nil
+}
+
+// Reset removes the entries (with the prefix).
+func (s *prefixStore) Reset() error {
+	entries, err := s.List(&ListOptions{NoData: true})
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if _, err := s.Delete(entry.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package vault_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollections(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	bob := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	charlie := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+
+	va, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	va.SetClient(testClient(t, env))
+	vb, closeFn2 := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn2()
+	vb.SetClient(testClient(t, env))
+	vc, closeFn3 := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn3()
+	vc.SetClient(testClient(t, env))
+
+	// Alice creates collection
+	col, err := va.CollectionCreate(ctx, "Deploy", alice)
+	require.NoError(t, err)
+	cols, err := va.Collections()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(cols))
+	require.Equal(t, "Deploy", cols[0].Name)
+	require.Equal(t, col.ID, cols[0].ID)
+
+	cva, err := va.CollectionVault(col.ID)
+	require.NoError(t, err)
+	err = cva.Set(vault.NewItem("token", []byte("secret1"), "", time.Now()))
+	require.NoError(t, err)
+	err = cva.Sync(ctx)
+	require.NoError(t, err)
+
+	// Collection items are separate from the vault
+	item, err := va.Get("token")
+	require.NoError(t, err)
+	require.Nil(t, item)
+
+	err = va.CollectionInvite(ctx, col.ID, alice, bob.ID(), charlie.ID())
+	require.NoError(t, err)
+	members, err := va.CollectionMembers(ctx, col.ID)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(members))
+
+	// Bob accepts
+	invites, err := vb.CollectionInvites(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(invites))
+	require.Equal(t, col.ID, invites[0].Vault)
+	bcol, err := vb.CollectionAccept(ctx, bob, col.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Deploy", bcol.Name)
+	invites, err = vb.CollectionInvites(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(invites))
+
+	cvb, err := vb.CollectionVault(col.ID)
+	require.NoError(t, err)
+	err = cvb.Sync(ctx)
+	require.NoError(t, err)
+	item, err = cvb.Get("token")
+	require.NoError(t, err)
+	require.NotNil(t, item)
+	require.Equal(t, []byte("secret1"), item.Data)
+	err = cvb.Set(vault.NewItem("token2", []byte("secret2"), "", time.Now()))
+	require.NoError(t, err)
+	err = cvb.Sync(ctx)
+	require.NoError(t, err)
+
+	// Charlie accepts
+	_, err = vc.CollectionAccept(ctx, charlie, col.ID)
+	require.NoError(t, err)
+	cvc, err := vc.CollectionVault(col.ID)
+	require.NoError(t, err)
+	err = cvc.Sync(ctx)
+	require.NoError(t, err)
+
+	// Alice removes charlie (rotating)
+	rotated, err := va.CollectionRemoveMember(ctx, col.ID, alice, charlie.ID())
+	require.NoError(t, err)
+	require.NotEqual(t, col.ID, rotated.ID)
+	cols, err = va.Collections()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(cols))
+	require.Equal(t, rotated.ID, cols[0].ID)
+	members, err = va.CollectionMembers(ctx, rotated.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(members))
+
+	cva, err = va.CollectionVault(rotated.ID)
+	require.NoError(t, err)
+	items, err := cva.Items()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+
+	// Charlie can't sync the old (deleted) collection
+	err = cvc.Sync(ctx)
+	require.Error(t, err)
+	invites, err = vc.CollectionInvites(ctx, charlie)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(invites))
+
+	// Bob accepts rotated collection
+	invites, err = vb.CollectionInvites(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(invites))
+	require.Equal(t, rotated.ID, invites[0].Vault)
+	_, err = vb.CollectionAccept(ctx, bob, rotated.ID)
+	require.NoError(t, err)
+	cvb, err = vb.CollectionVault(rotated.ID)
+	require.NoError(t, err)
+	err = cvb.Sync(ctx)
+	require.NoError(t, err)
+	item, err = cvb.Get("token2")
+	require.NoError(t, err)
+	require.NotNil(t, item)
+	require.Equal(t, []byte("secret2"), item.Data)
+
+	// Bob leaves old and rotated
+	err = vb.CollectionLeave(ctx, bob, col.ID)
+	require.NoError(t, err)
+	err = vb.CollectionLeave(ctx, bob, rotated.ID)
+	require.NoError(t, err)
+	cols, err = vb.Collections()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(cols))
+	paths, err := vaultPaths(vb, "/collection")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, paths)
+	members, err = va.CollectionMembers(ctx, rotated.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(members))
+}
+
+func TestCollectionVaultLock(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	va, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	va.SetClient(testClient(t, env))
+
+	col, err := va.CollectionCreate(ctx, "Deploy", alice)
+	require.NoError(t, err)
+
+	cva, err := va.CollectionVault(col.ID)
+	require.NoError(t, err)
+	err = cva.Set(vault.NewItem("token", []byte("secret1"), "", time.Now()))
+	require.NoError(t, err)
+
+	// Same vault for the collection
+	cva2, err := va.CollectionVault(col.ID)
+	require.NoError(t, err)
+	require.True(t, cva == cva2)
+
+	// Lock locks the collection vault
+	va.Lock()
+	_, err = cva.Get("token")
+	require.Equal(t, vault.ErrLocked, err)
+	_, err = va.CollectionVault(col.ID)
+	require.Equal(t, vault.ErrLocked, err)
+
+	key, _ := NewTestVaultKey(t, clock)
+	_, err = va.Unlock(key)
+	require.NoError(t, err)
+	cva3, err := va.CollectionVault(col.ID)
+	require.NoError(t, err)
+	require.False(t, cva == cva3)
+	item, err := cva3.Get("token")
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret1"), item.Data)
+}
@@ -0,0 +1,285 @@
+package vault
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+	"strconv"
+
+	httpclient "github.com/keys-pub/keys-ext/http/client"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/dstore/events"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+// Conflict is a concurrent modification of an item.
+//
+// A conflict is detected on pull, if the vault is unlocked, when an item event
+// has a version that isn't newer than the last pulled version of that item
+// (both were changed from the same version).
+// The Incoming item is applied (last writer wins), and the overwritten Current
+// item is kept in the conflict until it is resolved.
+type Conflict struct {
+	ID string `msgpack:"id"`
+	// Base is the common version the items were changed from, if known.
+	Base *Item `msgpack:"base,omitempty"`
+	// Current item (overwritten).
+	Current *Item `msgpack:"cur"`
+	// Incoming item (applied).
+	Incoming *Item `msgpack:"inc"`
+}
+
+// ConflictResolver returns items to save to resolve a conflict.
+type ConflictResolver func(conflict *Conflict) ([]*Item, error)
+
+// LastWriterWins resolves a conflict by keeping the incoming item.
+func LastWriterWins(conflict *Conflict) ([]*Item, error) {
+	return []*Item{copyItem(conflict.Incoming)}, nil
+}
+
+// KeepBoth resolves a conflict by keeping the incoming item and saving the
+// current (overwritten) item as a new item.
+// The new item ID is derived from the current item, so resolving the same
+// conflict on different devices results in the same item.
+// The item data is copied as is, so if the data includes the item ID (like
+// secrets do), use a type aware resolver, such as secrets.KeepBoth.
+func KeepBoth(conflict *Conflict) ([]*Item, error) {
+	incoming := copyItem(conflict.Incoming)
+	if len(conflict.Current.Data) == 0 {
+		return []*Item{incoming}, nil
+	}
+	if len(incoming.Data) == 0 {
+		return []*Item{copyItem(conflict.Current)}, nil
+	}
+	h := sha256.New()
+	_, _ = h.Write([]byte(conflict.ID))
+	_, _ = h.Write([]byte(strconv.FormatInt(conflict.Current.Version, 10)))
+	_, _ = h.Write(conflict.Current.Data)
+	id := encoding.MustEncode(h.Sum(nil), encoding.Base62)
+	current := copyItem(conflict.Current)
+	current.ID = id
+	current.Version = 0
+	return []*Item{incoming, current}, nil
+}
+
+// Conflicts returns unresolved conflicts.
+// Requires Unlock.
+func (v *Vault) Conflicts() ([]*Conflict, error) {
+	if v.mk == nil {
+		return nil, ErrLocked
+	}
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("conflict")})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Conflict, 0, len(entries))
+	for _, entry := range entries {
+		conflict, err := decryptConflict(entry.Data, v.mk)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, conflict)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+// Conflict returns unresolved conflict for item, or nil if there is no conflict.
+// Requires Unlock.
+func (v *Vault) Conflict(id string) (*Conflict, error) {
+	if v.mk == nil {
+		return nil, ErrLocked
+	}
+	b, err := v.store.Get(dstore.Path("conflict", id))
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, nil
+	}
+	return decryptConflict(b, v.mk)
+}
+
+// Resolve conflict for item using a resolver.
+// Items returned by the resolver are saved (and pushed on the next sync).
+// Requires Unlock.
+func (v *Vault) Resolve(id string, resolver ConflictResolver) error {
+	conflict, err := v.Conflict(id)
+	if err != nil {
+		return err
+	}
+	if conflict == nil {
+		return errors.Errorf("conflict not found %s", id)
+	}
+	return v.resolve(conflict, resolver)
+}
+
+func (v *Vault) resolve(conflict *Conflict, resolver ConflictResolver) error {
+	logger.Infof("Resolving conflict %s", conflict.ID)
+	items, err := resolver(conflict)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := v.Set(item); err != nil {
+			return err
+		}
+	}
+	if _, err := v.store.Delete(dstore.Path("conflict", conflict.ID)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// pullItem checks a pulled item event for a conflict.
+// This is called after the event is saved (to the pull log).
+// The head for the item is the last pulled event for that item.
+// If the vault is locked, we can't check for conflicts.
+func (v *Vault) pullItem(id string, event *httpclient.VaultEvent, pull string) error {
+	if v.mk == nil {
+		return nil
+	}
+	incoming, err := decryptItem(event.Data, v.mk, id)
+	if err != nil {
+		return err
+	}
+	head, err := v.headItem(id)
+	if err != nil {
+		return err
+	}
+	if err := v.store.Set(dstore.Path("head", id), []byte(pull)); err != nil {
+		return err
+	}
+
+	// Items without versions (from older clients) are always applied.
+	if head == nil || incoming.Version == 0 || incoming.Version > head.Version {
+		return v.clearConflict(id, incoming.Version)
+	}
+	if itemsEqual(head, incoming) {
+		return nil
+	}
+
+	logger.Infof("Conflict %s (version %d, %d)", id, head.Version, incoming.Version)
+	conflict := &Conflict{
+		ID:       id,
+		Current:  head,
+		Incoming: incoming,
+	}
+	base, err := v.baseItem(id, conflict)
+	if err != nil {
+		return err
+	}
+	conflict.Base = base
+
+	if v.resolver != nil {
+		return v.resolve(conflict, v.resolver)
+	}
+
+	b, err := encryptConflict(conflict, v.mk)
+	if err != nil {
+		return err
+	}
+	return v.store.Set(dstore.Path("conflict", id), b)
+}
+
+// clearConflict removes a conflict superseded by a later version.
+func (v *Vault) clearConflict(id string, version int64) error {
+	conflict, err := v.Conflict(id)
+	if err != nil {
+		return err
+	}
+	if conflict == nil {
+		return nil
+	}
+	if version <= conflict.Current.Version || version <= conflict.Incoming.Version {
+		return nil
+	}
+	logger.Infof("Conflict %s superseded (version %d)", id, version)
+	if _, err := v.store.Delete(dstore.Path("conflict", id)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// headItem returns the last pulled item.
+func (v *Vault) headItem(id string) (*Item, error) {
+	pull, err := v.store.Get(dstore.Path("head", id))
+	if err != nil {
+		return nil, err
+	}
+	if pull == nil {
+		return nil, nil
+	}
+	b, err := v.store.Get(string(pull))
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, nil
+	}
+	var event events.Event
+	if err := msgpack.Unmarshal(b, &event); err != nil {
+		return nil, err
+	}
+	return decryptItem(event.Data, v.mk, id)
+}
+
+// baseItem finds the (latest) item at the version the conflicting items were
+// changed from.
+func (v *Vault) baseItem(id string, conflict *Conflict) (*Item, error) {
+	version := conflict.Current.Version
+	if conflict.Incoming.Version < version {
+		version = conflict.Incoming.Version
+	}
+	version--
+	if version < 0 {
+		return nil, nil
+	}
+	history, err := v.ItemHistory(id)
+	if err != nil {
+		return nil, err
+	}
+	var base *Item
+	for _, item := range history {
+		if item.Version == version {
+			base = item
+		}
+	}
+	return base, nil
+}
+
+func itemsEqual(i1 *Item, i2 *Item) bool {
+	return i1.ID == i2.ID &&
+		i1.Type == i2.Type &&
+		i1.Version == i2.Version &&
+		i1.CreatedAt.Equal(i2.CreatedAt) &&
+		bytes.Equal(i1.Data, i2.Data)
+}
+
+// copyItem returns a copy of the item (with all fields).
+func copyItem(item *Item) *Item {
+	out := *item
+	return &out
+}
+
+func encryptConflict(conflict *Conflict, mk *[32]byte) ([]byte, error) {
+	b, err := msgpack.Marshal(conflict)
+	if err != nil {
+		return nil, err
+	}
+	return secretBoxSeal(b, mk), nil
+}
+
+func decryptConflict(b []byte, mk *[32]byte) (*Conflict, error) {
+	decrypted, ok := secretBoxOpen(b, mk)
+	if !ok {
+		return nil, ErrInvalidAuth
+	}
+	var conflict Conflict
+	if err := msgpack.Unmarshal(decrypted, &conflict); err != nil {
+		return nil, err
+	}
+	return &conflict, nil
+}
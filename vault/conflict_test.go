@@ -0,0 +1,289 @@
+package vault_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConflictVaults(t *testing.T, env *testEnv, opt ...vault.Option) (*vault.Vault, *vault.Vault, func()) {
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	st1, closeFn1 := newTestMem(t)
+	st2, closeFn2 := newTestMem(t)
+
+	v1 := vault.New(st1, opt...)
+	v1.SetClient(testClient(t, env))
+	key, provision := NewTestVaultKey(t, clock)
+	err := v1.Setup(key, provision)
+	require.NoError(t, err)
+	_, err = v1.Unlock(key)
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	v2 := vault.New(st2, opt...)
+	v2.SetClient(testClient(t, env))
+	err = v2.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+	_, err = v2.Unlock(key)
+	require.NoError(t, err)
+
+	return v1, v2, func() {
+		closeFn1()
+		closeFn2()
+	}
+}
+
+func TestConflict(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+
+	v1, v2, closeFn := newTestConflictVaults(t, env)
+	defer closeFn()
+
+	err = v1.Set(vault.NewItem("key1", []byte("value1a"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	out, err := v2.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), out.Version)
+
+	// Update (sequential)
+	err = v2.Set(vault.NewItem("key1", []byte("value1b"), "", time.Now()))
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	conflicts, err := v1.Conflicts()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(conflicts))
+
+	// Update (concurrent)
+	err = v1.Set(vault.NewItem("key1", []byte("value1c"), "", time.Now()))
+	require.NoError(t, err)
+	err = v2.Set(vault.NewItem("key1", []byte("value1d"), "", time.Now()))
+	require.NoError(t, err)
+
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	// Last writer wins (applied)
+	out, err = v1.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1d"), out.Data)
+	out, err = v2.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1d"), out.Data)
+
+	for _, v := range []*vault.Vault{v1, v2} {
+		conflicts, err = v.Conflicts()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(conflicts))
+		require.Equal(t, "key1", conflicts[0].ID)
+		require.Equal(t, []byte("value1c"), conflicts[0].Current.Data)
+		require.Equal(t, int64(3), conflicts[0].Current.Version)
+		require.Equal(t, []byte("value1d"), conflicts[0].Incoming.Data)
+		require.Equal(t, int64(3), conflicts[0].Incoming.Version)
+		require.NotNil(t, conflicts[0].Base)
+		require.Equal(t, []byte("value1b"), conflicts[0].Base.Data)
+	}
+
+	// Resolve (keep both)
+	err = v1.Resolve("key1", vault.KeepBoth)
+	require.NoError(t, err)
+	conflicts, err = v1.Conflicts()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(conflicts))
+
+	items, err := v1.Items()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	// Resolution on v1 supersedes conflict on v2
+	conflicts, err = v2.Conflicts()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(conflicts))
+	items, err = v2.Items()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+	out, err = v2.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1d"), out.Data)
+	require.Equal(t, int64(4), out.Version)
+
+	err = v1.Resolve("key1", vault.KeepBoth)
+	require.EqualError(t, err, "conflict not found key1")
+}
+
+func TestConflictExpiring(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+
+	v1, v2, closeFn := newTestConflictVaults(t, env)
+	defer closeFn()
+
+	expireAt := time.Now().Add(time.Hour)
+	newExpiring := func(data string) *vault.Item {
+		item := vault.NewItem("key1", []byte(data), "", time.Now())
+		item.ExpireAt = expireAt
+		return item
+	}
+	err = v1.Set(newExpiring("value1a"))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	err = v1.Set(newExpiring("value1b"))
+	require.NoError(t, err)
+	err = v2.Set(newExpiring("value1c"))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	// Last writer wins
+	err = v2.Resolve("key1", vault.LastWriterWins)
+	require.NoError(t, err)
+	items, err := v2.Items(vault.Expiring(time.Hour * 24))
+	require.NoError(t, err)
+	require.Equal(t, []string{"key1"}, itemIDs(items))
+	require.Equal(t, tsutil.Millis(expireAt), tsutil.Millis(items[0].ExpireAt))
+
+	// Keep both
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v1.Set(newExpiring("value1d"))
+	require.NoError(t, err)
+	err = v2.Set(newExpiring("value1e"))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Resolve("key1", vault.KeepBoth)
+	require.NoError(t, err)
+	items, err = v2.Items(vault.Expiring(time.Hour * 24))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+	for _, item := range items {
+		require.Equal(t, tsutil.Millis(expireAt), tsutil.Millis(item.ExpireAt))
+	}
+}
+
+func TestConflictResolver(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+
+	v1, v2, closeFn := newTestConflictVaults(t, env, vault.WithConflictResolver(vault.KeepBoth))
+	defer closeFn()
+
+	err = v1.Set(vault.NewItem("key1", []byte("value1a"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	err = v1.Set(vault.NewItem("key1", []byte("value1b"), "", time.Now()))
+	require.NoError(t, err)
+	err = v2.Set(vault.NewItem("key1", []byte("value1c"), "", time.Now()))
+	require.NoError(t, err)
+
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	// Both resolve to the same items
+	for _, v := range []*vault.Vault{v1, v2} {
+		conflicts, err := v.Conflicts()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(conflicts))
+
+		items, err := v.Items()
+		require.NoError(t, err)
+		require.Equal(t, 2, len(items))
+		out, err := v.Get("key1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1c"), out.Data)
+	}
+}
+
+func TestConflictDelete(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+
+	v1, v2, closeFn := newTestConflictVaults(t, env)
+	defer closeFn()
+
+	err = v1.Set(vault.NewItem("key1", []byte("value1a"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	err = v1.Set(vault.NewItem("key1", []byte("value1b"), "", time.Now()))
+	require.NoError(t, err)
+	_, err = v2.Delete("key1")
+	require.NoError(t, err)
+
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	out, err := v2.Get("key1")
+	require.NoError(t, err)
+	require.Nil(t, out)
+
+	conflicts, err := v2.Conflicts()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(conflicts))
+	require.Nil(t, conflicts[0].Incoming.Data)
+
+	// Keep both restores the deleted item
+	err = v2.Resolve("key1", vault.KeepBoth)
+	require.NoError(t, err)
+	out, err = v2.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1b"), out.Data)
+}
@@ -57,7 +57,10 @@ func TestConvertV0(t *testing.T) {
 	paths, err := vaultPaths(vlt, "")
 	require.NoError(t, err)
 	expected := []string{
+		"/audit/000000000000001",
+		"/audit/000000000000002",
 		"/auth/v0",
+		"/config/audit",
 		"/config/salt",
 		"/provision/v0",
 		"/push/000000000000001/auth/v0",
@@ -124,8 +127,12 @@ func TestConvertV1(t *testing.T) {
 	paths, err := vaultPaths(vlt, "")
 	require.NoError(t, err)
 	expected := []string{
+		"/audit/000000000000001",
+		"/audit/000000000000002",
 		"/auth/0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29",
+		"/config/audit",
 		"/config/salt",
+		"/history/key1/push/000000000000004",
 		"/item/key1",
 		"/provision/0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29",
 		"/push/000000000000001/auth/0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29",
@@ -11,7 +11,10 @@ func Copy(from Store, to Store, opt ...CopyOption) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return copyEntries(entries, to, opts)
+}
 
+func copyEntries(entries []*Entry, to Store, opts CopyOptions) ([]string, error) {
 	added := []string{}
 	for _, entry := range entries {
 		path, b := entry.Path, entry.Data
@@ -44,7 +44,11 @@ func TestCopy(t *testing.T) {
 
 	// Copy
 	expected := []string{
+		"/audit/000000000000001",
+		"/audit/000000000000002",
 		"/auth/0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29",
+		"/config/audit",
+		"/history/0TWD4V5tkyUQGc5qXvlBDd2Fj97aqsMoBGJJjsttG4I/push/000000000000003",
 		"/item/0TWD4V5tkyUQGc5qXvlBDd2Fj97aqsMoBGJJjsttG4I",
 		"/provision/0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29",
 		"/push/000000000000001/auth/0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29",
@@ -67,7 +71,7 @@ func TestCopy(t *testing.T) {
 
 	// Copy (again)
 	_, err = vault.Copy(st, st2)
-	require.EqualError(t, err, "failed to copy: already exists /auth/0El6XFXwsUFD8J2vGxsaboW7rZYnQRBP5d9erwRwd29")
+	require.EqualError(t, err, "failed to copy: already exists /audit/000000000000001")
 
 	// Copy (skip existing)
 	paths, err = vault.Copy(st, st2, vault.SkipExisting())
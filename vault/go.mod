@@ -7,7 +7,7 @@ require (
 	github.com/golang/protobuf v1.4.3 // indirect
 	github.com/golang/snappy v0.0.2 // indirect
 	github.com/keys-pub/keys v0.1.18-0.20201124170605-b802bea21f73
-	github.com/keys-pub/keys-ext/http/api v0.0.0-20201120215311-661239608411
+	github.com/keys-pub/keys-ext/http/api v0.0.0-20201124173412-72095c733b73
 	github.com/keys-pub/keys-ext/http/client v0.0.0-20201120220010-3d9f67cb9121
 	github.com/keys-pub/keys-ext/http/server v0.0.0-20201120215828-874010c80395
 	github.com/pkg/errors v0.9.1
@@ -16,14 +16,16 @@ require (
 	github.com/syndtr/goleveldb v1.0.0
 	github.com/vmihailenco/msgpack/v4 v4.3.12
 	github.com/vmihailenco/tagparser v0.1.2 // indirect
-	golang.org/x/crypto v0.0.0-20201117144127-c1f2f97bffc9
+	golang.org/x/crypto v0.0.0-20201124201722-c8d3bf9c5392
 	google.golang.org/appengine v1.6.7 // indirect
 )
 
 // replace github.com/keys-pub/keys => ../../keys
 
-// replace github.com/keys-pub/keys-ext/http/api => ../http/api
+replace github.com/keys-pub/keys-ext/http/api => ../http/api
 
-// replace github.com/keys-pub/keys-ext/http/client => ../http/client
+replace github.com/keys-pub/keys-ext/http/client => ../http/client
 
-// replace github.com/keys-pub/keys-ext/http/server => ../http/server
+replace github.com/keys-pub/keys-ext/http/server => ../http/server
+
+replace github.com/keys-pub/keys-ext/ws/api => ../ws/api
@@ -1,80 +1,168 @@
 package vault
 
 import (
-	"strings"
-
 	"github.com/keys-pub/keys/dstore"
 	"github.com/keys-pub/keys/dstore/events"
+	"github.com/pkg/errors"
 	"github.com/vmihailenco/msgpack/v4"
 )
 
+// The history index has an entry for each item change in the pull and push
+// logs, so we can find the history for an item without scanning the logs.
+//
+//   /history/{id}/pull/{index} => /pull/{index}/item/{id}
+//   /history/{id}/push/{index} => /push/{index}/item/{id}
+//   /history/{id}/rpull/{name}.{index} => /rpull/{name}.{index}/item/{id}
+//
+// Pull entries are listed before push (pending) entries, and then entries
+// pulled from additional remotes.
+
 // ItemHistory returns history of an item.
 // Items with empty data are deleted items.
-// This is slow.
+// Requires Unlock.
 func (v *Vault) ItemHistory(id string) ([]*Item, error) {
-	path := dstore.Path("pull")
-	ds, err := v.store.List(&ListOptions{Prefix: path, NoData: true})
-	if err != nil {
+	if id == "" {
+		return nil, errors.Errorf("invalid id")
+	}
+	if err := v.checkHistoryIndex(); err != nil {
 		return nil, err
 	}
-	paths := []string{}
-	for _, doc := range ds {
-		if strings.HasPrefix(dstore.PathFrom(doc.Path, 2), dstore.Path("item", id)) {
-			paths = append(paths, doc.Path)
-		}
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("history", id) + "/"})
+	if err != nil {
+		return nil, err
 	}
-
-	items := make([]*Item, 0, len(paths))
-	for _, p := range paths {
-		b, err := v.store.Get(p)
+	items := make([]*Item, 0, len(entries))
+	for _, entry := range entries {
+		item, err := v.logItem(string(entry.Data), id)
 		if err != nil {
 			return nil, err
 		}
-		if b == nil {
+		if item == nil {
 			continue
 		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Restore item to a version from its history.
+// If there is more than one change with that version (from a conflict), the
+// last one is restored.
+// The restored item is saved as a new version.
+// Requires Unlock.
+func (v *Vault) Restore(id string, version int64) (*Item, error) {
+	if v.mk == nil {
+		return nil, ErrLocked
+	}
+	history, err := v.ItemHistory(id)
+	if err != nil {
+		return nil, err
+	}
+	var found *Item
+	for _, item := range history {
+		if item.Version == version {
+			found = item
+		}
+	}
+	if found == nil {
+		return nil, errors.Errorf("version %d not found for %s", version, id)
+	}
+	logger.Infof("Restoring %s (version %d)", id, version)
+	item := copyItem(found)
+	if err := v.Set(item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// logItem returns item from a pull or push log entry, or nil if the entry is
+// no longer there.
+func (v *Vault) logItem(path string, id string) (*Item, error) {
+	b, err := v.store.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, nil
+	}
+	if col := dstore.PathFirst(path); col == "pull" || col == "rpull" {
 		var event events.Event
 		if err := msgpack.Unmarshal(b, &event); err != nil {
 			return nil, err
 		}
-		id := dstore.PathLast(p)
-		item, err := decryptItem(event.Data, v.mk, id)
-		if err != nil {
-			return nil, err
+		b = event.Data
+	}
+	return decryptItem(b, v.mk, id)
+}
+
+// historyPath returns the history index path for a pull or push log path, or
+// "" if the log entry isn't for an item.
+func historyPath(path string) string {
+	pc := dstore.PathComponents(path)
+	if len(pc) != 4 || pc[2] != "item" {
+		return ""
+	}
+	return dstore.Path("history", pc[3], pc[0], pc[1])
+}
+
+func (v *Vault) setHistory(path string) error {
+	hist := historyPath(path)
+	if hist == "" {
+		return nil
+	}
+	return v.store.Set(hist, []byte(path))
+}
+
+// deleteLog removes pull or push log entries (and their history index).
+func (v *Vault) deleteLog(paths []string) error {
+	for _, path := range paths {
+		if _, err := v.store.Delete(path); err != nil {
+			return err
+		}
+		if hist := historyPath(path); hist != "" {
+			if _, err := v.store.Delete(hist); err != nil {
+				return err
+			}
 		}
-		items = append(items, item)
 	}
+	return nil
+}
 
-	pending, err := v.findPendingItems(id)
+// checkHistoryIndex builds the history index, if it was never built (for
+// vaults from before there was an index).
+func (v *Vault) checkHistoryIndex() error {
+	indexed, err := v.getBool("/sync/historyIndex")
 	if err != nil {
-		return nil, err
+		return err
 	}
-	items = append(items, pending...)
-
-	return items, nil
+	if indexed {
+		return nil
+	}
+	return v.reindexHistory()
 }
 
-// findPendingItems returns list of pending items awaiting push.
-// Requires Unlock.
-func (v *Vault) findPendingItems(id string) ([]*Item, error) {
-	path := dstore.Path("push")
-	entries, err := v.store.List(&ListOptions{Prefix: path})
+// reindexHistory rebuilds the history index from the pull and push logs.
+func (v *Vault) reindexHistory() error {
+	logger.Infof("Indexing history...")
+	hist, err := v.store.List(&ListOptions{Prefix: dstore.Path("history"), NoData: true})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	items := []*Item{}
-	for _, entry := range entries {
-		pc := dstore.PathComponents(entry.Path)
-		if pc[2] != "item" || pc[3] != id {
-			continue
+	for _, entry := range hist {
+		if _, err := v.store.Delete(entry.Path); err != nil {
+			return err
 		}
-		item, err := decryptItem(entry.Data, v.mk, id)
+	}
+	for _, col := range []string{"pull", "push", "rpull"} {
+		entries, err := v.store.List(&ListOptions{Prefix: dstore.Path(col), NoData: true})
 		if err != nil {
-			return nil, err
+			return err
 		}
-		if item.ID == id {
-			items = append(items, item)
+		for _, entry := range entries {
+			if err := v.setHistory(entry.Path); err != nil {
+				return err
+			}
 		}
 	}
-	return items, nil
+	return v.setBool("/sync/historyIndex", true)
 }
@@ -0,0 +1,116 @@
+package vault_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestore(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	vlt.SetClient(testClient(t, env))
+
+	err = vlt.Set(vault.NewItem("key1", []byte("value1a"), "", time.Now()))
+	require.NoError(t, err)
+	err = vlt.Set(vault.NewItem("key1", []byte("value1b"), "", time.Now()))
+	require.NoError(t, err)
+	err = vlt.Sync(ctx)
+	require.NoError(t, err)
+	_, err = vlt.Delete("key1")
+	require.NoError(t, err)
+
+	history, err := vlt.ItemHistory("key1")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(history))
+	require.Equal(t, int64(1), history[0].Version)
+	require.Equal(t, int64(2), history[1].Version)
+	require.Equal(t, int64(3), history[2].Version)
+	require.Nil(t, history[2].Data)
+	require.False(t, history[0].UpdatedAt.IsZero())
+
+	// Restore (deleted)
+	out, err := vlt.Restore("key1", 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1a"), out.Data)
+	require.Equal(t, int64(4), out.Version)
+
+	item, err := vlt.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1a"), item.Data)
+
+	history, err = vlt.ItemHistory("key1")
+	require.NoError(t, err)
+	require.Equal(t, 4, len(history))
+
+	_, err = vlt.Restore("key1", 10)
+	require.EqualError(t, err, "version 10 not found for key1")
+
+	vlt.Lock()
+	_, err = vlt.Restore("key1", 1)
+	require.Equal(t, vault.ErrLocked, err)
+}
+
+func TestRestoreExpiring(t *testing.T) {
+	var err error
+	clock := tsutil.NewTestClock()
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+
+	expireAt := clock.Now().Add(time.Hour)
+	item := vault.NewItem("key1", []byte("value1a"), "", clock.Now())
+	item.ExpireAt = expireAt
+	err = vlt.Set(item)
+	require.NoError(t, err)
+	item = vault.NewItem("key1", []byte("value1b"), "", clock.Now())
+	item.ExpireAt = expireAt
+	err = vlt.Set(item)
+	require.NoError(t, err)
+
+	out, err := vlt.Restore("key1", 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1a"), out.Data)
+
+	items, err := vlt.Items(vault.Expiring(time.Hour * 24))
+	require.NoError(t, err)
+	require.Equal(t, []string{"key1"}, itemIDs(items))
+	require.Equal(t, tsutil.Millis(expireAt), tsutil.Millis(items[0].ExpireAt))
+}
+
+func TestHistoryReindex(t *testing.T) {
+	var err error
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn()
+
+	err = vlt.Set(vault.NewItem("key1", []byte("value1a"), "", time.Now()))
+	require.NoError(t, err)
+	err = vlt.Set(vault.NewItem("key1", []byte("value1b"), "", time.Now()))
+	require.NoError(t, err)
+
+	// Remove the index (as if from before there was an index)
+	paths, err := vaultPaths(vlt, "/history")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(paths))
+	for _, path := range paths {
+		_, err = vlt.Store().Delete(path)
+		require.NoError(t, err)
+	}
+	_, err = vlt.Store().Delete("/sync/historyIndex")
+	require.NoError(t, err)
+
+	history, err := vlt.ItemHistory("key1")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	require.Equal(t, []byte("value1a"), history[0].Data)
+	require.Equal(t, []byte("value1b"), history[1].Data)
+}
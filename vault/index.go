@@ -0,0 +1,596 @@
+package vault
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+// The search index is an inverted index (term => item IDs) in the Store,
+// encrypted with the master key.
+//
+//   /index/ready            Set if the index is built
+//   /index/terms/{hmac}     Term, by HMAC of term
+//   /index/postings/{hmac}  Term and item IDs, by HMAC of term
+//   /index/items/{id}       Terms for item (to update postings on change)
+//
+// Terms and postings are at the HMAC of the term, so terms aren't in the
+// (unencrypted) paths. Each term is a separate entry, so an item change only
+// updates the entries for the terms that changed.
+//
+// The index is built on the first Search, and then kept up to date on Set,
+// Delete and pull. If items are pulled while locked, the index is rebuilt on
+// the next Search.
+//
+// The (decrypted) terms are kept in memory while unlocked, so Search doesn't
+// decrypt every term.
+
+// Indexer returns the search terms for an item.
+// Terms are tokenized (see IndexTokens), so they can be names, URLs etc.
+type Indexer func(item *Item) ([]string, error)
+
+type indexPosting struct {
+	Term string   `msgpack:"t"`
+	IDs  []string `msgpack:"ids"`
+
+	// exists if the posting was saved (has IDs)
+	exists bool
+}
+
+func indexKeyItem(item *Item) ([]string, error) {
+	key, err := item.Key()
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, nil
+	}
+	return []string{key.ID.String(), key.Type, key.Notes}, nil
+}
+
+// IndexTokens returns the (lower case) tokens for search text, split on
+// anything that isn't a letter or number.
+func IndexTokens(s string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return dedupe(fields)
+}
+
+func dedupe(strs []string) []string {
+	out := make([]string, 0, len(strs))
+	seen := map[string]bool{}
+	for _, s := range strs {
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	return out
+}
+
+// Search items by query.
+// Each term in the query has to match (a prefix of) an indexed term, or
+// nearly match (fuzzy), for longer terms, or with SearchContains, be contained
+// in an indexed term. Items are returned in ID order.
+// Only item types with an Indexer are searched, see WithIndexer.
+// Requires Unlock.
+func (v *Vault) Search(query string, opt ...SearchOption) ([]*Item, error) {
+	opts := newSearchOptions(opt...)
+	if v.mk == nil {
+		return nil, ErrLocked
+	}
+	tokens := IndexTokens(query)
+	if len(tokens) == 0 {
+		return []*Item{}, nil
+	}
+
+	v.indexMtx.Lock()
+	defer v.indexMtx.Unlock()
+
+	if err := v.checkIndex(); err != nil {
+		return nil, err
+	}
+	dict, err := v.indexTerms()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids map[string]bool
+	for _, token := range tokens {
+		matched := map[string]bool{}
+		var terms []string
+		if opts.Contains {
+			terms = containsTerms(dict, token)
+		} else {
+			terms = matchTerms(dict, token)
+		}
+		for _, term := range terms {
+			posting, err := v.indexPosting(term)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range posting.IDs {
+				if ids == nil || ids[id] {
+					matched[id] = true
+				}
+			}
+		}
+		ids = matched
+		if len(ids) == 0 {
+			break
+		}
+	}
+
+	out := make([]*Item, 0, len(ids))
+	for id := range ids {
+		item, err := v.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil || !opts.hasType(item.Type) {
+			continue
+		}
+		out = append(out, item)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+// Indexes returns true if items of type are indexed (searchable).
+func (v *Vault) Indexes(typ string) bool {
+	_, ok := v.indexers[typ]
+	return ok
+}
+
+// Reindex rebuilds the search index.
+// Requires Unlock.
+func (v *Vault) Reindex() error {
+	if v.mk == nil {
+		return ErrLocked
+	}
+	v.indexMtx.Lock()
+	defer v.indexMtx.Unlock()
+	return v.reindex()
+}
+
+// checkIndex builds the index if it isn't ready.
+func (v *Vault) checkIndex() error {
+	ready, err := v.getBool("/index/ready")
+	if err != nil {
+		return err
+	}
+	if ready {
+		return nil
+	}
+	return v.reindex()
+}
+
+func (v *Vault) reindex() error {
+	logger.Infof("Indexing...")
+	if err := v.clearIndex(); err != nil {
+		return err
+	}
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("item")})
+	if err != nil {
+		return err
+	}
+	idx := newIndexUpdate(v)
+	for _, entry := range entries {
+		id := dstore.PathLast(entry.Path)
+		item, err := decryptItem(entry.Data, v.mk, id)
+		if err != nil {
+			return err
+		}
+		if err := idx.set(item); err != nil {
+			return err
+		}
+	}
+	if err := idx.save(); err != nil {
+		return err
+	}
+	logger.Infof("Indexed %d items", len(entries))
+	return v.setBool("/index/ready", true)
+}
+
+// clearIndex removes the index, it is rebuilt on the next Search.
+func (v *Vault) clearIndex() error {
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("index"), NoData: true})
+	if err != nil {
+		return err
+	}
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		paths = append(paths, entry.Path)
+	}
+	v.indexDict = nil
+	return deleteAll(v.store, paths)
+}
+
+// clearIndexTerms removes the (decrypted) terms from memory, on Lock.
+func (v *Vault) clearIndexTerms() {
+	v.indexMtx.Lock()
+	defer v.indexMtx.Unlock()
+	v.indexDict = nil
+}
+
+// index updates the search index for an item change, if the index is built.
+// If locked, the index is cleared (to rebuild on the next Search).
+func (v *Vault) index(item *Item) error {
+	v.indexMtx.Lock()
+	defer v.indexMtx.Unlock()
+	ready, err := v.getBool("/index/ready")
+	if err != nil {
+		return err
+	}
+	if !ready {
+		return nil
+	}
+	if v.mk == nil {
+		return v.clearIndex()
+	}
+	idx := newIndexUpdate(v)
+	if err := idx.set(item); err != nil {
+		return err
+	}
+	return idx.save()
+}
+
+// indexPulled updates the search index for a pulled item.
+// The item may have been resolved (with a conflict), so we use the current
+// item.
+func (v *Vault) indexPulled(id string) error {
+	if v.mk == nil {
+		return v.index(&Item{ID: id})
+	}
+	b, err := v.store.Get(dstore.Path("item", id))
+	if err != nil {
+		return err
+	}
+	if b == nil {
+		return v.index(&Item{ID: id})
+	}
+	item, err := decryptItem(b, v.mk, id)
+	if err != nil {
+		return err
+	}
+	return v.index(item)
+}
+
+// indexUpdate batches changes to the index terms and postings.
+type indexUpdate struct {
+	v        *Vault
+	postings map[string]*indexPosting
+}
+
+func newIndexUpdate(v *Vault) *indexUpdate {
+	return &indexUpdate{v: v, postings: map[string]*indexPosting{}}
+}
+
+// set item terms (or remove them, for a deleted item).
+func (u *indexUpdate) set(item *Item) error {
+	prev, err := u.v.indexItemTerms(item.ID)
+	if err != nil {
+		return err
+	}
+	terms, err := u.v.itemTerms(item)
+	if err != nil {
+		return err
+	}
+	added, removed := diffTerms(prev, terms)
+	for _, term := range removed {
+		posting, err := u.posting(term)
+		if err != nil {
+			return err
+		}
+		posting.IDs = removeID(posting.IDs, item.ID)
+	}
+	for _, term := range added {
+		posting, err := u.posting(term)
+		if err != nil {
+			return err
+		}
+		posting.IDs = append(posting.IDs, item.ID)
+	}
+
+	path := dstore.Path("index", "items", item.ID)
+	if len(terms) == 0 {
+		_, err := u.v.store.Delete(path)
+		return err
+	}
+	b, err := msgpack.Marshal(terms)
+	if err != nil {
+		return err
+	}
+	return u.v.store.Set(path, secretBoxSeal(b, u.v.mk))
+}
+
+func (u *indexUpdate) posting(term string) (*indexPosting, error) {
+	if p, ok := u.postings[term]; ok {
+		return p, nil
+	}
+	p, err := u.v.indexPosting(term)
+	if err != nil {
+		return nil, err
+	}
+	u.postings[term] = p
+	return p, nil
+}
+
+// save changed postings and terms.
+func (u *indexUpdate) save() error {
+	for term, posting := range u.postings {
+		key := u.v.indexTermKey(term)
+		path := dstore.Path("index", "postings", key)
+		termPath := dstore.Path("index", "terms", key)
+		if len(posting.IDs) == 0 {
+			if !posting.exists {
+				continue
+			}
+			if _, err := u.v.store.Delete(path); err != nil {
+				return err
+			}
+			if _, err := u.v.store.Delete(termPath); err != nil {
+				return err
+			}
+			u.v.indexDict = removeTerm(u.v.indexDict, term)
+			continue
+		}
+		b, err := msgpack.Marshal(posting)
+		if err != nil {
+			return err
+		}
+		if err := u.v.store.Set(path, secretBoxSeal(b, u.v.mk)); err != nil {
+			return err
+		}
+		if !posting.exists {
+			if err := u.v.store.Set(termPath, secretBoxSeal([]byte(term), u.v.mk)); err != nil {
+				return err
+			}
+			u.v.indexDict = insertTerm(u.v.indexDict, term)
+		}
+	}
+	return nil
+}
+
+// itemTerms returns the index terms for an item, from its Indexer.
+func (v *Vault) itemTerms(item *Item) ([]string, error) {
+	if len(item.Data) == 0 {
+		return nil, nil
+	}
+	indexer, ok := v.indexers[item.Type]
+	if !ok {
+		return nil, nil
+	}
+	strs, err := indexer(item)
+	if err != nil {
+		return nil, err
+	}
+	terms := []string{}
+	for _, s := range strs {
+		terms = append(terms, IndexTokens(s)...)
+	}
+	return dedupe(terms), nil
+}
+
+// indexTerms returns the (sorted) indexed terms.
+// The terms are loaded from the store on first use, and then kept up to date
+// by indexUpdate.
+func (v *Vault) indexTerms() ([]string, error) {
+	if v.indexDict != nil {
+		return v.indexDict, nil
+	}
+	terms, err := v.loadIndexTerms()
+	if err != nil {
+		return nil, err
+	}
+	v.indexDict = terms
+	return terms, nil
+}
+
+// loadIndexTerms decrypts the (sorted) indexed terms from the store.
+func (v *Vault) loadIndexTerms() ([]string, error) {
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("index", "terms") + "/"})
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		term, ok := secretBoxOpen(entry.Data, v.mk)
+		if !ok {
+			return nil, errors.Errorf("failed to decrypt index %s", entry.Path)
+		}
+		terms = append(terms, string(term))
+	}
+	sort.Strings(terms)
+	return terms, nil
+}
+
+func (v *Vault) indexItemTerms(id string) ([]string, error) {
+	var terms []string
+	if err := v.indexGet(dstore.Path("index", "items", id), &terms); err != nil {
+		return nil, err
+	}
+	return terms, nil
+}
+
+func (v *Vault) indexPosting(term string) (*indexPosting, error) {
+	var posting indexPosting
+	if err := v.indexGet(dstore.Path("index", "postings", v.indexTermKey(term)), &posting); err != nil {
+		return nil, err
+	}
+	posting.Term = term
+	posting.exists = len(posting.IDs) > 0
+	return &posting, nil
+}
+
+func (v *Vault) indexGet(path string, i interface{}) error {
+	b, err := v.store.Get(path)
+	if err != nil {
+		return err
+	}
+	if b == nil {
+		return nil
+	}
+	decrypted, ok := secretBoxOpen(b, v.mk)
+	if !ok {
+		return errors.Errorf("failed to decrypt index %s", path)
+	}
+	return msgpack.Unmarshal(decrypted, i)
+}
+
+// indexTermKey is the HMAC of the term (for paths).
+func (v *Vault) indexTermKey(term string) string {
+	ik := keys.HKDFSHA256(v.mk[:], 32, nil, []byte("keys.pub/index"))
+	h := hmac.New(sha256.New, ik)
+	_, _ = h.Write([]byte(term))
+	return encoding.MustEncode(h.Sum(nil), encoding.Base62)
+}
+
+func diffTerms(prev []string, terms []string) ([]string, []string) {
+	prevm := map[string]bool{}
+	for _, t := range prev {
+		prevm[t] = true
+	}
+	termsm := map[string]bool{}
+	added := []string{}
+	for _, t := range terms {
+		termsm[t] = true
+		if !prevm[t] {
+			added = append(added, t)
+		}
+	}
+	removed := []string{}
+	for _, t := range prev {
+		if !termsm[t] {
+			removed = append(removed, t)
+		}
+	}
+	return added, removed
+}
+
+// insertTerm adds a term to the (sorted) terms, if loaded.
+func insertTerm(dict []string, term string) []string {
+	if dict == nil {
+		return nil
+	}
+	i := sort.SearchStrings(dict, term)
+	if i < len(dict) && dict[i] == term {
+		return dict
+	}
+	dict = append(dict, "")
+	copy(dict[i+1:], dict[i:])
+	dict[i] = term
+	return dict
+}
+
+// removeTerm removes a term from the (sorted) terms, if loaded.
+func removeTerm(dict []string, term string) []string {
+	i := sort.SearchStrings(dict, term)
+	if i == len(dict) || dict[i] != term {
+		return dict
+	}
+	return append(dict[:i], dict[i+1:]...)
+}
+
+func removeID(ids []string, id string) []string {
+	out := make([]string, 0, len(ids))
+	for _, i := range ids {
+		if i != id {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// matchTerms returns terms (sorted) with the token as a prefix, or that
+// nearly match (see fuzzyMatch).
+func matchTerms(dict []string, token string) []string {
+	out := []string{}
+	i := sort.SearchStrings(dict, token)
+	for ; i < len(dict) && strings.HasPrefix(dict[i], token); i++ {
+		out = append(out, dict[i])
+	}
+	for _, term := range dict {
+		if !strings.HasPrefix(term, token) && fuzzyMatch(term, token) {
+			out = append(out, term)
+		}
+	}
+	return out
+}
+
+// containsTerms returns terms (sorted) that contain the token.
+func containsTerms(dict []string, token string) []string {
+	out := []string{}
+	for _, term := range dict {
+		if strings.Contains(term, token) {
+			out = append(out, term)
+		}
+	}
+	return out
+}
+
+// fuzzyMatch returns true if the token is within an edit distance of the term
+// (or the term prefix of the same length). The distance allowed is 1 for
+// tokens with at least 4 characters and 2 for 8 or more.
+func fuzzyMatch(term string, token string) bool {
+	t, s := []rune(term), []rune(token)
+	max := 0
+	switch {
+	case len(s) >= 8:
+		max = 2
+	case len(s) >= 4:
+		max = 1
+	default:
+		return false
+	}
+	if len(t) > len(s) && editDistance(t[:len(s)], s) <= max {
+		return true
+	}
+	return editDistance(t, s) <= max
+}
+
+// editDistance is the (optimal string alignment) edit distance, where
+// insertions, deletions, substitutions and transpositions count as 1.
+func editDistance(a []rune, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func minInt(n ...int) int {
+	m := n[0]
+	for _, i := range n[1:] {
+		if i < m {
+			m = i
+		}
+	}
+	return m
+}
@@ -0,0 +1,257 @@
+package vault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+// indexNote indexes the item data (for "note" items).
+func indexNote(item *vault.Item) ([]string, error) {
+	return []string{string(item.Data)}, nil
+}
+
+func newTestIndexVault(t *testing.T, env *testEnv) (*vault.Vault, func()) {
+	st, closeFn := newTestMem(t)
+	clock := tsutil.NewTestClock()
+	vlt := vault.New(st, vault.WithClock(clock), vault.WithIndexer("note", indexNote))
+	if env != nil {
+		vlt.SetClient(testClient(t, env))
+	}
+	key, provision := NewTestVaultKey(t, clock)
+	err := vlt.Setup(key, provision)
+	require.NoError(t, err)
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
+	return vlt, closeFn
+}
+
+func searchIDs(t *testing.T, vlt *vault.Vault, query string, opt ...vault.SearchOption) []string {
+	items, err := vlt.Search(query, opt...)
+	require.NoError(t, err)
+	return itemIDs(items)
+}
+
+func TestSearch(t *testing.T) {
+	vlt, closeFn := newTestIndexVault(t, nil)
+	defer closeFn()
+
+	err := vlt.Set(vault.NewItem("note1", []byte("GitHub https://github.com alice"), "note", time.Now()))
+	require.NoError(t, err)
+	err = vlt.Set(vault.NewItem("note2", []byte("GitLab https://gitlab.com bob"), "note", time.Now()))
+	require.NoError(t, err)
+	err = vlt.Set(vault.NewItem("other", []byte("github"), "other", time.Now()))
+	require.NoError(t, err)
+
+	// Index is built on first search
+	paths, err := vaultPaths(vlt, "/index")
+	require.NoError(t, err)
+	require.Empty(t, paths)
+
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "github"))
+	require.Equal(t, []string{"note1", "note2"}, searchIDs(t, vlt, "git"))
+	require.Equal(t, []string{"note2"}, searchIDs(t, vlt, "GIT bob"))
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "github.com"))
+	require.Equal(t, []string{}, searchIDs(t, vlt, "hub"))
+	require.Equal(t, []string{}, searchIDs(t, vlt, "github bob"))
+	require.Equal(t, []string{}, searchIDs(t, vlt, ""))
+	// Fuzzy
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "githbu"))
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "alcie"))
+	require.Equal(t, []string{}, searchIDs(t, vlt, "blcie"))
+	// Contains
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "hub", vault.SearchContains()))
+	require.Equal(t, []string{"note1", "note2"}, searchIDs(t, vlt, "it", vault.SearchContains()))
+	require.Equal(t, []string{}, searchIDs(t, vlt, "githbu", vault.SearchContains()))
+	// Types
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "github", vault.SearchTypes("note")))
+	require.Equal(t, []string{}, searchIDs(t, vlt, "github", vault.SearchTypes("key")))
+
+	// Terms aren't in paths
+	paths, err = vaultPaths(vlt, "/index")
+	require.NoError(t, err)
+	for _, p := range paths {
+		require.NotContains(t, p, "github")
+	}
+	// Entry for each term
+	terms, err := vaultPaths(vlt, "/index/terms")
+	require.NoError(t, err)
+	require.Equal(t, 6, len(terms))
+
+	// Update
+	err = vlt.Set(vault.NewItem("note1", []byte("Bitbucket alice"), "note", time.Now()))
+	require.NoError(t, err)
+	require.Equal(t, []string{"note2"}, searchIDs(t, vlt, "git"))
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "bitbucket"))
+
+	// Delete
+	_, err = vlt.Delete("note2")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, searchIDs(t, vlt, "git"))
+	terms, err = vaultPaths(vlt, "/index/terms")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(terms))
+
+	// Reindex
+	err = vlt.Reindex()
+	require.NoError(t, err)
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "alice"))
+
+	vlt.Lock()
+	_, err = vlt.Search("alice")
+	require.Equal(t, vault.ErrLocked, err)
+}
+
+func TestSearchTerms(t *testing.T) {
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+
+	vlt, closeFn := newTestIndexVault(t, env)
+	defer closeFn()
+	key, _ := NewTestVaultKey(t, tsutil.NewTestClock())
+
+	err := vlt.Set(vault.NewItem("note1", []byte("GitHub alice"), "note", time.Now()))
+	require.NoError(t, err)
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "git"))
+
+	// Terms (in memory) are updated on Set
+	err = vlt.Set(vault.NewItem("note2", []byte("GitLab bob"), "note", time.Now()))
+	require.NoError(t, err)
+	require.Equal(t, []string{"note1", "note2"}, searchIDs(t, vlt, "git"))
+	err = vlt.Set(vault.NewItem("note1", []byte("Bitbucket alice"), "note", time.Now()))
+	require.NoError(t, err)
+	require.Equal(t, []string{"note2"}, searchIDs(t, vlt, "git"))
+	vault.ClearIndexTerms(vlt)
+	require.Equal(t, []string{"note2"}, searchIDs(t, vlt, "git"))
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "bit"))
+
+	// Lock, unlock
+	vlt.Lock()
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "alice"))
+
+	// Rotate
+	err = vlt.Sync(ctx)
+	require.NoError(t, err)
+	err = vlt.RotateMasterKey(ctx, []*[32]byte{key}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"note1"}, searchIDs(t, vlt, "alice"))
+	err = vlt.Set(vault.NewItem("note3", []byte("Bitwarden"), "note", time.Now()))
+	require.NoError(t, err)
+	require.Equal(t, []string{"note1", "note3"}, searchIDs(t, vlt, "bit"))
+}
+
+func TestSearchKeys(t *testing.T) {
+	vlt, closeFn := newTestIndexVault(t, nil)
+	defer closeFn()
+
+	key := api.NewKey(keys.NewEdX25519KeyFromSeed(testSeed(0x01)))
+	key.Notes = "Work laptop"
+	_, _, err := vlt.SaveKey(key)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{key.ID.String()}, searchIDs(t, vlt, "laptop"))
+	require.Equal(t, []string{key.ID.String()}, searchIDs(t, vlt, key.ID.String()[:8]))
+}
+
+func TestSearchPull(t *testing.T) {
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+
+	v1, closeFn1 := newTestIndexVault(t, env)
+	defer closeFn1()
+	err := v1.Set(vault.NewItem("note1", []byte("github"), "note", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	st2, closeFn2 := newTestMem(t)
+	defer closeFn2()
+	v2 := vault.New(st2, vault.WithIndexer("note", indexNote))
+	v2.SetClient(testClient(t, env))
+	err = v2.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+	key, _ := NewTestVaultKey(t, tsutil.NewTestClock())
+	_, err = v2.Unlock(key)
+	require.NoError(t, err)
+	require.Equal(t, []string{"note1"}, searchIDs(t, v2, "github"))
+
+	// Pull updates the index
+	err = v1.Set(vault.NewItem("note2", []byte("gitlab"), "note", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"note1", "note2"}, searchIDs(t, v2, "git"))
+
+	// Pulled delete
+	_, err = v1.Delete("note1")
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"note2"}, searchIDs(t, v2, "git"))
+}
+
+func BenchmarkSearch(b *testing.B) {
+	vlt, closeFn := newBenchmarkIndexVault(b)
+	defer closeFn()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := vlt.Search("github"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSearchLoadTerms is Search, loading (and decrypting) the terms from
+// the store each time.
+func BenchmarkSearchLoadTerms(b *testing.B) {
+	vlt, closeFn := newBenchmarkIndexVault(b)
+	defer closeFn()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vault.ClearIndexTerms(vlt)
+		if _, err := vlt.Search("github"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newBenchmarkIndexVault(b *testing.B) (*vault.Vault, func()) {
+	mem := vault.NewMem()
+	if err := mem.Open(); err != nil {
+		b.Fatal(err)
+	}
+	clock := tsutil.NewTestClock()
+	vlt := vault.New(mem, vault.WithClock(clock), vault.WithIndexer("note", indexNote))
+	key := keys.Rand32()
+	if err := vlt.Setup(key, &vault.Provision{ID: keys.RandFileName(), CreatedAt: clock.Now()}); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := vlt.Unlock(key); err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		data := fmt.Sprintf("note%d https://example%d.com user%d@example.com", i, i, i)
+		if err := vlt.Set(vault.NewItem(fmt.Sprintf("note%d", i), []byte(data), "note", clock.Now())); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if _, err := vlt.Search("github"); err != nil {
+		b.Fatal(err)
+	}
+	return vlt, func() { _ = vlt.Close() }
+}
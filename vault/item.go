@@ -8,6 +8,8 @@ import (
 )
 
 // Item in the vault.
+// Optional times (UpdatedAt, ExpireAt) are omitted from the encoding if zero,
+// see EncodeMsgpack.
 type Item struct {
 	ID   string `msgpack:"id"`
 	Data []byte `msgpack:"dat"`
@@ -16,8 +18,64 @@ type Item struct {
 	Type string `msgpack:"typ,omitempty"`
 
 	// CreatedAt when item was created.
-	// TODO: Updated at
 	CreatedAt time.Time `msgpack:"cts,omitempty"`
+	// UpdatedAt when item was last changed (locally).
+	UpdatedAt time.Time `msgpack:"uts,omitempty"`
+
+	// Version is incremented each time the item is changed locally.
+	// It is used to detect concurrent modifications (conflicts) when syncing.
+	// Items saved before versioning have version 0.
+	Version int64 `msgpack:"v,omitempty"`
+
+	// ExpireAt (optional) is when the item expires (or should be rotated).
+	ExpireAt time.Time `msgpack:"eat,omitempty"`
+}
+
+// itemFormat is the (msgpack) encoding for an Item.
+// Optional times are pointers, since msgpack omitempty doesn't omit a zero
+// time.Time.
+type itemFormat struct {
+	ID        string     `msgpack:"id"`
+	Data      []byte     `msgpack:"dat"`
+	Type      string     `msgpack:"typ,omitempty"`
+	CreatedAt time.Time  `msgpack:"cts,omitempty"`
+	UpdatedAt *time.Time `msgpack:"uts,omitempty"`
+	Version   int64      `msgpack:"v,omitempty"`
+	ExpireAt  *time.Time `msgpack:"eat,omitempty"`
+}
+
+// itemFields is an Item without the msgpack methods, for decoding.
+type itemFields Item
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// EncodeMsgpack encodes an Item, omitting zero optional times.
+func (i Item) EncodeMsgpack(enc *msgpack.Encoder) error {
+	return enc.Encode(&itemFormat{
+		ID:        i.ID,
+		Data:      i.Data,
+		Type:      i.Type,
+		CreatedAt: i.CreatedAt,
+		UpdatedAt: optionalTime(i.UpdatedAt),
+		Version:   i.Version,
+		ExpireAt:  optionalTime(i.ExpireAt),
+	})
+}
+
+// DecodeMsgpack decodes an Item.
+func (i *Item) DecodeMsgpack(dec *msgpack.Decoder) error {
+	*i = Item{}
+	return dec.Decode((*itemFields)(i))
+}
+
+// IsExpired returns true if item has an expiry before or at t.
+func (i *Item) IsExpired(t time.Time) bool {
+	return !i.ExpireAt.IsZero() && !i.ExpireAt.After(t)
 }
 
 // NewItem creates an item.
@@ -27,6 +27,22 @@ func TestItem(t *testing.T) {
 }
 `
 	require.Equal(t, expected, spew.Sdump(b))
+
+	var out vault.Item
+	err = msgpack.Unmarshal(b, &out)
+	require.NoError(t, err)
+	require.True(t, out.UpdatedAt.IsZero())
+	require.True(t, out.ExpireAt.IsZero())
+
+	// Optional times
+	item.UpdatedAt = clock.Now()
+	item.ExpireAt = clock.Now()
+	b, err = msgpack.Marshal(item)
+	require.NoError(t, err)
+	err = msgpack.Unmarshal(b, &out)
+	require.NoError(t, err)
+	require.True(t, item.UpdatedAt.Equal(out.UpdatedAt))
+	require.True(t, item.ExpireAt.Equal(out.ExpireAt))
 }
 
 func TestLargeItems(t *testing.T) {
@@ -48,3 +64,59 @@ func TestLargeItems(t *testing.T) {
 	require.NotNil(t, item)
 	require.Equal(t, b, item.Data)
 }
+
+func TestItemsExpired(t *testing.T) {
+	var err error
+	clock := tsutil.NewTestClock()
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+
+	now := clock.Now()
+	expired := now.Add(-time.Hour)
+	expiring := now.Add(time.Hour * 24)
+	later := now.Add(time.Hour * 24 * 30)
+
+	err = vlt.Set(vault.NewItem("noexpire", []byte("1"), "", now))
+	require.NoError(t, err)
+	item := vault.NewItem("expired", []byte("2"), "", now)
+	item.ExpireAt = expired
+	err = vlt.Set(item)
+	require.NoError(t, err)
+	item = vault.NewItem("expiring", []byte("3"), "", now)
+	item.ExpireAt = expiring
+	err = vlt.Set(item)
+	require.NoError(t, err)
+	item = vault.NewItem("later", []byte("4"), "", now)
+	item.ExpireAt = later
+	err = vlt.Set(item)
+	require.NoError(t, err)
+
+	out, err := vlt.Get("expired")
+	require.NoError(t, err)
+	require.Equal(t, tsutil.Millis(expired), tsutil.Millis(out.ExpireAt))
+	require.True(t, out.IsExpired(clock.Now()))
+
+	items, err := vlt.Items()
+	require.NoError(t, err)
+	require.Equal(t, 4, len(items))
+
+	items, err = vlt.Items(vault.SkipExpired())
+	require.NoError(t, err)
+	require.Equal(t, []string{"expiring", "later", "noexpire"}, itemIDs(items))
+
+	items, err = vlt.Items(vault.Expiring(0))
+	require.NoError(t, err)
+	require.Equal(t, []string{"expired"}, itemIDs(items))
+
+	items, err = vlt.Items(vault.Expiring(time.Hour * 24 * 7))
+	require.NoError(t, err)
+	require.Equal(t, []string{"expired", "expiring"}, itemIDs(items))
+}
+
+func itemIDs(items []*vault.Item) []string {
+	ids := []string{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
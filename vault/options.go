@@ -1,12 +1,19 @@
 package vault
 
 import (
+	"time"
+
 	"github.com/keys-pub/keys/tsutil"
 )
 
 // Options for Vault.
 type Options struct {
 	Clock tsutil.Clock
+	// ConflictResolver, if set, resolves conflicts on pull, otherwise
+	// conflicts are saved until resolved with Vault.Resolve.
+	ConflictResolver ConflictResolver
+	// Indexers by item type, for Search.
+	Indexers map[string]Indexer
 }
 
 // Option for Vault.
@@ -14,7 +21,8 @@ type Option func(*Options)
 
 func newOptions(opts ...Option) Options {
 	options := Options{
-		Clock: tsutil.NewClock(),
+		Clock:    tsutil.NewClock(),
+		Indexers: map[string]Indexer{keyItemType: indexKeyItem},
 	}
 	for _, o := range opts {
 		o(&options)
@@ -28,3 +36,107 @@ func WithClock(clock tsutil.Clock) Option {
 		o.Clock = clock
 	}
 }
+
+// WithConflictResolver to automatically resolve conflicts on pull.
+func WithConflictResolver(resolver ConflictResolver) Option {
+	return func(o *Options) {
+		o.ConflictResolver = resolver
+	}
+}
+
+// WithIndexer to index items of type (for Search).
+func WithIndexer(typ string, indexer Indexer) Option {
+	return func(o *Options) {
+		o.Indexers[typ] = indexer
+	}
+}
+
+// ItemsOption for Vault.Items.
+type ItemsOption func(*ItemsOptions)
+
+// ItemsOptions for Vault.Items.
+type ItemsOptions struct {
+	// SkipExpired to skip expired items.
+	SkipExpired bool
+	// Expiring to only include items with an expiry, that are expired or
+	// expire within ExpiringWithin.
+	Expiring       bool
+	ExpiringWithin time.Duration
+}
+
+func newItemsOptions(opts ...ItemsOption) ItemsOptions {
+	var options ItemsOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+func (o ItemsOptions) match(item *Item, now time.Time) bool {
+	if o.SkipExpired && item.IsExpired(now) {
+		return false
+	}
+	if o.Expiring && (item.ExpireAt.IsZero() || item.ExpireAt.After(now.Add(o.ExpiringWithin))) {
+		return false
+	}
+	return true
+}
+
+// SkipExpired to skip expired items.
+func SkipExpired() ItemsOption {
+	return func(o *ItemsOptions) {
+		o.SkipExpired = true
+	}
+}
+
+// Expiring to only include items that are expired or expire within dt.
+func Expiring(dt time.Duration) ItemsOption {
+	return func(o *ItemsOptions) {
+		o.Expiring = true
+		o.ExpiringWithin = dt
+	}
+}
+
+// SearchOption for Vault.Search.
+type SearchOption func(*SearchOptions)
+
+// SearchOptions for Vault.Search.
+type SearchOptions struct {
+	// Types of items to include (or all if empty).
+	Types []string
+	// Contains matches indexed terms that contain the query terms, instead of
+	// by prefix (or fuzzy).
+	Contains bool
+}
+
+func newSearchOptions(opts ...SearchOption) SearchOptions {
+	var options SearchOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+// SearchTypes only includes items of types.
+func SearchTypes(types ...string) SearchOption {
+	return func(o *SearchOptions) { o.Types = types }
+}
+
+// SearchContains matches indexed terms that contain the query terms, instead
+// of by prefix (or fuzzy). This finds all items with a field containing the
+// query, for example, to filter with a substring match.
+func SearchContains() SearchOption {
+	return func(o *SearchOptions) { o.Contains = true }
+}
+
+func (o SearchOptions) hasType(typ string) bool {
+	if len(o.Types) == 0 {
+		return true
+	}
+	for _, t := range o.Types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
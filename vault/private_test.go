@@ -2,3 +2,9 @@ package vault
 
 // ConvertID for testing.
 var ConvertID = convertID
+
+// SetPullLimit for testing.
+func SetPullLimit(n int) { pullLimit = n }
+
+// ClearIndexTerms for testing (the next Search loads the terms from the store).
+func ClearIndexTerms(v *Vault) { v.clearIndexTerms() }
@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"time"
+
+	httpclient "github.com/keys-pub/keys-ext/http/client"
+)
+
+// pullProgress tracks progress for a pull.
+type pullProgress struct {
+	start      time.Time
+	startIndex int64
+	events     int
+	bytes      int64
+}
+
+func newPullProgress(index int64, start time.Time) *pullProgress {
+	return &pullProgress{start: start, startIndex: index}
+}
+
+// add a pulled page, returning the progress event.
+func (p *pullProgress) add(vault *httpclient.Vault, now time.Time) PullProgressEvent {
+	p.events += len(vault.Events)
+	if vault.Snapshot != nil {
+		p.events += len(vault.Snapshot.Events)
+	}
+	p.bytes += vault.Size
+
+	event := PullProgressEvent{
+		Events: p.events,
+		Bytes:  p.bytes,
+		Index:  vault.Index,
+		Max:    vault.Max,
+	}
+	// Estimate remaining from the rate (by index) so far.
+	pulled := vault.Index - p.startIndex
+	if pulled > 0 && vault.Max > vault.Index {
+		elapsed := now.Sub(p.start)
+		event.Remaining = time.Duration(float64(elapsed) * float64(vault.Max-vault.Index) / float64(pulled))
+	}
+	return event
+}
@@ -23,6 +23,9 @@ type Provision struct {
 	Salt []byte `msgpack:"salt,omitempty" json:"salt"`
 	// NoPin (for FIDO2HMACSecret)
 	NoPin bool `msgpack:"nopin,omitempty" json:"nopin"`
+
+	// Path (for KeyFile) is a hint for where the key file is.
+	Path string `msgpack:"path,omitempty" json:"path,omitempty"`
 }
 
 // NewProvision creates a new provision.
@@ -53,6 +56,7 @@ func (v *Vault) Provision(key *[32]byte, provision *Provision) error {
 			return err
 		}
 	}
+	v.authLog(AuthLogProvision, provision)
 
 	return nil
 }
@@ -94,11 +98,25 @@ func (v *Vault) Deprovision(id string, force bool) (bool, error) {
 		return false, errors.Errorf("failed to deprovision: last auth")
 	}
 
+	provision, err := v.provision(id)
+	if err != nil {
+		return false, err
+	}
 	if _, err = v.provisionDelete(id); err != nil {
 		return false, err
 	}
 
-	return v.authDelete(id)
+	ok, err := v.authDelete(id)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		if provision == nil {
+			provision = &Provision{ID: id}
+		}
+		v.authLog(AuthLogDeprovision, provision)
+	}
+	return ok, nil
 }
 
 // ProvisionSave for auth methods that need to store registration data before
@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"bytes"
 	"context"
 	"net/url"
 	"time"
@@ -10,10 +11,13 @@ import (
 )
 
 // Remote for vault.
+// If URL is nil, the vault client (URL) is used.
 type Remote struct {
 	URL  *url.URL          `json:"url,omitempty"`
 	Key  *keys.EdX25519Key `json:"key"`
 	Salt []byte            `json:"salt"`
+	// Name is set for additional remotes, see AddRemote.
+	Name string `json:"name,omitempty"`
 }
 
 // NewRemote creates a Remote.
@@ -26,11 +30,8 @@ func (v *Vault) Clone(ctx context.Context, remote *Remote) error {
 	if remote == nil {
 		return errors.Errorf("nil remote")
 	}
-	if remote.URL != nil {
-		return errors.Errorf("url not supported")
-	}
-	if v.client == nil {
-		return errors.Errorf("no vault client set")
+	if len(remote.Salt[:]) == 0 {
+		return errors.Errorf("no remote salt")
 	}
 
 	empty, err := v.IsEmpty()
@@ -38,27 +39,35 @@ func (v *Vault) Clone(ctx context.Context, remote *Remote) error {
 		return err
 	}
 	if !empty {
-		return errors.Errorf("vault not empty, can only be initialized if empty")
+		// If a previous clone was interrupted, resume it.
+		resume, err := v.isCloning(remote)
+		if err != nil {
+			return err
+		}
+		if !resume {
+			return errors.Errorf("vault not empty, can only be initialized if empty")
+		}
+		logger.Infof("Resuming clone...")
 	}
 
-	if len(remote.Salt[:]) == 0 {
-		return errors.Errorf("no remote salt")
+	if err := v.setRemoteSalt(remote.Salt[:]); err != nil {
+		return err
 	}
-
-	logger.Infof("Requesting remote vault...")
-	vault, err := v.client.Vault(ctx, remote.Key)
-	if err != nil {
+	if err := v.setRemoteURL(remote.URL); err != nil {
 		return err
 	}
-
-	if err := v.setRemoteSalt(remote.Salt[:]); err != nil {
+	if err := v.setBool("/sync/cloning", true); err != nil {
 		return err
 	}
 
-	if err := v.saveRemoteVault(vault); err != nil {
+	logger.Infof("Requesting remote vault...")
+	if err := v.pullFrom(ctx, remote); err != nil {
 		return err
 	}
 
+	if err := v.setValue("/sync/cloning", nil); err != nil {
+		return err
+	}
 	if err := v.setLastSync(time.Now()); err != nil {
 		return err
 	}
@@ -67,6 +76,23 @@ func (v *Vault) Clone(ctx context.Context, remote *Remote) error {
 	return nil
 }
 
+// isCloning returns true if a clone (from remote) was started and not
+// finished.
+func (v *Vault) isCloning(remote *Remote) (bool, error) {
+	cloning, err := v.getBool("/sync/cloning")
+	if err != nil {
+		return false, err
+	}
+	if !cloning {
+		return false, nil
+	}
+	rsalt, err := v.getRemoteSalt(false)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(rsalt, remote.Salt), nil
+}
+
 // Remote returns remote server and auth, if unlocked.
 // The vault auth key is used to encrypt and verify vault items from the server.
 // This encryption happens on top of the encryption by the master key.
@@ -74,3 +100,43 @@ func (v *Vault) Clone(ctx context.Context, remote *Remote) error {
 func (v *Vault) Remote() *Remote {
 	return v.remote
 }
+
+func (v *Vault) setRemoteURL(u *url.URL) error {
+	if u == nil {
+		return v.setValue("/sync/url", nil)
+	}
+	return v.setValue("/sync/url", []byte(u.String()))
+}
+
+func (v *Vault) remoteURL() (*url.URL, error) {
+	b, err := v.getValue("/sync/url")
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	return url.Parse(string(b))
+}
+
+// SetRemoteURL sets the URL for the (primary) remote, for syncing to a
+// different (self-hosted) server.
+// The vault must not have been synced, use Unsync first.
+func (v *Vault) SetRemoteURL(u *url.URL) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	last, err := v.lastSync()
+	if err != nil {
+		return err
+	}
+	if !last.IsZero() {
+		return errors.Errorf("vault is synced, unsync before changing the remote")
+	}
+	if err := v.setRemoteURL(u); err != nil {
+		return err
+	}
+	if v.remote != nil {
+		v.remote.URL = u
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package vault_test
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -89,3 +91,102 @@ func testClone(t *testing.T, st1 vault.Store, st2 vault.Store) {
 	require.NoError(t, err)
 	require.Equal(t, expected, paths2)
 }
+
+func TestClonePaged(t *testing.T) {
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+
+	vault.SetPullLimit(2)
+	defer vault.SetPullLimit(500)
+
+	v1, closeFn1 := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn1()
+	v1.SetClient(testClient(t, env))
+	for i := 1; i <= 4; i++ {
+		err := v1.Set(vault.NewItem(fmt.Sprintf("key%d", i), []byte("value"), "", time.Now()))
+		require.NoError(t, err)
+	}
+	err := v1.Sync(ctx)
+	require.NoError(t, err)
+
+	st2, closeFn2 := newTestMem(t)
+	defer closeFn2()
+	v2 := vault.New(st2)
+	v2.SetClient(testClient(t, env))
+	ch := v2.Subscribe("test")
+	defer v2.Unsubscribe("test")
+
+	err = v2.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+
+	// 6 events (salt, auth, 4 items) in pages of 2
+	progress := []vault.PullProgressEvent{}
+	for len(ch) > 0 {
+		if event, ok := (<-ch).(vault.PullProgressEvent); ok {
+			progress = append(progress, event)
+		}
+	}
+	require.Equal(t, 3, len(progress))
+	require.Equal(t, 2, progress[0].Events)
+	require.Equal(t, int64(2), progress[0].Index)
+	require.Equal(t, int64(6), progress[0].Max)
+	require.Equal(t, 6, progress[2].Events)
+	require.Equal(t, int64(6), progress[2].Index)
+	require.Equal(t, time.Duration(0), progress[2].Remaining)
+
+	paths1, err := vaultPaths(v1, "/pull")
+	require.NoError(t, err)
+	paths2, err := vaultPaths(v2, "/pull")
+	require.NoError(t, err)
+	require.Equal(t, paths1, paths2)
+}
+
+func TestCloneResume(t *testing.T) {
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+
+	vault.SetPullLimit(2)
+	defer vault.SetPullLimit(500)
+
+	v1, closeFn1 := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn1()
+	v1.SetClient(testClient(t, env))
+	for i := 1; i <= 4; i++ {
+		err := v1.Set(vault.NewItem(fmt.Sprintf("key%d", i), []byte("value"), "", time.Now()))
+		require.NoError(t, err)
+	}
+	err := v1.Sync(context.TODO())
+	require.NoError(t, err)
+
+	st2, closeFn2 := newTestMem(t)
+	defer closeFn2()
+	v2 := vault.New(st2)
+	v2.SetClient(testClient(t, env))
+
+	// Interrupt clone after the first page
+	env.handler.drop = func(r *http.Request) bool {
+		return r.Method == "GET" && r.URL.Query().Get("idx") != ""
+	}
+	err = v2.Clone(context.TODO(), v1.Remote())
+	require.Error(t, err)
+	env.handler.drop = nil
+
+	paths2, err := vaultPaths(v2, "/pull")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(paths2))
+
+	// Resume
+	err = v2.Clone(context.TODO(), v1.Remote())
+	require.NoError(t, err)
+
+	paths1, err := vaultPaths(v1, "/pull")
+	require.NoError(t, err)
+	paths2, err = vaultPaths(v2, "/pull")
+	require.NoError(t, err)
+	require.Equal(t, paths1, paths2)
+
+	// Completed clone, can't clone again
+	err = v2.Clone(context.TODO(), v1.Remote())
+	require.EqualError(t, err, "vault not empty, can only be initialized if empty")
+}
@@ -0,0 +1,500 @@
+package vault
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/url"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/keys-pub/keys"
+	httpclient "github.com/keys-pub/keys-ext/http/client"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+// Additional remotes.
+//
+// Besides the (primary) remote, a vault can sync to additional remotes, for
+// example a company server and a personal backup. Each remote has its own key
+// and independent push and pull indexes. The remote key is derived from the
+// master key and the remote name, so devices (with the same master key) that
+// add a remote with the same name share the remote vault.
+//
+// The primary remote is authoritative for history and conflicts. Additional
+// remotes are pushed the same events (push entries are kept until every
+// remote has them), and items pulled from them are applied only if they are a
+// newer version than the local item. Applied items are saved to a separate
+// pull log (remote indexes aren't comparable), and like items from the primary
+// remote, are in the item history and search index.
+//
+//   /rpull/{name}.{index}/item/{id}
+
+// RemoteStatus is the sync status for a remote.
+type RemoteStatus struct {
+	// Name is empty for the primary remote.
+	Name string
+	URL  *url.URL
+	KID  keys.ID
+	// PullIndex is the remote index we pulled to.
+	PullIndex int64
+	// PushedIndex is the (local) push index the remote acknowledged.
+	PushedIndex int64
+	SyncedAt    time.Time
+	// Err is the last sync error, if any.
+	Err string
+}
+
+type remoteConfig struct {
+	URL  string `msgpack:"url,omitempty"`
+	Salt []byte `msgpack:"salt"`
+}
+
+var remoteNameRe = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,32}$`)
+
+// SetRemoteClient sets the client to use for remotes with the client URL.
+// If not set, a client is created for remotes with a URL.
+func (v *Vault) SetRemoteClient(client *httpclient.Client) {
+	v.clientsMtx.Lock()
+	defer v.clientsMtx.Unlock()
+	if v.clients == nil {
+		v.clients = map[string]*httpclient.Client{}
+	}
+	v.clients[client.URL().String()] = client
+}
+
+// remoteClient returns the client for a remote.
+// If the remote has no URL, we use the vault client.
+func (v *Vault) remoteClient(remote *Remote) (*httpclient.Client, error) {
+	if remote.URL == nil || (v.client != nil && v.client.URL().String() == remote.URL.String()) {
+		if v.client == nil {
+			return nil, errors.Errorf("no vault client set")
+		}
+		return v.client, nil
+	}
+	v.clientsMtx.Lock()
+	defer v.clientsMtx.Unlock()
+	if v.clients == nil {
+		v.clients = map[string]*httpclient.Client{}
+	}
+	u := remote.URL.String()
+	if client, ok := v.clients[u]; ok {
+		return client, nil
+	}
+	client, err := httpclient.New(u)
+	if err != nil {
+		return nil, err
+	}
+	client.SetClock(v.clock)
+	v.clients[u] = client
+	return client, nil
+}
+
+// AddRemote adds an additional remote.
+// If url is nil, the remote uses the vault client (URL).
+// Requires Unlock.
+func (v *Vault) AddRemote(name string, u *url.URL) (*Remote, error) {
+	if v.mk == nil {
+		return nil, ErrLocked
+	}
+	if !remoteNameRe.MatchString(name) {
+		return nil, errors.Errorf("invalid remote name")
+	}
+	existing, err := v.remoteConfig(name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.Errorf("remote %s already exists", name)
+	}
+	cfg := &remoteConfig{Salt: remoteSalt(name)}
+	if u != nil {
+		cfg.URL = u.String()
+	}
+	b, err := msgpack.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.store.Set(dstore.Path("remotes", name), b); err != nil {
+		return nil, err
+	}
+	logger.Infof("Added remote %s", name)
+	return v.additionalRemote(name, cfg)
+}
+
+// RemoveRemote removes an additional remote.
+// The vault isn't removed from the remote.
+func (v *Vault) RemoveRemote(name string) (bool, error) {
+	ok, err := v.store.Delete(dstore.Path("remotes", name))
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, nil
+	}
+	state, err := v.store.List(&ListOptions{Prefix: dstore.Path("remotes-sync", name) + "/", NoData: true})
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range state {
+		if _, err := v.store.Delete(entry.Path); err != nil {
+			return false, err
+		}
+	}
+	rpull, err := v.store.List(&ListOptions{Prefix: dstore.Path("rpull", name+"."), NoData: true})
+	if err != nil {
+		return false, err
+	}
+	paths := make([]string, 0, len(rpull))
+	for _, entry := range rpull {
+		paths = append(paths, entry.Path)
+	}
+	if err := v.deleteLog(paths); err != nil {
+		return false, err
+	}
+	logger.Infof("Removed remote %s", name)
+	return true, nil
+}
+
+// Remotes returns the additional remotes.
+// Requires Unlock.
+func (v *Vault) Remotes() ([]*Remote, error) {
+	if v.mk == nil {
+		return nil, ErrLocked
+	}
+	names, err := v.remoteNames()
+	if err != nil {
+		return nil, err
+	}
+	remotes := make([]*Remote, 0, len(names))
+	for _, name := range names {
+		cfg, err := v.remoteConfig(name)
+		if err != nil {
+			return nil, err
+		}
+		remote, err := v.additionalRemote(name, cfg)
+		if err != nil {
+			return nil, err
+		}
+		remotes = append(remotes, remote)
+	}
+	return remotes, nil
+}
+
+// RemotesStatus returns the sync status for the primary and additional
+// remotes.
+// Requires Unlock.
+func (v *Vault) RemotesStatus() ([]*RemoteStatus, error) {
+	out := []*RemoteStatus{}
+	if v.remote != nil {
+		pull, err := v.pullIndex()
+		if err != nil {
+			return nil, err
+		}
+		pushed, err := v.pushedIndex()
+		if err != nil {
+			return nil, err
+		}
+		lastSync, err := v.lastSync()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &RemoteStatus{
+			URL:         v.remote.URL,
+			KID:         v.remote.Key.ID(),
+			PullIndex:   pull,
+			PushedIndex: pushed,
+			SyncedAt:    lastSync,
+		})
+	}
+	remotes, err := v.Remotes()
+	if err != nil {
+		return nil, err
+	}
+	for _, remote := range remotes {
+		status, err := v.remoteStatus(remote)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, status)
+	}
+	return out, nil
+}
+
+func (v *Vault) remoteStatus(remote *Remote) (*RemoteStatus, error) {
+	pull, err := v.getInt64(remoteSyncPath(remote.Name, "pull"))
+	if err != nil {
+		return nil, err
+	}
+	pushed, err := v.getInt64(remoteSyncPath(remote.Name, "pushed"))
+	if err != nil {
+		return nil, err
+	}
+	lastSync, err := v.getTime(remoteSyncPath(remote.Name, "lastSync"))
+	if err != nil {
+		return nil, err
+	}
+	serr, err := v.getValue(remoteSyncPath(remote.Name, "err"))
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteStatus{
+		Name:        remote.Name,
+		URL:         remote.URL,
+		KID:         remote.Key.ID(),
+		PullIndex:   pull,
+		PushedIndex: pushed,
+		SyncedAt:    lastSync,
+		Err:         string(serr),
+	}, nil
+}
+
+func remoteSalt(name string) []byte {
+	h := sha256.Sum256([]byte("keys.pub/remote/" + name))
+	return h[:]
+}
+
+func remoteSyncPath(name string, key string) string {
+	return dstore.Path("remotes-sync", name, key)
+}
+
+func (v *Vault) remoteNames() ([]string, error) {
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("remotes") + "/", NoData: true})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, dstore.PathLast(entry.Path))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (v *Vault) remoteConfig(name string) (*remoteConfig, error) {
+	b, err := v.store.Get(dstore.Path("remotes", name))
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, nil
+	}
+	var cfg remoteConfig
+	if err := msgpack.Unmarshal(b, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func (v *Vault) additionalRemote(name string, cfg *remoteConfig) (*Remote, error) {
+	var u *url.URL
+	if cfg.URL != "" {
+		p, err := url.Parse(cfg.URL)
+		if err != nil {
+			return nil, err
+		}
+		u = p
+	}
+	return &Remote{
+		Name: name,
+		URL:  u,
+		Key:  remoteKey(v.mk, cfg.Salt),
+		Salt: cfg.Salt,
+	}, nil
+}
+
+// syncRemotes syncs the additional remotes.
+// Errors are saved in the remote status (and logged), so a failing remote
+// doesn't stop syncing the others.
+func (v *Vault) syncRemotes(ctx context.Context) error {
+	if v.mk == nil {
+		return nil
+	}
+	remotes, err := v.Remotes()
+	if err != nil {
+		return err
+	}
+	for _, remote := range remotes {
+		serr := v.syncRemote(ctx, remote)
+		if serr != nil {
+			logger.Warningf("Failed to sync remote %s: %v", remote.Name, serr)
+			if err := v.setValue(remoteSyncPath(remote.Name, "err"), []byte(serr.Error())); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := v.setValue(remoteSyncPath(remote.Name, "err"), nil); err != nil {
+			return err
+		}
+		if err := v.setTime(remoteSyncPath(remote.Name, "lastSync"), v.clock.Now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *Vault) syncRemote(ctx context.Context, remote *Remote) error {
+	client, err := v.remoteClient(remote)
+	if err != nil {
+		return err
+	}
+	if err := v.pushRemote(ctx, client, remote); err != nil {
+		return errors.Wrapf(err, "failed to push")
+	}
+	if err := v.pullRemote(ctx, client, remote); err != nil {
+		return errors.Wrapf(err, "failed to pull")
+	}
+	return nil
+}
+
+func (v *Vault) pushRemote(ctx context.Context, client *httpclient.Client, remote *Remote) error {
+	pushedPath := remoteSyncPath(remote.Name, "pushed")
+	exists, err := v.store.Get(pushedPath)
+	if err != nil {
+		return err
+	}
+
+	events := []*httpclient.VaultEvent{}
+	last := int64(0)
+	if exists == nil {
+		// First push, send the current items, since the push log before now
+		// may have been removed.
+		items, err := v.store.List(&ListOptions{Prefix: dstore.Path("item") + "/"})
+		if err != nil {
+			return err
+		}
+		for _, entry := range items {
+			events = append(events, &httpclient.VaultEvent{Path: entry.Path, Data: entry.Data, ID: pushID(entry.Path, entry.Data)})
+		}
+		last, err = v.pushIndex()
+		if err != nil {
+			return err
+		}
+	} else {
+		pushed, err := v.getInt64(pushedPath)
+		if err != nil {
+			return err
+		}
+		last = pushed
+		ds, err := v.store.List(&ListOptions{Prefix: dstore.Path("push")})
+		if err != nil {
+			return err
+		}
+		for _, doc := range ds {
+			index, err := unpad(dstore.PathComponents(doc.Path)[1])
+			if err != nil {
+				return err
+			}
+			if index <= pushed {
+				continue
+			}
+			path := dstore.PathFrom(doc.Path, 2)
+			events = append(events, &httpclient.VaultEvent{Path: path, Data: doc.Data, ID: pushID(doc.Path, doc.Data)})
+			last = index
+		}
+	}
+
+	if len(events) > 0 {
+		logger.Infof("Pushing %d vault events (%s)", len(events), remote.Name)
+		if err := client.VaultSend(ctx, remote.Key, events); err != nil {
+			return err
+		}
+	}
+	// Set pushed (even if 0), so we know we did the initial push.
+	return v.store.Set(pushedPath, []byte(pad(last)))
+}
+
+func (v *Vault) pullRemote(ctx context.Context, client *httpclient.Client, remote *Remote) error {
+	pullPath := remoteSyncPath(remote.Name, "pull")
+	for {
+		index, err := v.getInt64(pullPath)
+		if err != nil {
+			return err
+		}
+		vault, err := client.Vault(ctx, remote.Key, httpclient.VaultIndex(index))
+		if err != nil {
+			return err
+		}
+		if vault == nil {
+			return nil
+		}
+		events := vault.Events
+		if vault.Snapshot != nil {
+			snapshot := []*httpclient.VaultEvent{}
+			for _, event := range vault.Snapshot.Events {
+				if event.RemoteIndex > index {
+					snapshot = append(snapshot, event)
+				}
+			}
+			events = append(snapshot, events...)
+		}
+		for _, event := range events {
+			if err := v.applyRemoteEvent(remote, event); err != nil {
+				return err
+			}
+		}
+		if err := v.setInt64(pullPath, vault.Index); err != nil {
+			return err
+		}
+		if !vault.Truncated {
+			return nil
+		}
+	}
+}
+
+// applyRemoteEvent applies an item event from an additional remote, if it's a
+// newer version than the local item.
+func (v *Vault) applyRemoteEvent(remote *Remote, event *httpclient.VaultEvent) error {
+	pc := dstore.PathComponents(event.Path)
+	if len(pc) != 2 || pc[0] != "item" || len(event.Data) == 0 {
+		return nil
+	}
+	id := pc[1]
+	incoming, err := decryptItem(event.Data, v.mk, id)
+	if err != nil {
+		return err
+	}
+	current, err := v.Get(id)
+	if err != nil {
+		return err
+	}
+	if current != nil && incoming.Version <= current.Version {
+		return nil
+	}
+	logger.Debugf("Applying %s (version %d) from remote %s", id, incoming.Version, remote.Name)
+	pull := dstore.Path("rpull", remote.Name+"."+pad(event.RemoteIndex), event.Path)
+	return v.applyPull(event, pull)
+}
+
+// prunePushIndex is the push index we can remove push entries at or below,
+// the minimum pushed index of all remotes.
+func (v *Vault) prunePushIndex() (int64, error) {
+	min, err := v.pushedIndex()
+	if err != nil {
+		return 0, err
+	}
+	names, err := v.remoteNames()
+	if err != nil {
+		return 0, err
+	}
+	for _, name := range names {
+		b, err := v.store.Get(remoteSyncPath(name, "pushed"))
+		if err != nil {
+			return 0, err
+		}
+		if b == nil {
+			// Initial push sends the items, not the push log.
+			continue
+		}
+		pushed, err := v.getInt64(remoteSyncPath(name, "pushed"))
+		if err != nil {
+			return 0, err
+		}
+		if pushed < min {
+			min = pushed
+		}
+	}
+	return min, nil
+}
@@ -0,0 +1,241 @@
+package vault_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteURL(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	env2 := newTestEnv(t, nil)
+	defer env2.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	u, err := url.Parse(env2.httpServer.URL)
+	require.NoError(t, err)
+
+	v1, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	v1.SetClient(testClient(t, env))
+	v1.SetRemoteClient(testClient(t, env2))
+	key, _ := NewTestVaultKey(t, clock)
+
+	err = v1.SetRemoteURL(u)
+	require.NoError(t, err)
+	err = v1.Set(vault.NewItem("key1", []byte("value1"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	status, err := v1.SyncStatus()
+	require.NoError(t, err)
+	require.Equal(t, u.String(), status.URL.String())
+
+	// Synced to env2 (not env)
+	remote := v1.Remote()
+	vlt, err := testClient(t, env2).Vault(ctx, remote.Key)
+	require.NoError(t, err)
+	require.NotNil(t, vlt)
+	vlt, err = testClient(t, env).Vault(ctx, remote.Key)
+	require.NoError(t, err)
+	require.Nil(t, vlt)
+
+	// Can't change URL once synced
+	err = v1.SetRemoteURL(nil)
+	require.EqualError(t, err, "vault is synced, unsync before changing the remote")
+
+	// Clone (URL)
+	v2, closeFn2 := NewTestVault(t, &TestVaultOptions{Clock: clock})
+	defer closeFn2()
+	v2.SetRemoteClient(testClient(t, env2))
+	err = v2.Clone(ctx, remote)
+	require.NoError(t, err)
+	_, err = v2.Unlock(key)
+	require.NoError(t, err)
+	item, err := v2.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), item.Data)
+	require.Equal(t, u.String(), v2.Remote().URL.String())
+}
+
+func TestAdditionalRemotes(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	env2 := newTestEnv(t, nil)
+	defer env2.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	u, err := url.Parse(env2.httpServer.URL)
+	require.NoError(t, err)
+
+	v1, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	v1.SetClient(testClient(t, env))
+	v1.SetRemoteClient(testClient(t, env2))
+	key, _ := NewTestVaultKey(t, clock)
+
+	err = v1.Set(vault.NewItem("key1", []byte("value1"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	// Add remote (after items were pushed to the primary)
+	backup, err := v1.AddRemote("backup", u)
+	require.NoError(t, err)
+	_, err = v1.AddRemote("backup", u)
+	require.EqualError(t, err, "remote backup already exists")
+	_, err = v1.AddRemote("invalid/name", u)
+	require.EqualError(t, err, "invalid remote name")
+
+	err = v1.Set(vault.NewItem("key2", []byte("value2"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	remotes, err := v1.RemotesStatus()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(remotes))
+	require.Equal(t, "", remotes[0].Name)
+	require.Equal(t, "backup", remotes[1].Name)
+	require.Equal(t, u.String(), remotes[1].URL.String())
+	require.Equal(t, backup.Key.ID(), remotes[1].KID)
+	require.Equal(t, remotes[0].PushedIndex, remotes[1].PushedIndex)
+	require.Equal(t, "", remotes[1].Err)
+	require.False(t, remotes[1].SyncedAt.IsZero())
+
+	// Backup has both items (initial push and push log)
+	vlt, err := testClient(t, env2).Vault(ctx, backup.Key)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vlt.Events))
+
+	paths, err := vaultPaths(v1, "/push")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, paths)
+
+	// Clone on another device, with the same remote
+	st2, closeFn2 := newTestMem(t)
+	defer closeFn2()
+	v2 := vault.New(st2, vault.WithClock(clock), vault.WithIndexer("", indexNote))
+	v2.SetClient(testClient(t, env))
+	v2.SetRemoteClient(testClient(t, env2))
+	err = v2.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+	_, err = v2.Unlock(key)
+	require.NoError(t, err)
+	backup2, err := v2.AddRemote("backup", u)
+	require.NoError(t, err)
+	require.Equal(t, backup.Key.ID(), backup2.Key.ID())
+	require.Equal(t, []string{"key1", "key2"}, searchIDs(t, v2, "value"))
+
+	// Primary goes down
+	env.closeFn()
+
+	err = v1.Set(vault.NewItem("key3", []byte("value3"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.Error(t, err)
+	// Not pushed to primary, so kept in push log
+	paths, err = vaultPaths(v1, "/push")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(paths))
+
+	// Other device gets item from backup
+	err = v2.Sync(ctx)
+	require.Error(t, err)
+	item, err := v2.Get("key3")
+	require.NoError(t, err)
+	require.NotNil(t, item)
+	require.Equal(t, []byte("value3"), item.Data)
+	// Applied like items from the primary (history, search index)
+	history, err := v2.ItemHistory("key3")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	require.Equal(t, []byte("value3"), history[0].Data)
+	require.Equal(t, []string{"key1", "key2", "key3"}, searchIDs(t, v2, "value"))
+
+	remotes, err = v2.RemotesStatus()
+	require.NoError(t, err)
+	require.Equal(t, "", remotes[1].Err)
+
+	ok, err := v2.RemoveRemote("backup")
+	require.NoError(t, err)
+	require.True(t, ok)
+	paths, err = vaultPaths(v2, "/rpull")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, paths)
+	history, err = v2.ItemHistory("key3")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(history))
+
+	// Remove
+	ok, err = v1.RemoveRemote("backup")
+	require.NoError(t, err)
+	require.True(t, ok)
+	remotes, err = v1.RemotesStatus()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(remotes))
+	paths, err = vaultPaths(v1, "/remotes-sync")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, paths)
+}
+
+func TestRemotesUnsync(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	env2 := newTestEnv(t, nil)
+	defer env2.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	u, err := url.Parse(env2.httpServer.URL)
+	require.NoError(t, err)
+
+	v1, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	v1.SetClient(testClient(t, env))
+	v1.SetRemoteClient(testClient(t, env2))
+	backup, err := v1.AddRemote("backup", u)
+	require.NoError(t, err)
+
+	err = v1.Set(vault.NewItem("key1", []byte("value1"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	vlt, err := testClient(t, env2).Vault(ctx, backup.Key)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(vlt.Events))
+
+	// Unsync resets the log (push indexes), so the remote pushed index is reset
+	err = v1.Unsync(ctx)
+	require.NoError(t, err)
+	paths, err := vaultPaths(v1, "/remotes-sync/backup/pushed")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, paths)
+
+	// Next sync is an initial push (current items), key1 was already pushed
+	// (same push ID), so the remote has it once.
+	err = v1.Set(vault.NewItem("key2", []byte("value2"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	vlt, err = testClient(t, env2).Vault(ctx, backup.Key)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vlt.Events))
+
+	remotes, err := v1.RemotesStatus()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(remotes))
+	require.Equal(t, remotes[0].PushedIndex, remotes[1].PushedIndex)
+}
@@ -0,0 +1,498 @@
+package vault
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/keys-pub/keys"
+	httpclient "github.com/keys-pub/keys-ext/http/client"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+// Master key rotation.
+//
+// Rotation is journaled in /rotate, so if we are interrupted, it is resumed
+// on the next Unlock (and Sync, for the remote):
+//
+//   /rotate/mk          New master key (encrypted with old master key)
+//   /rotate/omk         Old master key (encrypted with new master key)
+//   /rotate/rsalt       New remote salt
+//   /rotate/orsalt      Old remote salt, if the remote needs to be migrated
+//   /rotate/auth/{id}   Auth for the new master key
+//   /rotate/drop/{id}   Auth (and provision) to remove
+//   /rotate/step        Step (1=started, 2=local done, remote pending)
+//
+// The steps are:
+// - Re-encrypt items, conflicts, push and pull logs with the new master key
+// - Replace auths (wrapping the new master key)
+// - Delete the vault from the remote (old KID), reset log (move pull into
+//   push) and set the new remote salt, so the next push creates the vault at
+//   the new remote KID.
+
+const (
+	rotateStarted       int64 = 1
+	rotateRemotePending int64 = 2
+)
+
+// RotateMasterKey re-keys the vault with a new master key.
+//
+// Every item (and the push/pull log) is re-encrypted, and every auth is
+// re-wrapped with the new master key, so an auth key is required for each
+// auth. If force is true, auths without a key are removed (deprovisioned),
+// otherwise we error.
+//
+// If the vault was synced, the remote vault is migrated to a new remote key,
+// and other devices need to be re-authorized (see Clone).
+// Requires Unlock.
+func (v *Vault) RotateMasterKey(ctx context.Context, authKeys []*[32]byte, force bool) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if v.mk == nil {
+		return ErrLocked
+	}
+	step, err := v.getInt64("/rotate/step")
+	if err != nil {
+		return err
+	}
+	if step != 0 {
+		return errors.Errorf("master key rotation already in progress")
+	}
+
+	synced, err := v.syncedRemote()
+	if err != nil {
+		return err
+	}
+	if synced {
+		// Sync first, so everything is pushed (to the old remote) before the
+		// remote is migrated.
+		if err := v.sync(ctx); err != nil {
+			return err
+		}
+	}
+
+	logger.Infof("Rotating master key...")
+	mk := v.mk
+	nmk := keys.Rand32()
+	if err := v.rotatePrepare(mk, nmk, authKeys, force, synced); err != nil {
+		return err
+	}
+	if err := v.rotateLocal(mk, nmk); err != nil {
+		return err
+	}
+	v.mk = nmk
+	v.remote = nil
+	if err := v.setAuthFromMasterKey(nmk); err != nil {
+		return err
+	}
+	v.rotated(mk, nmk)
+	if synced {
+		if err := v.rotateRemote(ctx); err != nil {
+			return err
+		}
+		if err := v.sync(ctx); err != nil {
+			return err
+		}
+	}
+	logger.Infof("Rotated master key")
+	return nil
+}
+
+// syncedRemote returns true if we have synced with a remote.
+func (v *Vault) syncedRemote() (bool, error) {
+	if v.remote == nil {
+		return false, nil
+	}
+	if _, err := v.remoteClient(v.remote); err != nil {
+		return false, nil
+	}
+	last, err := v.lastSync()
+	if err != nil {
+		return false, err
+	}
+	return !last.IsZero(), nil
+}
+
+func (v *Vault) rotatePrepare(mk *[32]byte, nmk *[32]byte, authKeys []*[32]byte, force bool, migrateRemote bool) error {
+	auths, err := v.store.List(&ListOptions{Prefix: dstore.Path("auth")})
+	if err != nil {
+		return err
+	}
+	wrapped := map[string][]byte{}
+	drop := []string{}
+	for _, auth := range auths {
+		id := dstore.PathLast(auth.Path)
+		key := authKeyFor(auth.Data, mk, authKeys)
+		if key == nil {
+			if !force {
+				return errors.Errorf("no key for auth %s", id)
+			}
+			drop = append(drop, id)
+			continue
+		}
+		b, err := encryptItem(NewItem(id, nmk[:], "", v.clock.Now()), key)
+		if err != nil {
+			return err
+		}
+		wrapped[id] = b
+	}
+	if len(wrapped) == 0 {
+		return errors.Errorf("no keys for any auth")
+	}
+
+	if err := v.store.Set("/rotate/mk", secretBoxSeal(nmk[:], mk)); err != nil {
+		return err
+	}
+	if err := v.store.Set("/rotate/omk", secretBoxSeal(mk[:], nmk)); err != nil {
+		return err
+	}
+	if err := v.store.Set("/rotate/rsalt", keys.RandBytes(32)); err != nil {
+		return err
+	}
+	if migrateRemote {
+		rsalt, err := v.getRemoteSalt(false)
+		if err != nil {
+			return err
+		}
+		if err := v.store.Set("/rotate/orsalt", rsalt); err != nil {
+			return err
+		}
+	}
+	for id, b := range wrapped {
+		if err := v.store.Set(dstore.Path("rotate", "auth", id), b); err != nil {
+			return err
+		}
+	}
+	for _, id := range drop {
+		if err := v.store.Set(dstore.Path("rotate", "drop", id), []byte{}); err != nil {
+			return err
+		}
+	}
+	return v.setInt64("/rotate/step", rotateStarted)
+}
+
+// authKeyFor returns the key that opens the auth (for the master key).
+func authKeyFor(b []byte, mk *[32]byte, authKeys []*[32]byte) *[32]byte {
+	for _, key := range authKeys {
+		item, err := decryptItem(b, key, "")
+		if err != nil || item == nil {
+			continue
+		}
+		if len(item.Data) == 32 && *keys.Bytes32(item.Data) == *mk {
+			return key
+		}
+	}
+	return nil
+}
+
+// checkRotate resumes an interrupted master key rotation on Unlock.
+// The key is the master key from the auth, which is the old or new master
+// key, depending on where we were interrupted.
+// Returns the (new) master key.
+func (v *Vault) checkRotate(key *[32]byte) (*[32]byte, error) {
+	step, err := v.getInt64("/rotate/step")
+	if err != nil {
+		return nil, err
+	}
+	if step == 0 {
+		return key, nil
+	}
+	logger.Infof("Resuming master key rotation...")
+	mk, nmk, err := v.rotateKeys(key)
+	if err != nil {
+		return nil, err
+	}
+	if step == rotateStarted {
+		if err := v.rotateLocal(mk, nmk); err != nil {
+			return nil, err
+		}
+	}
+	// We may have been interrupted before (or while) calling the hook.
+	v.rotated(mk, nmk)
+	return nmk, nil
+}
+
+// rotated calls the rotate hook, if set.
+func (v *Vault) rotated(mk *[32]byte, nmk *[32]byte) {
+	if v.rotateHook != nil {
+		v.rotateHook(mk, nmk)
+	}
+}
+
+// rotateKeys returns the old and new master keys from the journal.
+func (v *Vault) rotateKeys(key *[32]byte) (*[32]byte, *[32]byte, error) {
+	b, err := v.store.Get("/rotate/mk")
+	if err != nil {
+		return nil, nil, err
+	}
+	if nmk, ok := secretBoxOpen(b, key); ok {
+		return key, keys.Bytes32(nmk), nil
+	}
+	b, err = v.store.Get("/rotate/omk")
+	if err != nil {
+		return nil, nil, err
+	}
+	if mk, ok := secretBoxOpen(b, key); ok {
+		return keys.Bytes32(mk), key, nil
+	}
+	return nil, nil, errors.Errorf("invalid master key rotation")
+}
+
+// rotateLocal re-encrypts and replaces auths.
+// It's safe to run more than once.
+func (v *Vault) rotateLocal(mk *[32]byte, nmk *[32]byte) error {
+	for _, prefix := range []string{"item", "conflict"} {
+		entries, err := v.store.List(&ListOptions{Prefix: dstore.Path(prefix)})
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			b, err := reencrypt(entry.Data, mk, nmk)
+			if err != nil {
+				return errors.Wrapf(err, "failed to re-encrypt %s", entry.Path)
+			}
+			if err := v.store.Set(entry.Path, b); err != nil {
+				return err
+			}
+		}
+	}
+
+	push, err := v.store.List(&ListOptions{Prefix: dstore.Path("push")})
+	if err != nil {
+		return err
+	}
+	for _, entry := range push {
+		if !isItemLog(entry.Path) || len(entry.Data) == 0 {
+			continue
+		}
+		b, err := reencrypt(entry.Data, mk, nmk)
+		if err != nil {
+			return errors.Wrapf(err, "failed to re-encrypt %s", entry.Path)
+		}
+		if err := v.store.Set(entry.Path, b); err != nil {
+			return err
+		}
+	}
+
+	pull, err := v.store.List(&ListOptions{Prefix: dstore.Path("pull")})
+	if err != nil {
+		return err
+	}
+	rpull, err := v.store.List(&ListOptions{Prefix: dstore.Path("rpull")})
+	if err != nil {
+		return err
+	}
+	pull = append(pull, rpull...)
+	for _, entry := range pull {
+		if !isItemLog(entry.Path) {
+			continue
+		}
+		var event httpclient.VaultEvent
+		if err := msgpack.Unmarshal(entry.Data, &event); err != nil {
+			return err
+		}
+		if len(event.Data) == 0 {
+			continue
+		}
+		b, err := reencrypt(event.Data, mk, nmk)
+		if err != nil {
+			return errors.Wrapf(err, "failed to re-encrypt %s", entry.Path)
+		}
+		event.Data = b
+		eb, err := msgpack.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err := v.store.Set(entry.Path, eb); err != nil {
+			return err
+		}
+	}
+
+	// The index is encrypted with the master key, so rebuild on next Search.
+	v.indexMtx.Lock()
+	err = v.clearIndex()
+	v.indexMtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// Replace auths
+	drop, err := v.store.List(&ListOptions{Prefix: dstore.Path("rotate", "drop"), NoData: true})
+	if err != nil {
+		return err
+	}
+	for _, entry := range drop {
+		id := dstore.PathLast(entry.Path)
+		logger.Infof("Removing auth %s (no key for rotation)", id)
+		if _, err := v.authDelete(id); err != nil {
+			return err
+		}
+		if _, err := v.provisionDelete(id); err != nil {
+			return err
+		}
+		if _, err := v.store.Delete(entry.Path); err != nil {
+			return err
+		}
+	}
+	auths, err := v.store.List(&ListOptions{Prefix: dstore.Path("rotate", "auth")})
+	if err != nil {
+		return err
+	}
+	for _, entry := range auths {
+		id := dstore.PathLast(entry.Path)
+		if err := v.set(dstore.Path("auth", id), entry.Data, true); err != nil {
+			return err
+		}
+		if _, err := v.store.Delete(entry.Path); err != nil {
+			return err
+		}
+	}
+
+	// Additional remote keys are derived from the master key, so they
+	// changed, and need an initial push (to the new remote key).
+	names, err := v.remoteNames()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := deleteAll(v.store, []string{remoteSyncPath(name, "pushed"), remoteSyncPath(name, "pull")}); err != nil {
+			return err
+		}
+	}
+
+	orsalt, err := v.store.Get("/rotate/orsalt")
+	if err != nil {
+		return err
+	}
+	if orsalt != nil {
+		return v.setInt64("/rotate/step", rotateRemotePending)
+	}
+	// No remote to migrate
+	rsalt, err := v.store.Get("/rotate/rsalt")
+	if err != nil {
+		return err
+	}
+	if err := v.setRemoteSalt(rsalt); err != nil {
+		return err
+	}
+	return v.clearRotate()
+}
+
+// rotateRemote migrates the remote after rotation, if pending.
+// The vault is deleted from the old remote, and the log is reset (moving pull
+// into push), so the next push creates the vault at the new remote.
+func (v *Vault) rotateRemote(ctx context.Context) error {
+	step, err := v.getInt64("/rotate/step")
+	if err != nil {
+		return err
+	}
+	if step != rotateRemotePending {
+		return nil
+	}
+	if v.mk == nil {
+		return ErrLocked
+	}
+	client, err := v.remoteClient(v.remote)
+	if err != nil {
+		return err
+	}
+	logger.Infof("Migrating remote (master key rotation)...")
+	b, err := v.store.Get("/rotate/omk")
+	if err != nil {
+		return err
+	}
+	omk, ok := secretBoxOpen(b, v.mk)
+	if !ok {
+		return errors.Errorf("invalid master key rotation")
+	}
+	orsalt, err := v.store.Get("/rotate/orsalt")
+	if err != nil {
+		return err
+	}
+	rsalt, err := v.store.Get("/rotate/rsalt")
+	if err != nil {
+		return err
+	}
+
+	old := remoteKey(keys.Bytes32(omk), orsalt)
+	if err := client.VaultDelete(ctx, old); err != nil {
+		var rerr httpclient.Error
+		if !errors.As(err, &rerr) || rerr.StatusCode != http.StatusNotFound {
+			return err
+		}
+	}
+	v.rotateRemotesDelete(ctx, keys.Bytes32(omk))
+
+	if err := v.resetLog(); err != nil {
+		return err
+	}
+	if err := v.setPullIndex(0); err != nil {
+		return err
+	}
+	if err := v.setPushedIndex(0); err != nil {
+		return err
+	}
+	if err := v.setRemoteSalt(rsalt); err != nil {
+		return err
+	}
+	if err := v.clearRotate(); err != nil {
+		return err
+	}
+	v.remote = nil
+	return v.setAuthFromMasterKey(v.mk)
+}
+
+// rotateRemotesDelete deletes the vault (old key) from additional remotes.
+// This is best effort, since additional remotes are independent, failures are
+// logged.
+func (v *Vault) rotateRemotesDelete(ctx context.Context, omk *[32]byte) {
+	remotes, err := v.Remotes()
+	if err != nil {
+		logger.Warningf("Failed to list remotes: %v", err)
+		return
+	}
+	for _, remote := range remotes {
+		client, err := v.remoteClient(remote)
+		if err != nil {
+			logger.Warningf("Failed to delete vault from remote %s: %v", remote.Name, err)
+			continue
+		}
+		if err := client.VaultDelete(ctx, remoteKey(omk, remote.Salt)); err != nil {
+			logger.Warningf("Failed to delete vault from remote %s: %v", remote.Name, err)
+		}
+	}
+}
+
+func (v *Vault) clearRotate() error {
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("rotate"), NoData: true})
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if _, err := v.store.Delete(entry.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reencrypt secretbox data from old to new key.
+// If already encrypted with the new key, it's returned as is.
+func reencrypt(b []byte, mk *[32]byte, nmk *[32]byte) ([]byte, error) {
+	decrypted, ok := secretBoxOpen(b, mk)
+	if ok {
+		return secretBoxSeal(decrypted, nmk), nil
+	}
+	if _, ok := secretBoxOpen(b, nmk); ok {
+		return b, nil
+	}
+	return nil, ErrInvalidAuth
+}
+
+// isItemLog returns true if push or pull log path is for an item.
+func isItemLog(path string) bool {
+	pc := dstore.PathComponents(path)
+	return len(pc) == 4 && pc[2] == "item"
+}
@@ -0,0 +1,200 @@
+package vault_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateMasterKey(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	v1, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	v1.SetClient(testClient(t, env))
+	key, _ := NewTestVaultKey(t, clock)
+
+	err = v1.Set(vault.NewItem("key1", []byte("value1"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	mk := v1.MasterKey()
+	remote := v1.Remote()
+	rotated := [][2]*[32]byte{}
+	v1.SetRotateHook(func(mk *[32]byte, nmk *[32]byte) {
+		rotated = append(rotated, [2]*[32]byte{mk, nmk})
+	})
+
+	// Missing key
+	err = v1.RotateMasterKey(ctx, []*[32]byte{keys.Rand32()}, false)
+	require.EqualError(t, err, "no key for auth ySymDh5DDuJo21ydVJdyuxcDTgYUJMin4PZQzSUBums")
+
+	err = v1.RotateMasterKey(ctx, []*[32]byte{key}, false)
+	require.NoError(t, err)
+	require.NotEqual(t, mk, v1.MasterKey())
+	require.NotEqual(t, remote.Key.ID(), v1.Remote().Key.ID())
+	require.Equal(t, [][2]*[32]byte{{mk, v1.MasterKey()}}, rotated)
+
+	paths, err := vaultPaths(v1, "/rotate")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, paths)
+
+	// Old remote was deleted
+	old, err := testClient(t, env).Vault(ctx, remote.Key)
+	require.NoError(t, err)
+	require.Nil(t, old)
+
+	v1.Lock()
+	_, err = v1.Unlock(key)
+	require.NoError(t, err)
+	item, err := v1.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), item.Data)
+	history, err := v1.ItemHistory("key1")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+
+	// Clone from new remote
+	v2, closeFn2 := NewTestVault(t, &TestVaultOptions{Clock: clock})
+	defer closeFn2()
+	v2.SetClient(testClient(t, env))
+	err = v2.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+	_, err = v2.Unlock(key)
+	require.NoError(t, err)
+	item, err = v2.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), item.Data)
+}
+
+// testRotateFailStore fails setting auths.
+type testRotateFailStore struct {
+	vault.Store
+	fail bool
+}
+
+func (s *testRotateFailStore) Set(path string, b []byte) error {
+	if s.fail && dstore.PathFirst(path) == "auth" {
+		return errors.Errorf("failed to set (test)")
+	}
+	return s.Store.Set(path, b)
+}
+
+func TestRotateMasterKeyResume(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	mem, closeFn := newTestMem(t)
+	defer closeFn()
+	st := &testRotateFailStore{Store: mem}
+
+	v1 := vault.New(st, vault.WithClock(clock))
+	v1.SetClient(testClient(t, env))
+	key, provision := NewTestVaultKey(t, clock)
+	err = v1.Setup(key, provision)
+	require.NoError(t, err)
+	_, err = v1.Unlock(key)
+	require.NoError(t, err)
+	err = v1.Set(vault.NewItem("key1", []byte("value1"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	remote := v1.Remote()
+	mk := v1.MasterKey()
+	rotated := [][2]*[32]byte{}
+	v1.SetRotateHook(func(mk *[32]byte, nmk *[32]byte) {
+		rotated = append(rotated, [2]*[32]byte{mk, nmk})
+	})
+
+	// Interrupted (after re-encrypting, before replacing auths)
+	st.fail = true
+	err = v1.RotateMasterKey(ctx, []*[32]byte{key}, false)
+	require.EqualError(t, err, "failed to set (test)")
+	st.fail = false
+	require.Equal(t, 0, len(rotated))
+	v1.Lock()
+
+	// Interrupted (remote)
+	env.handler.drop = func(r *http.Request) bool {
+		return r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/vault/")
+	}
+	_, err = v1.Unlock(key)
+	require.NoError(t, err)
+	require.Equal(t, [][2]*[32]byte{{mk, v1.MasterKey()}}, rotated)
+	item, err := v1.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), item.Data)
+	err = v1.Sync(ctx)
+	require.Error(t, err)
+
+	paths, err := vaultPaths(v1, "/rotate")
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(paths))
+
+	// Resume (remote)
+	env.handler.drop = nil
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, remote.Key.ID(), v1.Remote().Key.ID())
+	paths, err = vaultPaths(v1, "/rotate")
+	require.NoError(t, err)
+	require.Equal(t, []string{}, paths)
+
+	v1.Lock()
+	_, err = v1.Unlock(key)
+	require.NoError(t, err)
+	item, err = v1.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), item.Data)
+}
+
+func TestRotateMasterKeyForce(t *testing.T) {
+	var err error
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	key, _ := NewTestVaultKey(t, clock)
+
+	key2 := keys.Rand32()
+	provision2 := vault.NewProvision(vault.UnknownAuth)
+	err = vlt.Provision(key2, provision2)
+	require.NoError(t, err)
+
+	err = vlt.Set(vault.NewItem("key1", []byte("value1"), "", time.Now()))
+	require.NoError(t, err)
+
+	err = vlt.RotateMasterKey(ctx, []*[32]byte{key}, true)
+	require.NoError(t, err)
+
+	vlt.Lock()
+	_, err = vlt.Unlock(key2)
+	require.Equal(t, vault.ErrInvalidAuth, err)
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
+	item, err := vlt.Get("key1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), item.Data)
+
+	provisions, err := vlt.Provisions()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(provisions))
+}
@@ -0,0 +1,317 @@
+package secrets
+
+import (
+	"bufio"
+	"crypto/sha1" // #nosec (for breached password hashes)
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/pkg/errors"
+)
+
+// AuditIssue is a problem found by Audit.
+type AuditIssue string
+
+// Audit issues.
+const (
+	// Breached if the password is in the breached password hashes.
+	Breached AuditIssue = "breached"
+	// Reused if the password is used by more than one secret.
+	Reused AuditIssue = "reused"
+	// Weak if the password entropy estimate is low.
+	Weak AuditIssue = "weak"
+	// Stale if the password hasn't been updated recently.
+	Stale AuditIssue = "stale"
+	// MissingURL if there is no URL.
+	MissingURL AuditIssue = "missing-url"
+)
+
+// AuditSeverity is the severity of an audit issue.
+type AuditSeverity int
+
+// Audit severities.
+const (
+	Low    AuditSeverity = 1
+	Medium AuditSeverity = 2
+	High   AuditSeverity = 3
+)
+
+func (s AuditSeverity) String() string {
+	switch s {
+	case High:
+		return "high"
+	case Medium:
+		return "medium"
+	case Low:
+		return "low"
+	default:
+		return ""
+	}
+}
+
+var auditSeverities = map[AuditIssue]AuditSeverity{
+	Breached:   High,
+	Reused:     High,
+	Weak:       Medium,
+	Stale:      Low,
+	MissingURL: Low,
+}
+
+// AuditFinding is an issue found for a secret.
+type AuditFinding struct {
+	Secret   *Secret
+	Issue    AuditIssue
+	Severity AuditSeverity
+	Detail   string
+}
+
+// AuditOptions ...
+type AuditOptions struct {
+	// StaleAge is how old (since updated) a password is stale.
+	// Defaults to 1 year.
+	StaleAge time.Duration
+	// MinEntropy is the minimum password entropy (in bits).
+	// Defaults to 60.
+	MinEntropy float64
+	// Breached password hashes (optional).
+	Breached io.Reader
+	// BreachedPrefix is the hash prefix for Breached, if it is a range (of
+	// hash suffixes).
+	BreachedPrefix string
+}
+
+// AuditOption ...
+type AuditOption func(*AuditOptions)
+
+// AuditStaleAge sets the stale age.
+func AuditStaleAge(d time.Duration) AuditOption {
+	return func(o *AuditOptions) { o.StaleAge = d }
+}
+
+// AuditMinEntropy sets the minimum entropy (bits).
+func AuditMinEntropy(bits float64) AuditOption {
+	return func(o *AuditOptions) { o.MinEntropy = bits }
+}
+
+// AuditBreached checks passwords against breached password hashes.
+//
+// Each line is a (hex) SHA-1 hash, with an optional ":count" suffix, for
+// example, from the "Pwned Passwords" downloads.
+// For a range (of hash suffixes), see AuditBreachedRange.
+func AuditBreached(r io.Reader) AuditOption {
+	return func(o *AuditOptions) {
+		o.Breached = r
+		o.BreachedPrefix = ""
+	}
+}
+
+// AuditBreachedRange checks passwords against a range of breached password
+// hashes, as returned by the "Pwned Passwords" range API (or downloader), for
+// a (5 character) hash prefix.
+//
+// Each line is the (35 character) hash suffix with a ":count" suffix.
+// Lines with a count of 0 are padding and are ignored.
+func AuditBreachedRange(prefix string, r io.Reader) AuditOption {
+	return func(o *AuditOptions) {
+		o.Breached = r
+		o.BreachedPrefix = prefix
+	}
+}
+
+// Audit checks password secrets for breached, reused, weak or stale passwords,
+// and missing URLs.
+// Findings are sorted by severity (highest first), then by secret name.
+func Audit(v *vault.Vault, opt ...AuditOption) ([]*AuditFinding, error) {
+	opts := AuditOptions{
+		StaleAge:   time.Hour * 24 * 365,
+		MinEntropy: 60,
+	}
+	for _, o := range opt {
+		o(&opts)
+	}
+
+	ss, err := List(v, WithTypes(PasswordType))
+	if err != nil {
+		return nil, err
+	}
+
+	findings := []*AuditFinding{}
+	add := func(secret *Secret, issue AuditIssue, detail string) {
+		findings = append(findings, &AuditFinding{
+			Secret:   secret,
+			Issue:    issue,
+			Severity: auditSeverities[issue],
+			Detail:   detail,
+		})
+	}
+
+	if opts.Breached != nil {
+		breached, err := breachedSecrets(ss, opts.Breached, opts.BreachedPrefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range ss {
+			if count, ok := breached[secret.ID]; ok {
+				detail := "password found in breached password hashes"
+				if count > 0 {
+					detail = fmt.Sprintf("password found in breached password hashes (%d times)", count)
+				}
+				add(secret, Breached, detail)
+			}
+		}
+	}
+
+	byPassword := map[string][]*Secret{}
+	for _, secret := range ss {
+		if secret.Password == "" {
+			continue
+		}
+		byPassword[secret.Password] = append(byPassword[secret.Password], secret)
+	}
+
+	now := v.Now()
+	for _, secret := range ss {
+		if reused := byPassword[secret.Password]; len(reused) > 1 {
+			names := []string{}
+			for _, r := range reused {
+				if r.ID != secret.ID {
+					names = append(names, r.Name)
+				}
+			}
+			sort.Strings(names)
+			add(secret, Reused, fmt.Sprintf("password also used by %s", strings.Join(names, ", ")))
+		}
+		if secret.Password != "" {
+			if bits := PasswordEntropy(secret.Password); bits < opts.MinEntropy {
+				add(secret, Weak, fmt.Sprintf("password entropy is %.0f bits", bits))
+			}
+		}
+		if opts.StaleAge > 0 && !secret.UpdatedAt.IsZero() && now.Sub(secret.UpdatedAt) > opts.StaleAge {
+			add(secret, Stale, fmt.Sprintf("password not updated since %s", secret.UpdatedAt.Format("2006-01-02")))
+		}
+		if strings.TrimSpace(secret.URL) == "" {
+			add(secret, MissingURL, "no URL")
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Severity != findings[j].Severity {
+			return findings[i].Severity > findings[j].Severity
+		}
+		return findings[i].Secret.Name < findings[j].Secret.Name
+	})
+	return findings, nil
+}
+
+// PasswordEntropy returns an estimate of password entropy (in bits), from the
+// length and character classes, with repeated characters counted once after
+// the first repeat.
+func PasswordEntropy(password string) float64 {
+	var lower, upper, digit, symbol, other bool
+	counts := map[rune]int{}
+	length := 0
+	for _, r := range password {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < unicode.MaxASCII && unicode.IsPrint(r):
+			symbol = true
+		default:
+			other = true
+		}
+		counts[r]++
+		if counts[r] <= 2 {
+			length++
+		}
+	}
+	pool := 0
+	if lower {
+		pool += 26
+	}
+	if upper {
+		pool += 26
+	}
+	if digit {
+		pool += 10
+	}
+	if symbol {
+		pool += 33
+	}
+	if other {
+		pool += 100
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(length) * math.Log2(float64(pool))
+}
+
+// breachedSecrets returns secret IDs whose password (SHA-1) hash matches a line
+// in breached, with the count (or 0 if not specified).
+// If prefix is specified, lines are hash suffixes (range format).
+func breachedSecrets(ss []*Secret, breached io.Reader, prefix string) (map[string]int64, error) {
+	prefix = strings.ToUpper(prefix)
+	if prefix != "" && (len(prefix) != 5 || !isHex(prefix)) {
+		return nil, errors.Errorf("invalid breached password hash prefix %q", prefix)
+	}
+
+	hashes := map[string][]string{}
+	for _, secret := range ss {
+		if secret.Password == "" {
+			continue
+		}
+		sum := sha1.Sum([]byte(secret.Password)) // #nosec
+		h := strings.ToUpper(hex.EncodeToString(sum[:]))
+		hashes[h] = append(hashes[h], secret.ID)
+	}
+
+	out := map[string]int64{}
+	scanner := bufio.NewScanner(breached)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		h := line
+		var count int64
+		if i := strings.Index(line, ":"); i >= 0 {
+			h = line[:i]
+			if _, err := fmt.Sscanf(line[i+1:], "%d", &count); err != nil {
+				return nil, errors.Errorf("invalid breached password count (line %d)", lineNum)
+			}
+			if count == 0 {
+				// Padding
+				continue
+			}
+		}
+		h = prefix + strings.ToUpper(h)
+		if len(h) != 40 || !isHex(h) {
+			return nil, errors.Errorf("invalid breached password hash (line %d)", lineNum)
+		}
+		for _, id := range hashes[h] {
+			out[id] += count
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func isHex(s string) bool {
+	_, err := hex.DecodeString(s + strings.Repeat("0", len(s)%2))
+	return err == nil
+}
@@ -0,0 +1,104 @@
+package secrets_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordEntropy(t *testing.T) {
+	require.Equal(t, float64(0), secrets.PasswordEntropy(""))
+	require.InDelta(t, 37.6, secrets.PasswordEntropy("password"), 0.1)
+	require.InDelta(t, 9.4, secrets.PasswordEntropy("aaaaaaaaaa"), 0.1)
+	require.InDelta(t, 78.8, secrets.PasswordEntropy("Tr0ub4dor&3x"), 0.1)
+}
+
+func TestAudit(t *testing.T) {
+	clock := tsutil.NewTestClock()
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+
+	save := func(secret *secrets.Secret) {
+		_, _, err := secrets.Save(vlt, secret)
+		require.NoError(t, err)
+	}
+	save(secrets.NewPassword("alpha", "alice", "password", "https://alpha.com"))
+	save(secrets.NewPassword("bravo", "alice", "Uv9#kq2!Lm7$Wz4@", "https://bravo.com"))
+	save(secrets.NewPassword("charlie", "alice", "Uv9#kq2!Lm7$Wz4@", ""))
+	clock.Add(time.Hour * 24 * 400)
+	save(secrets.NewPassword("delta", "alice", "Xb8%rT3^nP6&yH1*", "https://delta.com"))
+	save(&secrets.Secret{Type: secrets.NoteType, Name: "note"})
+
+	findings, err := secrets.Audit(vlt)
+	require.NoError(t, err)
+	out := []string{}
+	for _, f := range findings {
+		out = append(out, f.Severity.String()+" "+string(f.Issue)+" "+f.Secret.Name)
+	}
+	require.Equal(t, []string{
+		"high reused bravo",
+		"high reused charlie",
+		"medium weak alpha",
+		"low stale alpha",
+		"low stale bravo",
+		"low stale charlie",
+		"low missing-url charlie",
+	}, out)
+	require.Equal(t, "password also used by charlie", findings[0].Detail)
+
+	// Breached (SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8)
+	breached := "# breached\n" +
+		"5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:3861493\n" +
+		"7C4A8D09CA3762AF61E59520943DC26494F8941B:123\n"
+	findings, err = secrets.Audit(vlt,
+		secrets.AuditBreached(strings.NewReader(breached)),
+		secrets.AuditStaleAge(0),
+		secrets.AuditMinEntropy(10))
+	require.NoError(t, err)
+	out = []string{}
+	for _, f := range findings {
+		out = append(out, f.Severity.String()+" "+string(f.Issue)+" "+f.Secret.Name)
+	}
+	require.Equal(t, []string{
+		"high breached alpha",
+		"high reused bravo",
+		"high reused charlie",
+		"low missing-url charlie",
+	}, out)
+	require.Equal(t, "password found in breached password hashes (3861493 times)", findings[0].Detail)
+
+	// Hash (no count)
+	findings, err = secrets.Audit(vlt, secrets.AuditBreached(strings.NewReader("5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8\n")))
+	require.NoError(t, err)
+	require.Equal(t, secrets.Breached, findings[0].Issue)
+	require.Equal(t, "password found in breached password hashes", findings[0].Detail)
+
+	// Range (for prefix 5BAA6), with padding
+	rng := "1CE7EB5C3A3F6C8E8AA6D4D2D7E3B4BEF7A:2\r\n" +
+		"1E4C9B93F3F0682250B6CF8331B7EE68FD8:3861493\r\n" +
+		"1E4C9B93F3F0682250B6CF8331B7EE68FD9:0\r\n" +
+		"1F2B668E8AABEF1C59E9EC6F82E3F3CD786:1\r\n"
+	findings, err = secrets.Audit(vlt, secrets.AuditBreachedRange("5baa6", strings.NewReader(rng)))
+	require.NoError(t, err)
+	require.Equal(t, secrets.Breached, findings[0].Issue)
+	require.Equal(t, "alpha", findings[0].Secret.Name)
+	require.Equal(t, "password found in breached password hashes (3861493 times)", findings[0].Detail)
+	findings, err = secrets.Audit(vlt, secrets.AuditBreachedRange("7C4A8", strings.NewReader(rng)))
+	require.NoError(t, err)
+	require.NotEqual(t, secrets.Breached, findings[0].Issue)
+	// Range without prefix
+	_, err = secrets.Audit(vlt, secrets.AuditBreached(strings.NewReader(rng)))
+	require.EqualError(t, err, "invalid breached password hash (line 1)")
+	_, err = secrets.Audit(vlt, secrets.AuditBreachedRange("5BA", strings.NewReader(rng)))
+	require.EqualError(t, err, `invalid breached password hash prefix "5BA"`)
+
+	// Hash prefixes aren't supported (false positives)
+	_, err = secrets.Audit(vlt, secrets.AuditBreached(strings.NewReader("5baa6\n")))
+	require.EqualError(t, err, "invalid breached password hash (line 1)")
+	_, err = secrets.Audit(vlt, secrets.AuditBreached(strings.NewReader("notahash\n")))
+	require.EqualError(t, err, "invalid breached password hash (line 1)")
+}
@@ -0,0 +1,94 @@
+package secrets
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ExportFormat identifies a secrets export bundle.
+const ExportFormat = "keys.pub/secrets"
+
+// ExportVersion is the current export bundle version.
+const ExportVersion = 1
+
+// Export is a bundle of secrets, for audits or migrating to another vault.
+type Export struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	Secrets   []*Secret `json:"secrets"`
+}
+
+// ExportJSON returns an export bundle (JSON).
+func ExportJSON(ss []*Secret, ts time.Time) ([]byte, error) {
+	if ss == nil {
+		ss = []*Secret{}
+	}
+	export := &Export{
+		Format:    ExportFormat,
+		Version:   ExportVersion,
+		CreatedAt: ts,
+		Secrets:   ss,
+	}
+	return json.MarshalIndent(export, "", "  ")
+}
+
+// ReadExport reads an export bundle (JSON).
+func ReadExport(r io.Reader) (*Export, error) {
+	var export Export
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, errors.Wrapf(err, "invalid secrets export")
+	}
+	if export.Format != ExportFormat {
+		return nil, errors.Errorf("invalid secrets export format")
+	}
+	if export.Version > ExportVersion {
+		return nil, errors.Errorf("unsupported secrets export version %d", export.Version)
+	}
+	return &export, nil
+}
+
+// ExportCSV writes secrets as CSV (unencrypted).
+// Custom fields are additional columns (by field name).
+// The columns can be read by ParseImport with the CSV format.
+func ExportCSV(w io.Writer, ss []*Secret) error {
+	header := []string{"name", "type", "url", "username", "password", "notes", "otp", "folder", "tags"}
+	fieldNames := []string{}
+	seen := map[string]bool{}
+	for _, s := range ss {
+		for _, f := range s.Fields {
+			if !seen[f.Name] {
+				seen[f.Name] = true
+				fieldNames = append(fieldNames, f.Name)
+			}
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(header, fieldNames...)); err != nil {
+		return err
+	}
+	for _, s := range ss {
+		record := []string{s.Name, string(s.Type), s.URL, s.Username, s.Password, s.Notes, s.OTP, s.Folder, strings.Join(s.Tags, ",")}
+		for _, name := range fieldNames {
+			value := ""
+			for _, f := range s.Fields {
+				if f.Name == name {
+					value = f.Value
+					break
+				}
+			}
+			record = append(record, value)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,73 @@
+package secrets_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn()
+
+	alice := secrets.NewPassword("keys.pub", "alice", "alicepassword", "https://keys.pub")
+	_, _, err := secrets.Save(vlt, alice)
+	require.NoError(t, err)
+	note := &secrets.Secret{
+		Type:   secrets.NoteType,
+		Name:   "Note",
+		Notes:  "line1\nline2, with comma",
+		Fields: []*secrets.Field{{Name: "Account", Type: secrets.TextField, Value: "12345"}},
+		Tags:   []string{"bank", "personal"},
+		Folder: "Finance",
+	}
+	_, _, err = secrets.Save(vlt, note)
+	require.NoError(t, err)
+	otp, err := secrets.NewOTP("Keys OTP", "otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	require.NoError(t, err)
+	_, _, err = secrets.Save(vlt, otp)
+	require.NoError(t, err)
+
+	ss, err := secrets.List(vlt, secrets.WithTypes(secrets.PasswordType, secrets.NoteType))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ss))
+	ss, err = secrets.List(vlt, secrets.WithName("keys"))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ss))
+	require.Equal(t, "Keys OTP", ss[0].Name)
+	require.Equal(t, "keys.pub", ss[1].Name)
+
+	// JSON
+	all, err := secrets.List(vlt)
+	require.NoError(t, err)
+	b, err := secrets.ExportJSON(all, time.Now())
+	require.NoError(t, err)
+	export, err := secrets.ReadExport(bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(export.Secrets))
+	require.Equal(t, all[0].ID, export.Secrets[0].ID)
+	require.Equal(t, all[2].Password, export.Secrets[2].Password)
+
+	// CSV (round trip with import)
+	var buf bytes.Buffer
+	err = secrets.ExportCSV(&buf, all)
+	require.NoError(t, err)
+	imported, err := secrets.ParseImport(&buf, secrets.CSV)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(imported))
+	for i, s := range imported {
+		require.Equal(t, all[i].Name, s.Name)
+		require.Equal(t, all[i].Type, s.Type)
+		require.Equal(t, all[i].URL, s.URL)
+		require.Equal(t, all[i].Username, s.Username)
+		require.Equal(t, all[i].Password, s.Password)
+		require.Equal(t, all[i].Notes, s.Notes)
+		require.Equal(t, all[i].OTP, s.OTP)
+		require.Equal(t, all[i].Fields, s.Fields)
+		require.Equal(t, all[i].Tags, s.Tags)
+		require.Equal(t, all[i].Folder, s.Folder)
+	}
+}
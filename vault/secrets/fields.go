@@ -0,0 +1,188 @@
+package secrets
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FieldType is the type of a custom field.
+type FieldType string
+
+// Field types.
+const (
+	// TextField is plain text (the default).
+	TextField FieldType = "text"
+	// HiddenField is text that should be hidden (when displayed), for example,
+	// an API key or a security question answer.
+	HiddenField FieldType = "hidden"
+	// URLField is a URL.
+	URLField FieldType = "url"
+	// DateField is a date (YYYY-MM-DD).
+	DateField FieldType = "date"
+)
+
+// DateFieldFormat is the format for DateField values.
+const DateFieldFormat = "2006-01-02"
+
+// Field is a custom field on a secret.
+type Field struct {
+	Name  string    `json:"name"`
+	Type  FieldType `json:"type,omitempty"`
+	Value string    `json:"value,omitempty"`
+}
+
+// Validate the field.
+func (f *Field) Validate() error {
+	if strings.TrimSpace(f.Name) == "" {
+		return errors.Errorf("field has no name")
+	}
+	switch f.Type {
+	case "", TextField, HiddenField:
+	case URLField:
+		if f.Value != "" {
+			if _, err := url.Parse(f.Value); err != nil {
+				return errors.Errorf("invalid url for field %q", f.Name)
+			}
+		}
+	case DateField:
+		if f.Value != "" {
+			if _, err := time.Parse(DateFieldFormat, f.Value); err != nil {
+				return errors.Errorf("invalid date for field %q, should be YYYY-MM-DD", f.Name)
+			}
+		}
+	default:
+		return errors.Errorf("invalid field type %q", f.Type)
+	}
+	return nil
+}
+
+// normalizeTags trims, lowercases, de-duplicates and sorts tags.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	set := map[string]bool{}
+	out := []string{}
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || set[tag] {
+			continue
+		}
+		set[tag] = true
+		out = append(out, tag)
+	}
+	sort.Strings(out)
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// normalizeFolder returns a folder path without leading, trailing or repeated
+// slashes, for example, "/Work//Servers/" => "Work/Servers".
+func normalizeFolder(folder string) string {
+	parts := []string{}
+	for _, p := range strings.Split(folder, "/") {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// normalize validates fields, and normalizes tags and folder.
+func (s *Secret) normalize() error {
+	for _, f := range s.Fields {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if f.Type == "" {
+			f.Type = TextField
+		}
+	}
+	s.Tags = normalizeTags(s.Tags)
+	s.Folder = normalizeFolder(s.Folder)
+	return nil
+}
+
+// HasTag returns true if secret has tag.
+func (s *Secret) HasTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, t := range s.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// InFolder returns true if secret is in folder (or a sub folder).
+func (s *Secret) InFolder(folder string) bool {
+	folder = normalizeFolder(folder)
+	if folder == "" {
+		return true
+	}
+	return s.Folder == folder || strings.HasPrefix(s.Folder, folder+"/")
+}
+
+func matchTags(secret *Secret, tags []string) bool {
+	for _, tag := range tags {
+		if !secret.HasTag(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchQuery returns true if the query is in the name, username, URL, notes,
+// tags or custom field (that aren't hidden) values.
+func matchQuery(secret *Secret, query string) bool {
+	if query == "" ||
+		strings.Contains(secret.Name, query) ||
+		strings.Contains(secret.Username, query) ||
+		strings.Contains(secret.URL, query) ||
+		strings.Contains(secret.Notes, query) {
+		return true
+	}
+	for _, tag := range secret.Tags {
+		if strings.Contains(tag, strings.ToLower(query)) {
+			return true
+		}
+	}
+	for _, f := range secret.Fields {
+		if strings.Contains(f.Name, query) {
+			return true
+		}
+		if f.Type != HiddenField && strings.Contains(f.Value, query) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseQuery extracts "tag:" and "folder:" terms from a query, for example,
+// "tag:work folder:Servers ssh" returns ("ssh", ["work"], "Servers").
+func parseQuery(query string) (string, []string, string) {
+	terms := []string{}
+	tags := []string{}
+	folder := ""
+	for _, term := range strings.Fields(query) {
+		switch {
+		case strings.HasPrefix(term, "tag:"):
+			tags = append(tags, strings.TrimPrefix(term, "tag:"))
+		case strings.HasPrefix(term, "folder:"):
+			folder = strings.TrimPrefix(term, "folder:")
+		default:
+			terms = append(terms, term)
+		}
+	}
+	if len(tags) == 0 && folder == "" {
+		return strings.TrimSpace(query), nil, ""
+	}
+	return strings.Join(terms, " "), tags, folder
+}
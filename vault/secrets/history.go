@@ -0,0 +1,48 @@
+package secrets
+
+import (
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault"
+)
+
+// Version of a secret.
+type Version struct {
+	Version int64
+	// Secret at this version, or nil if it was deleted.
+	Secret    *Secret
+	UpdatedAt time.Time
+}
+
+// History returns versions of a secret, oldest first.
+func History(v *vault.Vault, id string) ([]*Version, error) {
+	items, err := v.ItemHistory(id)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Version, 0, len(items))
+	for _, item := range items {
+		version := &Version{Version: item.Version, UpdatedAt: item.UpdatedAt}
+		if len(item.Data) > 0 {
+			if !IsSecret(item) {
+				continue
+			}
+			secret, err := asSecret(item)
+			if err != nil {
+				return nil, err
+			}
+			version.Secret = secret
+		}
+		out = append(out, version)
+	}
+	return out, nil
+}
+
+// Restore a secret to a version from its history.
+func Restore(v *vault.Vault, id string, version int64) (*Secret, error) {
+	item, err := v.Restore(id, version)
+	if err != nil {
+		return nil, err
+	}
+	return asSecret(item)
+}
@@ -0,0 +1,519 @@
+package secrets
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/pkg/errors"
+)
+
+// ImportFormat is a format for importing secrets (from another password
+// manager).
+type ImportFormat string
+
+// Import formats.
+const (
+	// BitwardenJSON is a Bitwarden (unencrypted) JSON export.
+	BitwardenJSON ImportFormat = "bitwarden"
+	// OnePasswordCSV is a 1Password CSV export.
+	OnePasswordCSV ImportFormat = "1password"
+	// KeePassXML is a KeePass (2.x) XML export.
+	KeePassXML ImportFormat = "keepass"
+	// CSV with a header row (name, url, username, password, notes, otp).
+	CSV ImportFormat = "csv"
+)
+
+// ImportFormats are the supported import formats.
+var ImportFormats = []ImportFormat{BitwardenJSON, OnePasswordCSV, KeePassXML, CSV}
+
+// ParseImport parses secrets from an export of another password manager.
+// Fields that don't map to a Secret are added as custom fields.
+func ParseImport(r io.Reader, format ImportFormat) ([]*Secret, error) {
+	switch format {
+	case BitwardenJSON:
+		return parseBitwarden(r)
+	case OnePasswordCSV:
+		return parseCSV(r, onePasswordColumns)
+	case KeePassXML:
+		return parseKeePass(r)
+	case CSV:
+		return parseCSV(r, csvColumns)
+	default:
+		return nil, errors.Errorf("invalid import format %q", format)
+	}
+}
+
+// ImportResult is the result of an import.
+type ImportResult struct {
+	// Added secrets (or secrets that would be added, with DryRun).
+	Added []*Secret
+	// Skipped secrets, that have the same name and URL as an existing secret.
+	Skipped []*Secret
+}
+
+// ImportOptions ...
+type ImportOptions struct {
+	DryRun bool
+}
+
+// ImportOption ...
+type ImportOption func(*ImportOptions)
+
+// ImportDryRun to check what would be imported, without saving.
+func ImportDryRun() ImportOption {
+	return func(o *ImportOptions) { o.DryRun = true }
+}
+
+// Import secrets into the vault.
+// Secrets with the same name and URL as an existing secret (or a secret
+// earlier in the import) are skipped.
+func Import(v *vault.Vault, ss []*Secret, opt ...ImportOption) (*ImportResult, error) {
+	var opts ImportOptions
+	for _, o := range opt {
+		o(&opts)
+	}
+
+	existing, err := List(v)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, secret := range existing {
+		seen[importKey(secret)] = true
+	}
+
+	result := &ImportResult{Added: []*Secret{}, Skipped: []*Secret{}}
+	for _, secret := range ss {
+		key := importKey(secret)
+		if seen[key] {
+			result.Skipped = append(result.Skipped, secret)
+			continue
+		}
+		seen[key] = true
+		if !opts.DryRun {
+			if _, _, err := Save(v, secret); err != nil {
+				return nil, err
+			}
+		}
+		result.Added = append(result.Added, secret)
+	}
+	return result, nil
+}
+
+func importKey(secret *Secret) string {
+	return strings.TrimSpace(secret.Name) + "\x00" + strings.TrimSpace(secret.URL)
+}
+
+func appendNote(notes string, name string, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return notes
+	}
+	line := value
+	if name != "" {
+		line = name + ": " + value
+	}
+	if notes == "" {
+		return line
+	}
+	return notes + "\n" + line
+}
+
+// importOTP returns an otpauth:// URI, from a URI or a (base32) TOTP secret.
+func importOTP(name string, s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", true
+	}
+	if otp, err := ParseOTP(s); err == nil {
+		return otp.URI(), true
+	}
+	secret, err := decodeOTPSecret(s)
+	if err != nil {
+		return "", false
+	}
+	otp := &OTP{Kind: TOTP, Account: name, Secret: secret}
+	return otp.URI(), true
+}
+
+func newImportSecret(name string) *Secret {
+	secret := newSecret()
+	secret.Name = strings.TrimSpace(name)
+	return secret
+}
+
+// setImportOTP sets the OTP, or if invalid, adds it to the notes.
+func setImportOTP(secret *Secret, label string, s string) {
+	uri, ok := importOTP(secret.Name, s)
+	if !ok {
+		secret.Notes = appendNote(secret.Notes, label, s)
+		return
+	}
+	secret.OTP = uri
+}
+
+// Bitwarden
+
+type bitwardenExport struct {
+	Encrypted bool `json:"encrypted"`
+	Folders   []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"folders"`
+	Items []*bitwardenItem `json:"items"`
+}
+
+type bitwardenItem struct {
+	ID       string `json:"id"`
+	FolderID string `json:"folderId"`
+	Type     int    `json:"type"`
+	Name     string `json:"name"`
+	Notes    string `json:"notes"`
+	Fields   []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+		Type  int    `json:"type"`
+	} `json:"fields"`
+	Login *struct {
+		URIs []struct {
+			URI string `json:"uri"`
+		} `json:"uris"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+		TOTP     string `json:"totp"`
+	} `json:"login"`
+	Card     map[string]interface{} `json:"card"`
+	Identity map[string]interface{} `json:"identity"`
+}
+
+const (
+	bitwardenLogin = 1
+	bitwardenNote  = 2
+	bitwardenCard  = 3
+	bitwardenID    = 4
+
+	bitwardenHiddenField = 1
+)
+
+func parseBitwarden(r io.Reader) ([]*Secret, error) {
+	var export bitwardenExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, errors.Wrapf(err, "invalid bitwarden export")
+	}
+	if export.Encrypted {
+		return nil, errors.Errorf("encrypted bitwarden exports aren't supported")
+	}
+	folders := map[string]string{}
+	for _, folder := range export.Folders {
+		folders[folder.ID] = folder.Name
+	}
+
+	out := make([]*Secret, 0, len(export.Items))
+	for _, item := range export.Items {
+		secret := newImportSecret(item.Name)
+		secret.Notes = strings.TrimSpace(item.Notes)
+		switch item.Type {
+		case bitwardenLogin:
+			secret.Type = PasswordType
+			if item.Login != nil {
+				secret.Username = item.Login.Username
+				secret.Password = item.Login.Password
+				for i, uri := range item.Login.URIs {
+					if i == 0 {
+						secret.URL = uri.URI
+						continue
+					}
+					secret.Fields = appendField(secret.Fields, "URL", URLField, uri.URI)
+				}
+				setImportOTP(secret, "TOTP", item.Login.TOTP)
+			}
+		case bitwardenNote:
+			secret.Type = NoteType
+		case bitwardenCard:
+			secret.Type = NoteType
+			secret.Fields = appendMapFields(secret.Fields, item.Card, "number", "code")
+		case bitwardenID:
+			secret.Type = NoteType
+			secret.Fields = appendMapFields(secret.Fields, item.Identity, "ssn", "passportNumber", "licenseNumber")
+		default:
+			return nil, errors.Errorf("invalid bitwarden item type %d", item.Type)
+		}
+		for _, field := range item.Fields {
+			typ := TextField
+			if field.Type == bitwardenHiddenField {
+				typ = HiddenField
+			}
+			secret.Fields = appendField(secret.Fields, field.Name, typ, field.Value)
+		}
+		secret.Folder = normalizeFolder(folders[item.FolderID])
+		out = append(out, secret)
+	}
+	return out, nil
+}
+
+// appendField adds a custom field (if the value isn't empty).
+func appendField(fields []*Field, name string, typ FieldType, value string) []*Field {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return fields
+	}
+	if name == "" {
+		name = "Field"
+	}
+	return append(fields, &Field{Name: name, Type: typ, Value: value})
+}
+
+// appendMapFields adds values as custom fields, in sorted key order.
+func appendMapFields(fields []*Field, m map[string]interface{}, hidden ...string) []*Field {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if m[k] == nil {
+			continue
+		}
+		typ := TextField
+		for _, h := range hidden {
+			if k == h {
+				typ = HiddenField
+			}
+		}
+		fields = appendField(fields, k, typ, fmt.Sprintf("%v", m[k]))
+	}
+	return fields
+}
+
+// CSV
+
+type csvField string
+
+const (
+	csvName     csvField = "name"
+	csvURL      csvField = "url"
+	csvUsername csvField = "username"
+	csvPassword csvField = "password"
+	csvNotes    csvField = "notes"
+	csvOTP      csvField = "otp"
+	csvType     csvField = "type"
+	csvTags     csvField = "tags"
+	csvFolder   csvField = "folder"
+	csvIgnore   csvField = "-"
+)
+
+var csvColumns = map[string]csvField{
+	"name":     csvName,
+	"title":    csvName,
+	"url":      csvURL,
+	"uri":      csvURL,
+	"website":  csvURL,
+	"username": csvUsername,
+	"login":    csvUsername,
+	"email":    csvUsername,
+	"password": csvPassword,
+	"notes":    csvNotes,
+	"note":     csvNotes,
+	"otp":      csvOTP,
+	"totp":     csvOTP,
+	"type":     csvType,
+	"tags":     csvTags,
+	"folder":   csvFolder,
+	"group":    csvFolder,
+}
+
+var onePasswordColumns = map[string]csvField{
+	"title":             csvName,
+	"website":           csvURL,
+	"url":               csvURL,
+	"urls":              csvURL,
+	"username":          csvUsername,
+	"password":          csvPassword,
+	"notes":             csvNotes,
+	"notesplain":        csvNotes,
+	"otpauth":           csvOTP,
+	"tags":              csvTags,
+	"one-time password": csvOTP,
+	"favorite":          csvIgnore,
+	"archived":          csvIgnore,
+	"uuid":              csvIgnore,
+}
+
+func parseCSV(r io.Reader, columns map[string]csvField) ([]*Secret, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid csv")
+	}
+	if len(records) == 0 {
+		return []*Secret{}, nil
+	}
+	header := records[0]
+	fields := make([]csvField, len(header))
+	hasName := false
+	for i, h := range header {
+		h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+		header[i] = h
+		fields[i] = columns[strings.ToLower(h)]
+		if fields[i] == csvName {
+			hasName = true
+		}
+	}
+	if !hasName {
+		return nil, errors.Errorf("invalid csv, no name (or title) column")
+	}
+
+	out := make([]*Secret, 0, len(records)-1)
+	for _, record := range records[1:] {
+		secret := newImportSecret("")
+		var otp string
+		var typ Type
+		for i, value := range record {
+			if i >= len(fields) {
+				break
+			}
+			switch fields[i] {
+			case csvName:
+				secret.Name = strings.TrimSpace(value)
+			case csvURL:
+				secret.URL = strings.TrimSpace(value)
+			case csvUsername:
+				secret.Username = value
+			case csvPassword:
+				secret.Password = value
+			case csvNotes:
+				secret.Notes = appendNote(secret.Notes, "", value)
+			case csvOTP:
+				otp = value
+			case csvType:
+				typ = Type(strings.TrimSpace(value))
+			case csvTags:
+				secret.Tags = normalizeTags(strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }))
+			case csvFolder:
+				secret.Folder = normalizeFolder(value)
+			case csvIgnore:
+			default:
+				secret.Fields = appendField(secret.Fields, header[i], TextField, value)
+			}
+		}
+		setImportOTP(secret, "OTP", otp)
+		if secret.Name == "" && secret.URL == "" && secret.Username == "" && secret.Password == "" {
+			continue
+		}
+		switch typ {
+		case PasswordType, NoteType, OTPType:
+			secret.Type = typ
+		default:
+			secret.Type = importType(secret)
+		}
+		out = append(out, secret)
+	}
+	return out, nil
+}
+
+func importType(secret *Secret) Type {
+	if secret.Username == "" && secret.Password == "" && secret.URL == "" && secret.OTP == "" {
+		return NoteType
+	}
+	return PasswordType
+}
+
+// KeePass
+
+type keePassFile struct {
+	Meta struct {
+		RecycleBinEnabled string `xml:"RecycleBinEnabled"`
+		RecycleBinUUID    string `xml:"RecycleBinUUID"`
+	} `xml:"Meta"`
+	Root struct {
+		Groups []*keePassGroup `xml:"Group"`
+	} `xml:"Root"`
+}
+
+type keePassGroup struct {
+	UUID    string          `xml:"UUID"`
+	Name    string          `xml:"Name"`
+	Entries []*keePassEntry `xml:"Entry"`
+	Groups  []*keePassGroup `xml:"Group"`
+}
+
+type keePassEntry struct {
+	Strings []struct {
+		Key   string `xml:"Key"`
+		Value struct {
+			Value     string `xml:",chardata"`
+			Protected string `xml:"ProtectInMemory,attr"`
+		} `xml:"Value"`
+	} `xml:"String"`
+	Tags string `xml:"Tags"`
+}
+
+func parseKeePass(r io.Reader) ([]*Secret, error) {
+	var file keePassFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, errors.Wrapf(err, "invalid keepass export")
+	}
+	recycleBin := ""
+	if strings.EqualFold(file.Meta.RecycleBinEnabled, "true") {
+		recycleBin = file.Meta.RecycleBinUUID
+	}
+	out := []*Secret{}
+	var walk func(group *keePassGroup, path []string)
+	walk = func(group *keePassGroup, path []string) {
+		if recycleBin != "" && group.UUID == recycleBin {
+			return
+		}
+		for _, entry := range group.Entries {
+			out = append(out, keePassSecret(entry, path))
+		}
+		for _, g := range group.Groups {
+			walk(g, append(append([]string{}, path...), g.Name))
+		}
+	}
+	for _, group := range file.Root.Groups {
+		// The root group (usually the database name) isn't included in the
+		// path.
+		walk(group, []string{})
+	}
+	return out, nil
+}
+
+func keePassSecret(entry *keePassEntry, path []string) *Secret {
+	secret := newImportSecret("")
+	var otp string
+	for _, s := range entry.Strings {
+		value := s.Value.Value
+		switch s.Key {
+		case "Title":
+			secret.Name = strings.TrimSpace(value)
+		case "UserName":
+			secret.Username = value
+		case "Password":
+			secret.Password = value
+		case "URL":
+			secret.URL = strings.TrimSpace(value)
+		case "Notes":
+			secret.Notes = appendNote(secret.Notes, "", value)
+		case "otp", "TimeOtp-Secret-Base32":
+			otp = value
+		default:
+			typ := TextField
+			if strings.EqualFold(s.Value.Protected, "true") {
+				typ = HiddenField
+			}
+			secret.Fields = appendField(secret.Fields, s.Key, typ, value)
+		}
+	}
+	setImportOTP(secret, "OTP", otp)
+	secret.Tags = normalizeTags(strings.FieldsFunc(entry.Tags, func(r rune) bool { return r == ',' || r == ';' }))
+	secret.Folder = strings.Join(path, "/")
+	secret.Type = importType(secret)
+	return secret
+}
@@ -0,0 +1,217 @@
+package secrets_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+const testBitwarden = `{
+  "encrypted": false,
+  "folders": [{"id": "f1", "name": "Work"}],
+  "items": [
+    {
+      "id": "i1",
+      "folderId": "f1",
+      "type": 1,
+      "name": "keys.pub",
+      "notes": "my note",
+      "fields": [{"name": "PIN", "value": "1234", "type": 1}],
+      "login": {
+        "uris": [{"match": null, "uri": "https://keys.pub"}, {"match": null, "uri": "https://keys.pub/login"}],
+        "username": "alice",
+        "password": "alicepassword",
+        "totp": "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+      }
+    },
+    {
+      "id": "i2",
+      "folderId": null,
+      "type": 2,
+      "name": "Secure note",
+      "notes": "secret stuff",
+      "secureNote": {"type": 0}
+    },
+    {
+      "id": "i3",
+      "folderId": null,
+      "type": 3,
+      "name": "Card",
+      "notes": null,
+      "card": {"cardholderName": "Alice", "brand": "Visa", "number": "4111111111111111", "code": null}
+    }
+  ]
+}`
+
+func TestImportBitwarden(t *testing.T) {
+	ss, err := secrets.ParseImport(strings.NewReader(testBitwarden), secrets.BitwardenJSON)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(ss))
+
+	require.Equal(t, secrets.PasswordType, ss[0].Type)
+	require.Equal(t, "keys.pub", ss[0].Name)
+	require.Equal(t, "alice", ss[0].Username)
+	require.Equal(t, "alicepassword", ss[0].Password)
+	require.Equal(t, "https://keys.pub", ss[0].URL)
+	require.Equal(t, "otpauth://totp/keys.pub?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", ss[0].OTP)
+	require.Equal(t, "my note", ss[0].Notes)
+	require.Equal(t, []*secrets.Field{
+		{Name: "URL", Type: secrets.URLField, Value: "https://keys.pub/login"},
+		{Name: "PIN", Type: secrets.HiddenField, Value: "1234"},
+	}, ss[0].Fields)
+	require.Equal(t, "Work", ss[0].Folder)
+
+	require.Equal(t, secrets.NoteType, ss[1].Type)
+	require.Equal(t, "secret stuff", ss[1].Notes)
+
+	require.Equal(t, secrets.NoteType, ss[2].Type)
+	require.Equal(t, "", ss[2].Notes)
+	require.Equal(t, []*secrets.Field{
+		{Name: "brand", Type: secrets.TextField, Value: "Visa"},
+		{Name: "cardholderName", Type: secrets.TextField, Value: "Alice"},
+		{Name: "number", Type: secrets.HiddenField, Value: "4111111111111111"},
+	}, ss[2].Fields)
+
+	_, err = secrets.ParseImport(strings.NewReader(`{"encrypted": true, "items": []}`), secrets.BitwardenJSON)
+	require.EqualError(t, err, "encrypted bitwarden exports aren't supported")
+}
+
+func TestImportOnePassword(t *testing.T) {
+	in := "Title,Website,Username,Password,OTPAuth,Favorite,Archived,Tags,Notes\n" +
+		"keys.pub,https://keys.pub,alice,alicepassword,,false,false,Work,\"my\nnote\"\n" +
+		"Example,https://example.com,bob,bobpassword,otpauth://totp/Example:bob?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&issuer=Example,true,false,,\n"
+	ss, err := secrets.ParseImport(strings.NewReader(in), secrets.OnePasswordCSV)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ss))
+	require.Equal(t, "keys.pub", ss[0].Name)
+	require.Equal(t, "https://keys.pub", ss[0].URL)
+	require.Equal(t, "alice", ss[0].Username)
+	require.Equal(t, "alicepassword", ss[0].Password)
+	require.Equal(t, "my\nnote", ss[0].Notes)
+	require.Equal(t, []string{"work"}, ss[0].Tags)
+	require.Equal(t, "", ss[0].OTP)
+	require.Equal(t, "otpauth://totp/Example:bob?issuer=Example&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", ss[1].OTP)
+	require.Equal(t, "", ss[1].Notes)
+}
+
+func TestImportCSV(t *testing.T) {
+	in := "name,url,username,password,notes,group,pin\n" +
+		"keys.pub,https://keys.pub,alice,alicepassword,,Work,1234\n" +
+		"Note,,,,just a note,,\n" +
+		",,,,,,\n"
+	ss, err := secrets.ParseImport(strings.NewReader(in), secrets.CSV)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ss))
+	require.Equal(t, secrets.PasswordType, ss[0].Type)
+	require.Equal(t, "", ss[0].Notes)
+	require.Equal(t, "Work", ss[0].Folder)
+	require.Equal(t, []*secrets.Field{{Name: "pin", Type: secrets.TextField, Value: "1234"}}, ss[0].Fields)
+	require.Equal(t, secrets.NoteType, ss[1].Type)
+	require.Equal(t, "just a note", ss[1].Notes)
+
+	_, err = secrets.ParseImport(strings.NewReader("url,password\nhttps://keys.pub,password\n"), secrets.CSV)
+	require.EqualError(t, err, "invalid csv, no name (or title) column")
+
+	_, err = secrets.ParseImport(strings.NewReader(""), "lastpass")
+	require.EqualError(t, err, `invalid import format "lastpass"`)
+}
+
+const testKeePass = `<?xml version="1.0" encoding="utf-8" standalone="yes"?>
+<KeePassFile>
+  <Meta>
+    <RecycleBinEnabled>True</RecycleBinEnabled>
+    <RecycleBinUUID>cmVjeWNsZQ==</RecycleBinUUID>
+  </Meta>
+  <Root>
+    <Group>
+      <UUID>cm9vdA==</UUID>
+      <Name>Database</Name>
+      <Entry>
+        <String><Key>Notes</Key><Value>my note</Value></String>
+        <String><Key>Password</Key><Value ProtectInMemory="True">alicepassword</Value></String>
+        <String><Key>Title</Key><Value>keys.pub</Value></String>
+        <String><Key>URL</Key><Value>https://keys.pub</Value></String>
+        <String><Key>UserName</Key><Value>alice</Value></String>
+        <String><Key>Recovery</Key><Value ProtectInMemory="True">abcd-efgh</Value></String>
+        <Tags>Web;Personal</Tags>
+        <String><Key>otp</Key><Value>otpauth://totp/keys.pub:alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&amp;issuer=keys.pub</Value></String>
+        <History>
+          <Entry>
+            <String><Key>Password</Key><Value>oldpassword</Value></String>
+            <String><Key>Title</Key><Value>keys.pub</Value></String>
+          </Entry>
+        </History>
+      </Entry>
+      <Group>
+        <UUID>d29yaw==</UUID>
+        <Name>Work</Name>
+        <Entry>
+          <String><Key>Password</Key><Value>bobpassword</Value></String>
+          <String><Key>Title</Key><Value>Example</Value></String>
+          <String><Key>UserName</Key><Value>bob</Value></String>
+        </Entry>
+      </Group>
+      <Group>
+        <UUID>cmVjeWNsZQ==</UUID>
+        <Name>Recycle Bin</Name>
+        <Entry>
+          <String><Key>Title</Key><Value>Deleted</Value></String>
+        </Entry>
+      </Group>
+    </Group>
+  </Root>
+</KeePassFile>`
+
+func TestImportKeePass(t *testing.T) {
+	ss, err := secrets.ParseImport(strings.NewReader(testKeePass), secrets.KeePassXML)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ss))
+	require.Equal(t, "keys.pub", ss[0].Name)
+	require.Equal(t, "alice", ss[0].Username)
+	require.Equal(t, "alicepassword", ss[0].Password)
+	require.Equal(t, "https://keys.pub", ss[0].URL)
+	require.Equal(t, "my note", ss[0].Notes)
+	require.Equal(t, []*secrets.Field{{Name: "Recovery", Type: secrets.HiddenField, Value: "abcd-efgh"}}, ss[0].Fields)
+	require.Equal(t, []string{"personal", "web"}, ss[0].Tags)
+	require.Equal(t, "", ss[0].Folder)
+	require.Equal(t, "otpauth://totp/keys.pub:alice?issuer=keys.pub&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", ss[0].OTP)
+	require.Equal(t, "Example", ss[1].Name)
+	require.Equal(t, "", ss[1].Notes)
+	require.Equal(t, "Work", ss[1].Folder)
+}
+
+func TestImport(t *testing.T) {
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn()
+
+	existing := secrets.NewPassword("keys.pub", "alice", "alicepassword", "https://keys.pub")
+	_, _, err := secrets.Save(vlt, existing)
+	require.NoError(t, err)
+
+	ss, err := secrets.ParseImport(strings.NewReader(testBitwarden), secrets.BitwardenJSON)
+	require.NoError(t, err)
+
+	result, err := secrets.Import(vlt, ss, secrets.ImportDryRun())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Added))
+	require.Equal(t, 1, len(result.Skipped))
+	require.Equal(t, "keys.pub", result.Skipped[0].Name)
+	out, err := secrets.List(vlt)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(out))
+
+	result, err = secrets.Import(vlt, ss)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Added))
+	out, err = secrets.List(vlt)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(out))
+
+	// Import again (all duplicates)
+	result, err = secrets.Import(vlt, ss)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(result.Added))
+	require.Equal(t, 3, len(result.Skipped))
+}
@@ -0,0 +1,27 @@
+package secrets
+
+import "github.com/keys-pub/keys-ext/vault"
+
+// IndexOption indexes secrets in the vault (for Search and List queries).
+func IndexOption() vault.Option {
+	return vault.WithIndexer(secretItemType, indexSecret)
+}
+
+// indexSecret returns the search terms for a secret item, the same as
+// matchQuery: name, username, URL, notes, tags and custom fields (names, and
+// values that aren't hidden).
+func indexSecret(item *vault.Item) ([]string, error) {
+	secret, err := asSecret(item)
+	if err != nil {
+		return nil, err
+	}
+	terms := []string{secret.Name, secret.Username, secret.URL, secret.Notes}
+	terms = append(terms, secret.Tags...)
+	for _, f := range secret.Fields {
+		terms = append(terms, f.Name)
+		if f.Type != HiddenField {
+			terms = append(terms, f.Value)
+		}
+	}
+	return terms, nil
+}
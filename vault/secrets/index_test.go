@@ -0,0 +1,55 @@
+package secrets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretsSearch(t *testing.T) {
+	clock := tsutil.NewTestClock()
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+
+	github := secrets.NewPassword("GitHub", "alice", "alicepassword", "https://github.com")
+	github.Tags = []string{"work"}
+	_, _, err := secrets.Save(vlt, github)
+	require.NoError(t, err)
+	gitlab := secrets.NewPassword("GitLab", "alice", "alicepassword", "https://gitlab.com")
+	gitlab.ExpireAt = clock.Now()
+	_, _, err = secrets.Save(vlt, gitlab)
+	require.NoError(t, err)
+
+	names := func(opt ...secrets.Option) []string {
+		ss, err := secrets.List(vlt, opt...)
+		require.NoError(t, err)
+		out := []string{}
+		for _, s := range ss {
+			out = append(out, s.Name)
+		}
+		return out
+	}
+	require.Equal(t, []string{"GitHub", "GitLab"}, names(secrets.WithQuery("git")))
+	// Substring
+	require.Equal(t, []string{"GitHub"}, names(secrets.WithQuery("hub")))
+	require.Equal(t, []string{"GitHub"}, names(secrets.WithQuery("GitH")))
+	require.Equal(t, []string{"GitHub", "GitLab"}, names(secrets.WithQuery("ps://git")))
+	require.Equal(t, []string{"GitHub", "GitLab"}, names(secrets.WithQuery("://")))
+	require.Equal(t, []string{}, names(secrets.WithQuery("gith ub")))
+	// Not fuzzy (same as without an index)
+	require.Equal(t, []string{}, names(secrets.WithQuery("githbu")))
+	require.Equal(t, []string{"GitHub"}, names(secrets.WithQuery("git tag:work")))
+	require.Equal(t, []string{"GitHub"}, names(secrets.WithQuery("git"), secrets.WithSkipExpired()))
+	require.Equal(t, []string{"GitLab"}, names(secrets.WithQuery("alice"), secrets.WithExpiring(time.Hour)))
+
+	// Updated
+	github.Name = "Bitbucket"
+	github.URL = "https://bitbucket.org"
+	_, _, err = secrets.Save(vlt, github)
+	require.NoError(t, err)
+	require.Equal(t, []string{"GitLab"}, names(secrets.WithQuery("git")))
+	require.Equal(t, []string{"Bitbucket"}, names(secrets.WithQuery("bitbucket")))
+}
@@ -0,0 +1,203 @@
+package secrets
+
+import (
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/pkg/errors"
+)
+
+// Merge resolves a vault.Conflict between secrets by merging fields.
+//
+// Fields changed on only one side (from the base version) are kept.
+// If a field was changed on both sides, the incoming value wins, except for
+// notes, which are combined.
+// If one side was deleted, the other side is kept.
+// Items that aren't secrets are resolved with vault.LastWriterWins, so this
+// can be used as a vault.ConflictResolver for the whole vault.
+func Merge(conflict *vault.Conflict) ([]*vault.Item, error) {
+	current, incoming := conflict.Current, conflict.Incoming
+	if current.Type != secretItemType || incoming.Type != secretItemType {
+		return vault.LastWriterWins(conflict)
+	}
+	if len(current.Data) == 0 {
+		return []*vault.Item{incoming}, nil
+	}
+	if len(incoming.Data) == 0 {
+		return []*vault.Item{current}, nil
+	}
+
+	s1, err := asSecret(current)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to merge")
+	}
+	s2, err := asSecret(incoming)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to merge")
+	}
+	base := &Secret{}
+	if conflict.Base != nil && len(conflict.Base.Data) > 0 {
+		b, err := asSecret(conflict.Base)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to merge")
+		}
+		base = b
+	}
+
+	merged := &Secret{
+		ID:        conflict.ID,
+		Type:      Type(mergeField(string(base.Type), string(s1.Type), string(s2.Type))),
+		Name:      mergeField(base.Name, s1.Name, s2.Name),
+		Username:  mergeField(base.Username, s1.Username, s2.Username),
+		Password:  mergeField(base.Password, s1.Password, s2.Password),
+		URL:       mergeField(base.URL, s1.URL, s2.URL),
+		Notes:     mergeNotes(base.Notes, s1.Notes, s2.Notes),
+		OTP:       mergeOTP(base.OTP, s1.OTP, s2.OTP),
+		Fields:    mergeFields(base.Fields, s1.Fields, s2.Fields),
+		Tags:      mergeTags(base.Tags, s1.Tags, s2.Tags),
+		Folder:    mergeField(base.Folder, s1.Folder, s2.Folder),
+		ExpireAt:  mergeTime(base.ExpireAt, s1.ExpireAt, s2.ExpireAt),
+		CreatedAt: s2.CreatedAt,
+		UpdatedAt: s2.UpdatedAt,
+	}
+	if s1.UpdatedAt.After(merged.UpdatedAt) {
+		merged.UpdatedAt = s1.UpdatedAt
+	}
+
+	item, err := newItemForSecret(merged)
+	if err != nil {
+		return nil, err
+	}
+	item.CreatedAt = incoming.CreatedAt
+	return []*vault.Item{item}, nil
+}
+
+// KeepBoth resolves a vault.Conflict by keeping the incoming secret and saving
+// the current (overwritten) secret as a new secret, see vault.KeepBoth.
+// The ID in the copied secret is changed to the new item ID, so the copy can be
+// fetched, edited and removed like any other secret.
+// Items that aren't secrets are resolved with vault.KeepBoth.
+func KeepBoth(conflict *vault.Conflict) ([]*vault.Item, error) {
+	items, err := vault.KeepBoth(conflict)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) < 2 || conflict.Current.Type != secretItemType {
+		return items, nil
+	}
+	kept := items[1]
+	secret, err := asSecret(kept)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to keep both")
+	}
+	secret.ID = kept.ID
+	item, err := newItemForSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	item.CreatedAt = kept.CreatedAt
+	items[1] = item
+	return items, nil
+}
+
+func mergeTime(base time.Time, current time.Time, incoming time.Time) time.Time {
+	if current.Equal(incoming) {
+		return incoming
+	}
+	if incoming.Equal(base) {
+		return current
+	}
+	return incoming
+}
+
+func mergeField(base string, current string, incoming string) string {
+	if current == incoming {
+		return incoming
+	}
+	if incoming == base {
+		return current
+	}
+	return incoming
+}
+
+func mergeNotes(base string, current string, incoming string) string {
+	if current == incoming || current == base || current == "" {
+		return incoming
+	}
+	if incoming == base || incoming == "" {
+		return current
+	}
+	return incoming + "\n\n" + current
+}
+
+// mergeTags keeps tags added on either side, and removes tags removed on
+// either side.
+func mergeTags(base []string, current []string, incoming []string) []string {
+	inBase := map[string]bool{}
+	for _, tag := range base {
+		inBase[tag] = true
+	}
+	inCurrent := map[string]bool{}
+	for _, tag := range current {
+		inCurrent[tag] = true
+	}
+	inIncoming := map[string]bool{}
+	for _, tag := range incoming {
+		inIncoming[tag] = true
+	}
+	out := []string{}
+	for _, tag := range append(append([]string{}, current...), incoming...) {
+		if inBase[tag] && (!inCurrent[tag] || !inIncoming[tag]) {
+			continue
+		}
+		out = append(out, tag)
+	}
+	return normalizeTags(out)
+}
+
+// mergeFields merges custom fields by name.
+// A field changed on only one side is kept, if changed on both sides, the
+// incoming field wins. A field removed on one side (and unchanged on the other)
+// is removed.
+func mergeFields(base []*Field, current []*Field, incoming []*Field) []*Field {
+	byName := func(fields []*Field) map[string]*Field {
+		m := map[string]*Field{}
+		for _, f := range fields {
+			m[f.Name] = f
+		}
+		return m
+	}
+	b, c, i := byName(base), byName(current), byName(incoming)
+	names := []string{}
+	seen := map[string]bool{}
+	for _, f := range append(append([]*Field{}, incoming...), current...) {
+		if !seen[f.Name] {
+			seen[f.Name] = true
+			names = append(names, f.Name)
+		}
+	}
+	out := []*Field{}
+	for _, name := range names {
+		bf, cf, inf := fieldString(b[name]), fieldString(c[name]), fieldString(i[name])
+		merged := mergeField(bf, cf, inf)
+		switch merged {
+		case "":
+			continue
+		case inf:
+			out = append(out, i[name])
+		default:
+			out = append(out, c[name])
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func fieldString(f *Field) string {
+	if f == nil {
+		return ""
+	}
+	return string(f.Type) + "\x00" + f.Value
+}
@@ -0,0 +1,118 @@
+package secrets_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+func testSecretItem(t *testing.T, secret *secrets.Secret, version int64) *vault.Item {
+	b, err := json.Marshal(secret)
+	require.NoError(t, err)
+	item := vault.NewItem(secret.ID, b, "secret", time.Time{})
+	item.Version = version
+	return item
+}
+
+func TestMerge(t *testing.T) {
+	id := "Ibgoe3sXvdpxFUeR1hSUriTRdxvcoWjou80WnPiFcPC"
+	base := &secrets.Secret{ID: id, Type: secrets.PasswordType, Name: "keys.pub", Username: "alice", Password: "password1", Notes: "note"}
+	current := &secrets.Secret{ID: id, Type: secrets.PasswordType, Name: "keys.pub", Username: "alice2", Password: "password2", Notes: "note1"}
+	incoming := &secrets.Secret{ID: id, Type: secrets.PasswordType, Name: "Keys", Username: "alice", Password: "password3", Notes: "note2"}
+
+	conflict := &vault.Conflict{
+		ID:       id,
+		Base:     testSecretItem(t, base, 1),
+		Current:  testSecretItem(t, current, 2),
+		Incoming: testSecretItem(t, incoming, 2),
+	}
+	items, err := secrets.Merge(conflict)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+
+	var out secrets.Secret
+	err = json.Unmarshal(items[0].Data, &out)
+	require.NoError(t, err)
+	require.Equal(t, "Keys", out.Name)
+	require.Equal(t, "alice2", out.Username)
+	require.Equal(t, "password3", out.Password)
+	require.Equal(t, "note2\n\nnote1", out.Notes)
+
+	// Deleted
+	conflict.Incoming.Data = nil
+	items, err = secrets.Merge(conflict)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+	require.Equal(t, conflict.Current.Data, items[0].Data)
+
+	// Not a secret
+	conflict = &vault.Conflict{
+		ID:       id,
+		Current:  vault.NewItem(id, []byte("value1"), "", time.Time{}),
+		Incoming: vault.NewItem(id, []byte("value2"), "", time.Time{}),
+	}
+	items, err = secrets.Merge(conflict)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+	require.Equal(t, []byte("value2"), items[0].Data)
+}
+
+func TestKeepBoth(t *testing.T) {
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn()
+
+	id := "Ibgoe3sXvdpxFUeR1hSUriTRdxvcoWjou80WnPiFcPC"
+	current := &secrets.Secret{ID: id, Type: secrets.PasswordType, Name: "keys.pub", Username: "alice", Password: "password1"}
+	incoming := &secrets.Secret{ID: id, Type: secrets.PasswordType, Name: "keys.pub", Username: "alice", Password: "password2"}
+
+	conflict := &vault.Conflict{
+		ID:       id,
+		Current:  testSecretItem(t, current, 1),
+		Incoming: testSecretItem(t, incoming, 1),
+	}
+	items, err := secrets.KeepBoth(conflict)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+	for _, item := range items {
+		err = vlt.Set(item)
+		require.NoError(t, err)
+	}
+	copyID := items[1].ID
+	require.NotEqual(t, id, copyID)
+
+	out, err := secrets.List(vlt)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(out))
+	byID := map[string]*secrets.Secret{}
+	for _, secret := range out {
+		byID[secret.ID] = secret
+	}
+	require.Equal(t, "password2", byID[id].Password)
+	require.Equal(t, "password1", byID[copyID].Password)
+
+	secret, err := secrets.Get(vlt, id)
+	require.NoError(t, err)
+	require.Equal(t, "password2", secret.Password)
+	secret, err = secrets.Get(vlt, copyID)
+	require.NoError(t, err)
+	require.NotNil(t, secret)
+	require.Equal(t, copyID, secret.ID)
+	require.Equal(t, "password1", secret.Password)
+
+	// Edit and remove the copy
+	secret.Password = "password3"
+	_, updated, err := secrets.Save(vlt, secret)
+	require.NoError(t, err)
+	require.True(t, updated)
+	ok, err := vlt.Delete(copyID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	out, err = secrets.List(vlt)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(out))
+	require.Equal(t, id, out[0].ID)
+}
@@ -0,0 +1,287 @@
+package secrets
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/pkg/errors"
+)
+
+// OTPKind is the kind of one time password.
+type OTPKind string
+
+// OTP kinds.
+const (
+	// TOTP is a time-based one time password (RFC 6238).
+	TOTP OTPKind = "totp"
+	// HOTP is a counter-based one time password (RFC 4226).
+	HOTP OTPKind = "hotp"
+)
+
+// OTP is a one time password generator, from an otpauth:// URI.
+//
+// See https://github.com/google/google-authenticator/wiki/Key-Uri-Format.
+type OTP struct {
+	Kind    OTPKind
+	Issuer  string
+	Account string
+	Secret  []byte
+	// Algorithm is SHA1 (default), SHA256 or SHA512.
+	Algorithm string
+	// Digits is 6 (default), 7 or 8.
+	Digits int
+	// Period in seconds for TOTP, defaults to 30.
+	Period int
+	// Counter for HOTP.
+	Counter int64
+}
+
+// ParseOTP parses an otpauth:// URI.
+func ParseOTP(s string) (*OTP, error) {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid otp uri")
+	}
+	if u.Scheme != "otpauth" {
+		return nil, errors.Errorf("invalid otp uri, scheme should be otpauth")
+	}
+	otp := &OTP{
+		Kind:      OTPKind(strings.ToLower(u.Host)),
+		Algorithm: "SHA1",
+		Digits:    6,
+		Period:    30,
+	}
+	switch otp.Kind {
+	case TOTP, HOTP:
+	default:
+		return nil, errors.Errorf("invalid otp type %q", u.Host)
+	}
+
+	label := strings.TrimPrefix(u.Path, "/")
+	if i := strings.Index(label, ":"); i >= 0 {
+		otp.Issuer = strings.TrimSpace(label[:i])
+		otp.Account = strings.TrimSpace(label[i+1:])
+	} else {
+		otp.Account = strings.TrimSpace(label)
+	}
+
+	q := u.Query()
+	secret, err := decodeOTPSecret(q.Get("secret"))
+	if err != nil {
+		return nil, err
+	}
+	otp.Secret = secret
+	if issuer := q.Get("issuer"); issuer != "" {
+		otp.Issuer = issuer
+	}
+	if alg := q.Get("algorithm"); alg != "" {
+		otp.Algorithm = strings.ToUpper(alg)
+		if _, err := otpHash(otp.Algorithm); err != nil {
+			return nil, err
+		}
+	}
+	if digits := q.Get("digits"); digits != "" {
+		n, err := strconv.Atoi(digits)
+		if err != nil || n < 6 || n > 8 {
+			return nil, errors.Errorf("invalid otp digits %q", digits)
+		}
+		otp.Digits = n
+	}
+	if period := q.Get("period"); period != "" {
+		n, err := strconv.Atoi(period)
+		if err != nil || n <= 0 {
+			return nil, errors.Errorf("invalid otp period %q", period)
+		}
+		otp.Period = n
+	}
+	if counter := q.Get("counter"); counter != "" {
+		n, err := strconv.ParseInt(counter, 10, 64)
+		if err != nil || n < 0 {
+			return nil, errors.Errorf("invalid otp counter %q", counter)
+		}
+		otp.Counter = n
+	}
+	return otp, nil
+}
+
+// URI returns the otpauth:// URI.
+func (o *OTP) URI() string {
+	label := o.Account
+	if o.Issuer != "" {
+		label = o.Issuer + ":" + o.Account
+	}
+	q := url.Values{}
+	q.Set("secret", base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(o.Secret))
+	if o.Issuer != "" {
+		q.Set("issuer", o.Issuer)
+	}
+	if o.Algorithm != "" && o.Algorithm != "SHA1" {
+		q.Set("algorithm", o.Algorithm)
+	}
+	if o.Digits != 0 && o.Digits != 6 {
+		q.Set("digits", strconv.Itoa(o.Digits))
+	}
+	switch o.Kind {
+	case TOTP:
+		if o.Period != 0 && o.Period != 30 {
+			q.Set("period", strconv.Itoa(o.Period))
+		}
+	case HOTP:
+		q.Set("counter", strconv.FormatInt(o.Counter, 10))
+	}
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     string(o.Kind),
+		Path:     "/" + label,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
+
+// TOTPCode returns the TOTP code at time and how long the code is valid for.
+func (o *OTP) TOTPCode(t time.Time) (string, time.Duration, error) {
+	period := int64(o.Period)
+	if period <= 0 {
+		period = 30
+	}
+	ts := t.Unix()
+	code, err := o.HOTPCode(ts / period)
+	if err != nil {
+		return "", 0, err
+	}
+	remaining := time.Duration(period-ts%period) * time.Second
+	return code, remaining, nil
+}
+
+// HOTPCode returns the HOTP code for counter.
+func (o *OTP) HOTPCode(counter int64) (string, error) {
+	hf, err := otpHash(o.Algorithm)
+	if err != nil {
+		return "", err
+	}
+	digits := o.Digits
+	if digits == 0 {
+		digits = 6
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(counter))
+	mac := hmac.New(hf, o.Secret)
+	_, _ = mac.Write(b[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod), nil
+}
+
+// OTPCode is a generated one time password.
+type OTPCode struct {
+	Code string
+	Kind OTPKind
+	// Remaining is how long a TOTP code is valid for.
+	Remaining time.Duration
+	// Counter used for a HOTP code.
+	Counter int64
+}
+
+// GenerateOTP generates a code for a secret with an OTP.
+// For HOTP, the counter is incremented and saved to the vault.
+func GenerateOTP(v *vault.Vault, id string) (*OTPCode, error) {
+	secret, err := Get(v, id)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, keys.NewErrNotFound(id)
+	}
+	if secret.OTP == "" {
+		return nil, errors.Errorf("secret has no otp")
+	}
+	otp, err := ParseOTP(secret.OTP)
+	if err != nil {
+		return nil, err
+	}
+	switch otp.Kind {
+	case TOTP:
+		code, remaining, err := otp.TOTPCode(v.Now())
+		if err != nil {
+			return nil, err
+		}
+		return &OTPCode{Code: code, Kind: TOTP, Remaining: remaining}, nil
+	case HOTP:
+		counter := otp.Counter
+		code, err := otp.HOTPCode(counter)
+		if err != nil {
+			return nil, err
+		}
+		// Save the counter before returning the code, so a code is never
+		// reused.
+		otp.Counter++
+		secret.OTP = otp.URI()
+		if _, _, err := Save(v, secret); err != nil {
+			return nil, err
+		}
+		return &OTPCode{Code: code, Kind: HOTP, Counter: counter}, nil
+	default:
+		return nil, errors.Errorf("invalid otp type %q", otp.Kind)
+	}
+}
+
+func decodeOTPSecret(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), " ", ""))
+	s = strings.TrimRight(s, "=")
+	if s == "" {
+		return nil, errors.Errorf("no otp secret")
+	}
+	b, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s)
+	if err != nil {
+		return nil, errors.Errorf("invalid otp secret")
+	}
+	return b, nil
+}
+
+func otpHash(alg string) (func() hash.Hash, error) {
+	switch alg {
+	case "", "SHA1":
+		return sha1.New, nil
+	case "SHA256":
+		return sha256.New, nil
+	case "SHA512":
+		return sha512.New, nil
+	default:
+		return nil, errors.Errorf("invalid otp algorithm %q", alg)
+	}
+}
+
+// mergeOTP merges OTP URIs, keeping the highest HOTP counter (so a code isn't
+// reused after a counter was incremented on both sides).
+func mergeOTP(base string, current string, incoming string) string {
+	merged := mergeField(base, current, incoming)
+	c, err := ParseOTP(current)
+	if err != nil || c.Kind != HOTP {
+		return merged
+	}
+	i, err := ParseOTP(incoming)
+	if err != nil || i.Kind != HOTP || string(c.Secret) != string(i.Secret) {
+		return merged
+	}
+	if c.Counter > i.Counter {
+		i.Counter = c.Counter
+	}
+	return i.URI()
+}
@@ -0,0 +1,139 @@
+package secrets_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+// Secret "12345678901234567890" from RFC 4226 and RFC 6238.
+const testOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestOTPCodes(t *testing.T) {
+	// RFC 4226 (Appendix D)
+	otp, err := secrets.ParseOTP("otpauth://hotp/Test:alice?secret=" + testOTPSecret)
+	require.NoError(t, err)
+	require.Equal(t, secrets.HOTP, otp.Kind)
+	require.Equal(t, "Test", otp.Issuer)
+	require.Equal(t, "alice", otp.Account)
+	expected := []string{"755224", "287082", "359152", "969429", "338314"}
+	for i, code := range expected {
+		out, err := otp.HOTPCode(int64(i))
+		require.NoError(t, err)
+		require.Equal(t, code, out)
+	}
+
+	// RFC 6238 (Appendix B)
+	otp, err = secrets.ParseOTP("otpauth://totp/alice?secret=" + testOTPSecret + "&digits=8")
+	require.NoError(t, err)
+	require.Equal(t, secrets.TOTP, otp.Kind)
+	code, remaining, err := otp.TOTPCode(time.Unix(59, 0))
+	require.NoError(t, err)
+	require.Equal(t, "94287082", code)
+	require.Equal(t, time.Second, remaining)
+	code, remaining, err = otp.TOTPCode(time.Unix(1111111109, 0))
+	require.NoError(t, err)
+	require.Equal(t, "07081804", code)
+	require.Equal(t, time.Second, remaining)
+	code, _, err = otp.TOTPCode(time.Unix(20000000000, 0))
+	require.NoError(t, err)
+	require.Equal(t, "65353130", code)
+
+	otp, err = secrets.ParseOTP("otpauth://totp/alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQGEZA&digits=8&algorithm=SHA256")
+	require.NoError(t, err)
+	code, _, err = otp.TOTPCode(time.Unix(59, 0))
+	require.NoError(t, err)
+	require.Equal(t, "46119246", code)
+}
+
+func TestParseOTP(t *testing.T) {
+	otp, err := secrets.ParseOTP("otpauth://totp/ACME%20Co:john.doe@email.com?secret=HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ&issuer=ACME%20Co&algorithm=SHA1&digits=6&period=30")
+	require.NoError(t, err)
+	require.Equal(t, "ACME Co", otp.Issuer)
+	require.Equal(t, "john.doe@email.com", otp.Account)
+	require.Equal(t, 6, otp.Digits)
+	require.Equal(t, 30, otp.Period)
+	require.Equal(t, "otpauth://totp/ACME%20Co:john.doe@email.com?issuer=ACME+Co&secret=HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ", otp.URI())
+
+	_, err = secrets.ParseOTP("https://keys.pub")
+	require.EqualError(t, err, "invalid otp uri, scheme should be otpauth")
+	_, err = secrets.ParseOTP("otpauth://motp/alice?secret=" + testOTPSecret)
+	require.EqualError(t, err, `invalid otp type "motp"`)
+	_, err = secrets.ParseOTP("otpauth://totp/alice")
+	require.EqualError(t, err, "no otp secret")
+	_, err = secrets.ParseOTP("otpauth://totp/alice?secret=1111")
+	require.EqualError(t, err, "invalid otp secret")
+	_, err = secrets.ParseOTP("otpauth://totp/alice?secret=" + testOTPSecret + "&digits=10")
+	require.EqualError(t, err, `invalid otp digits "10"`)
+	_, err = secrets.ParseOTP("otpauth://totp/alice?secret=" + testOTPSecret + "&algorithm=MD5")
+	require.EqualError(t, err, `invalid otp algorithm "MD5"`)
+}
+
+func TestGenerateOTP(t *testing.T) {
+	clock := tsutil.NewTestClock()
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+
+	totp, err := secrets.NewOTP("TOTP", "otpauth://totp/alice?secret="+testOTPSecret)
+	require.NoError(t, err)
+	_, _, err = secrets.Save(vlt, totp)
+	require.NoError(t, err)
+	code, err := secrets.GenerateOTP(vlt, totp.ID)
+	require.NoError(t, err)
+	require.Equal(t, secrets.TOTP, code.Kind)
+	require.Equal(t, 6, len(code.Code))
+	require.True(t, code.Remaining > 0 && code.Remaining <= 30*time.Second)
+
+	hotp, err := secrets.NewOTP("HOTP", "otpauth://hotp/alice?secret="+testOTPSecret+"&counter=1")
+	require.NoError(t, err)
+	_, _, err = secrets.Save(vlt, hotp)
+	require.NoError(t, err)
+	code, err = secrets.GenerateOTP(vlt, hotp.ID)
+	require.NoError(t, err)
+	require.Equal(t, "287082", code.Code)
+	require.Equal(t, int64(1), code.Counter)
+	code, err = secrets.GenerateOTP(vlt, hotp.ID)
+	require.NoError(t, err)
+	require.Equal(t, "359152", code.Code)
+	require.Equal(t, int64(2), code.Counter)
+
+	out, err := secrets.Get(vlt, hotp.ID)
+	require.NoError(t, err)
+	require.Equal(t, "otpauth://hotp/alice?counter=3&secret="+testOTPSecret, out.OTP)
+
+	password := secrets.NewPassword("keys.pub", "alice", "mypassword", "")
+	_, _, err = secrets.Save(vlt, password)
+	require.NoError(t, err)
+	_, err = secrets.GenerateOTP(vlt, password.ID)
+	require.EqualError(t, err, "secret has no otp")
+	_, err = secrets.GenerateOTP(vlt, "notfound")
+	require.EqualError(t, err, "notfound not found")
+}
+
+func TestMergeHOTPCounter(t *testing.T) {
+	id := "Ibgoe3sXvdpxFUeR1hSUriTRdxvcoWjou80WnPiFcPC"
+	uri := func(counter string) string {
+		return "otpauth://hotp/alice?counter=" + counter + "&secret=" + testOTPSecret
+	}
+	base := &secrets.Secret{ID: id, Type: secrets.OTPType, Name: "HOTP", OTP: uri("1")}
+	current := &secrets.Secret{ID: id, Type: secrets.OTPType, Name: "HOTP", OTP: uri("5")}
+	incoming := &secrets.Secret{ID: id, Type: secrets.OTPType, Name: "HOTP", OTP: uri("3")}
+
+	items, err := secrets.Merge(&vault.Conflict{
+		ID:       id,
+		Base:     testSecretItem(t, base, 1),
+		Current:  testSecretItem(t, current, 2),
+		Incoming: testSecretItem(t, incoming, 2),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+	var out secrets.Secret
+	err = json.Unmarshal(items[0].Data, &out)
+	require.NoError(t, err)
+	require.Equal(t, uri("5"), out.OTP)
+}
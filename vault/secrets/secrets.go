@@ -25,6 +25,19 @@ type Secret struct {
 	URL   string `json:"url,omitempty"`
 	Notes string `json:"notes,omitempty"`
 
+	// OTP is an otpauth:// URI (for TOTP or HOTP).
+	OTP string `json:"otp,omitempty"`
+
+	// Fields are custom fields.
+	Fields []*Field `json:"fields,omitempty"`
+	// Tags (lowercase, sorted).
+	Tags []string `json:"tags,omitempty"`
+	// Folder path, for example, "Work/Servers".
+	Folder string `json:"folder,omitempty"`
+
+	// ExpireAt (optional) is when the secret expires, or should be rotated by.
+	ExpireAt time.Time `json:"expireAt,omitempty"`
+
 	CreatedAt time.Time `json:"createdAt,omitempty"`
 	UpdatedAt time.Time `json:"updatedAt,omitempty"`
 }
@@ -37,6 +50,7 @@ const (
 	UnknownType  Type = ""
 	PasswordType Type = "password"
 	NoteType     Type = "note"
+	OTPType      Type = "otp"
 )
 
 func newSecretID() string {
@@ -62,6 +76,19 @@ func NewPassword(name string, username string, password string, url string) *Sec
 	return secret
 }
 
+// NewOTP creates a new one time password secret from an otpauth:// URI.
+func NewOTP(name string, uri string) (*Secret, error) {
+	otp, err := ParseOTP(uri)
+	if err != nil {
+		return nil, err
+	}
+	secret := newSecret()
+	secret.Type = OTPType
+	secret.Name = name
+	secret.OTP = otp.URI()
+	return secret, nil
+}
+
 // Save a secret.
 // Returns true if secret was updated.
 func Save(v *vault.Vault, secret *Secret) (*Secret, bool, error) {
@@ -72,6 +99,9 @@ func Save(v *vault.Vault, secret *Secret) (*Secret, bool, error) {
 	if secret.ID == "" {
 		secret.ID = newSecretID()
 	}
+	if err := secret.normalize(); err != nil {
+		return nil, false, err
+	}
 
 	item, err := v.Get(secret.ID)
 	if err != nil {
@@ -82,6 +112,7 @@ func Save(v *vault.Vault, secret *Secret) (*Secret, bool, error) {
 	if item != nil {
 		secret.UpdatedAt = v.Now()
 		item.Data = marshalSecret(secret)
+		item.ExpireAt = secret.ExpireAt
 		if err := v.Set(item); err != nil {
 			return nil, false, err
 		}
@@ -130,6 +161,20 @@ type Options struct {
 	Query         string
 	Sort          string
 	SortDirection SortDirection
+	// Types to include (or all if empty).
+	Types []Type
+	// Name to match (case-insensitive substring).
+	Name string
+	// Tags to match (all).
+	Tags []string
+	// Folder to match (including sub folders).
+	Folder string
+	// SkipExpired to skip expired secrets.
+	SkipExpired bool
+	// Expiring to only include secrets that are expired or expire within
+	// ExpiringWithin.
+	Expiring       bool
+	ExpiringWithin time.Duration
 }
 
 // Option ...
@@ -144,13 +189,20 @@ func newSecretsOptions(opts ...Option) Options {
 }
 
 // List ...
+// If the vault indexes secrets (see IndexOption), the search index is used to
+// find the secrets that might match the query, instead of checking every
+// secret.
 func List(v *vault.Vault, opt ...Option) ([]*Secret, error) {
 	opts := newSecretsOptions(opt...)
-	items, err := v.Items()
+	query, tags, folder := parseQuery(opts.Query)
+	tags = append(tags, opts.Tags...)
+	if opts.Folder != "" {
+		folder = opts.Folder
+	}
+	items, err := listItems(v, query, opts)
 	if err != nil {
 		return nil, err
 	}
-	query := strings.TrimSpace(opts.Query)
 	out := make([]*Secret, 0, len(items))
 	for _, item := range items {
 		if item.Type != secretItemType {
@@ -160,12 +212,13 @@ func List(v *vault.Vault, opt ...Option) ([]*Secret, error) {
 		if err != nil {
 			return nil, err
 		}
-
-		if query == "" ||
-			strings.Contains(secret.Name, query) ||
-			strings.Contains(secret.Username, query) ||
-			strings.Contains(secret.URL, query) ||
-			strings.Contains(secret.Notes, query) {
+		if !matchTypes(secret, opts.Types) ||
+			!matchName(secret, opts.Name) ||
+			!matchTags(secret, tags) ||
+			!secret.InFolder(folder) {
+			continue
+		}
+		if matchQuery(secret, query) {
 			out = append(out, secret)
 		}
 	}
@@ -190,6 +243,29 @@ func List(v *vault.Vault, opt ...Option) ([]*Secret, error) {
 	return out, nil
 }
 
+// listItems returns the items to list.
+// For a query, if indexed, these are the items with terms containing the query
+// terms. This includes every item matchQuery can match (a field containing the
+// query contains its terms), so the index only narrows the items to check.
+func listItems(v *vault.Vault, query string, opts Options) ([]*vault.Item, error) {
+	itemsOpts := []vault.ItemsOption{}
+	if opts.SkipExpired {
+		itemsOpts = append(itemsOpts, vault.SkipExpired())
+	}
+	if opts.Expiring {
+		itemsOpts = append(itemsOpts, vault.Expiring(opts.ExpiringWithin))
+	}
+	// A query without terms (only punctuation) can't use the index.
+	if len(vault.IndexTokens(query)) == 0 || !v.Indexes(secretItemType) {
+		return v.Items(itemsOpts...)
+	}
+	items, err := v.Search(query, vault.SearchTypes(secretItemType), vault.SearchContains())
+	if err != nil {
+		return nil, err
+	}
+	return vault.FilterItems(items, v.Now(), itemsOpts...), nil
+}
+
 // WithQuery ...
 func WithQuery(q string) Option {
 	return func(o *Options) { o.Query = q }
@@ -205,6 +281,66 @@ func WithSortDirection(d SortDirection) Option {
 	return func(o *Options) { o.SortDirection = d }
 }
 
+// WithTypes filters by types.
+func WithTypes(types ...Type) Option {
+	return func(o *Options) { o.Types = types }
+}
+
+// WithTags filters by tags (secrets must have all tags).
+// Tags can also be specified in the query, for example, "tag:work".
+func WithTags(tags ...string) Option {
+	return func(o *Options) { o.Tags = tags }
+}
+
+// WithFolder filters by folder (including sub folders).
+// The folder can also be specified in the query, for example, "folder:Work".
+func WithFolder(folder string) Option {
+	return func(o *Options) { o.Folder = folder }
+}
+
+// WithSkipExpired skips expired secrets.
+func WithSkipExpired() Option {
+	return func(o *Options) { o.SkipExpired = true }
+}
+
+// WithExpiring only includes secrets that are expired or expire within dt.
+func WithExpiring(dt time.Duration) Option {
+	return func(o *Options) {
+		o.Expiring = true
+		o.ExpiringWithin = dt
+	}
+}
+
+// IsExpired returns true if secret has an expiry before or at t.
+func (s *Secret) IsExpired(t time.Time) bool {
+	return !s.ExpireAt.IsZero() && !s.ExpireAt.After(t)
+}
+
+// WithName filters by name (case-insensitive substring).
+func WithName(name string) Option {
+	return func(o *Options) { o.Name = name }
+}
+
+func matchTypes(secret *Secret, types []Type) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, typ := range types {
+		if secret.Type == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func matchName(secret *Secret, name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(secret.Name), strings.ToLower(name))
+}
+
 func secretsSort(secrets []*Secret, sortField string, sortDirection SortDirection, i, j int) bool {
 	switch sortField {
 	case "id":
@@ -233,6 +369,16 @@ func secretsSort(secrets []*Secret, sortField string, sortDirection SortDirectio
 	}
 }
 
+// IsSecret returns true if vault item is a secret.
+func IsSecret(item *vault.Item) bool {
+	return item.Type == secretItemType
+}
+
+// FromItem returns Secret for vault item.
+func FromItem(item *vault.Item) (*Secret, error) {
+	return asSecret(item)
+}
+
 // asSecret returns Secret for Item.
 func asSecret(item *vault.Item) (*Secret, error) {
 	if item.Type != secretItemType {
@@ -254,7 +400,9 @@ func newItemForSecret(secret *Secret) (*vault.Item, error) {
 		return nil, errors.Errorf("no secret id")
 	}
 	b := marshalSecret(secret)
-	return vault.NewItem(secret.ID, b, secretItemType, secret.CreatedAt), nil
+	item := vault.NewItem(secret.ID, b, secretItemType, secret.CreatedAt)
+	item.ExpireAt = secret.ExpireAt
+	return item, nil
 }
 
 func marshalSecret(secret *Secret) []byte {
@@ -2,8 +2,10 @@ package secrets_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/keys-pub/keys-ext/vault/secrets"
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,3 +83,75 @@ func TestSecrets(t *testing.T) {
 	require.Equal(t, charlie.ID, out[2].ID)
 	require.Equal(t, gabriel.ID, out[3].ID)
 }
+
+func TestSecretsExpiring(t *testing.T) {
+	var err error
+	clock := tsutil.NewTestClock()
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+
+	now := clock.Now()
+	noexpire := secrets.NewPassword("noexpire", "alice", "alicepassword", "")
+	_, _, err = secrets.Save(vlt, noexpire)
+	require.NoError(t, err)
+	expired := secrets.NewPassword("expired", "alice", "alicepassword", "")
+	expired.ExpireAt = now.Add(-time.Hour)
+	_, _, err = secrets.Save(vlt, expired)
+	require.NoError(t, err)
+	expiring := secrets.NewPassword("expiring", "alice", "alicepassword", "")
+	expiring.ExpireAt = now.Add(time.Hour * 24)
+	_, _, err = secrets.Save(vlt, expiring)
+	require.NoError(t, err)
+
+	out, err := secrets.List(vlt, secrets.WithSkipExpired())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(out))
+	require.Equal(t, "expiring", out[0].Name)
+	require.Equal(t, "noexpire", out[1].Name)
+
+	out, err = secrets.List(vlt, secrets.WithExpiring(time.Hour*24*7))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(out))
+	require.Equal(t, "expired", out[0].Name)
+	require.True(t, out[0].IsExpired(clock.Now()))
+	require.Equal(t, "expiring", out[1].Name)
+	require.False(t, out[1].IsExpired(clock.Now()))
+
+	// Remove expiry
+	expired.ExpireAt = time.Time{}
+	_, _, err = secrets.Save(vlt, expired)
+	require.NoError(t, err)
+	out, err = secrets.List(vlt, secrets.WithExpiring(0))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(out))
+}
+
+func TestSecretHistory(t *testing.T) {
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn()
+
+	secret := secrets.NewPassword("keys.pub", "alice", "password1", "")
+	_, _, err := secrets.Save(vlt, secret)
+	require.NoError(t, err)
+	secret.Password = "password2"
+	_, _, err = secrets.Save(vlt, secret)
+	require.NoError(t, err)
+	_, err = vlt.Delete(secret.ID)
+	require.NoError(t, err)
+
+	history, err := secrets.History(vlt, secret.ID)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(history))
+	require.Equal(t, int64(1), history[0].Version)
+	require.Equal(t, "password1", history[0].Secret.Password)
+	require.Equal(t, "password2", history[1].Secret.Password)
+	require.Nil(t, history[2].Secret)
+
+	out, err := secrets.Restore(vlt, secret.ID, 1)
+	require.NoError(t, err)
+	require.Equal(t, "password1", out.Password)
+
+	out, err = secrets.Get(vlt, secret.ID)
+	require.NoError(t, err)
+	require.Equal(t, "password1", out.Password)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/secrets"
 	"github.com/keys-pub/keys/encoding"
 	"github.com/keys-pub/keys/tsutil"
 	"github.com/stretchr/testify/require"
@@ -58,7 +59,7 @@ func NewTestVault(t *testing.T, opts *TestVaultOptions) (*vault.Vault, func()) {
 		st, closeFn = newTestDB(t)
 	}
 
-	vlt := vault.New(st, vault.WithClock(opts.Clock))
+	vlt := vault.New(st, vault.WithClock(opts.Clock), secrets.IndexOption())
 
 	if opts.Unlock {
 		key, provision := NewTestVaultKey(t, opts.Clock)
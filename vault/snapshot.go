@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"context"
+	"sort"
+
+	httpclient "github.com/keys-pub/keys-ext/http/client"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+// Compact saves a snapshot of the vault to the remote and compacts the remote,
+// dropping events superseded by the snapshot.
+// Clients cloning (or pulling from before the snapshot) get the snapshot
+// instead of the full event log, so they won't see the history before it.
+//
+// The snapshot is the last pulled event for each path, so we pull first.
+// Does NOT require Unlock.
+func (v *Vault) Compact(ctx context.Context) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	logger.Infof("Compacting...")
+
+	if v.remote == nil {
+		return errors.Errorf("no remote set")
+	}
+	client, err := v.remoteClient(v.remote)
+	if err != nil {
+		return err
+	}
+
+	if err := v.pull(ctx); err != nil {
+		return errors.Wrapf(err, "failed to pull vault")
+	}
+
+	snapshot, err := v.snapshot()
+	if err != nil {
+		return err
+	}
+	if snapshot.Index == 0 {
+		return errors.Errorf("nothing to compact")
+	}
+
+	logger.Infof("Saving snapshot (%d)", snapshot.Index)
+	if err := client.VaultSnapshotSave(ctx, v.remote.Key, snapshot); err != nil {
+		return err
+	}
+	if err := client.VaultCompact(ctx, v.remote.Key); err != nil {
+		return err
+	}
+	return nil
+}
+
+// snapshot returns the last pulled event for each path, at the pull index.
+func (v *Vault) snapshot() (*httpclient.VaultSnapshot, error) {
+	index, err := v.pullIndex()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := v.store.List(&ListOptions{Prefix: dstore.Path("pull")})
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{}
+	last := map[string]*httpclient.VaultEvent{}
+	for _, entry := range entries {
+		pc := dstore.PathComponents(entry.Path)
+		remoteIndex, err := unpad(pc[1])
+		if err != nil {
+			return nil, err
+		}
+		var event httpclient.VaultEvent
+		if err := msgpack.Unmarshal(entry.Data, &event); err != nil {
+			return nil, err
+		}
+		event.RemoteIndex = remoteIndex
+		if _, ok := last[event.Path]; !ok {
+			paths = append(paths, event.Path)
+		}
+		last[event.Path] = &event
+	}
+
+	events := make([]*httpclient.VaultEvent, 0, len(paths))
+	for _, path := range paths {
+		events = append(events, last[path])
+	}
+	// Order by remote index, so the snapshot is applied in the same order.
+	sortEventsByIndex(events)
+
+	return &httpclient.VaultSnapshot{
+		Events: events,
+		Index:  index,
+	}, nil
+}
+
+func sortEventsByIndex(events []*httpclient.VaultEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].RemoteIndex < events[j].RemoteIndex
+	})
+}
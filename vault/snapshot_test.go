@@ -0,0 +1,108 @@
+package vault_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompact(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+	key, provision := NewTestVaultKey(t, clock)
+
+	st1, closeFn1 := newTestMem(t)
+	defer closeFn1()
+	v1 := vault.New(st1)
+	v1.SetClient(testClient(t, env))
+	err = v1.Setup(key, provision)
+	require.NoError(t, err)
+	_, err = v1.Unlock(key)
+	require.NoError(t, err)
+
+	err = v1.Compact(ctx)
+	require.EqualError(t, err, "failed to pull vault: vault not found")
+
+	err = v1.Set(vault.NewItem("key1", []byte("mysecretdata.1a"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	// Client #3 (before compaction)
+	st3, closeFn3 := newTestMem(t)
+	defer closeFn3()
+	v3 := vault.New(st3)
+	v3.SetClient(testClient(t, env))
+	err = v3.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+	_, err = v3.Unlock(key)
+	require.NoError(t, err)
+
+	err = v1.Set(vault.NewItem("key1", []byte("mysecretdata.1b"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Set(vault.NewItem("key2", []byte("mysecretdata.2"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	err = v1.Compact(ctx)
+	require.NoError(t, err)
+
+	err = v1.Set(vault.NewItem("key3", []byte("mysecretdata.3"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	// Client #2 (clone from snapshot)
+	st2, closeFn2 := newTestMem(t)
+	defer closeFn2()
+	v2 := vault.New(st2)
+	v2.SetClient(testClient(t, env))
+	err = v2.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+	_, err = v2.Unlock(key)
+	require.NoError(t, err)
+
+	paths, err := vaultPaths(v2, dstore.Path("pull"))
+	require.NoError(t, err)
+	expected := []string{
+		"/pull/000000000000001/auth/ySymDh5DDuJo21ydVJdyuxcDTgYUJMin4PZQzSUBums",
+		"/pull/000000000000002/provision/ySymDh5DDuJo21ydVJdyuxcDTgYUJMin4PZQzSUBums",
+		"/pull/000000000000004/item/key1",
+		"/pull/000000000000005/item/key2",
+		"/pull/000000000000006/item/key3",
+	}
+	require.Equal(t, expected, paths)
+
+	// Client #3 pulls from before compaction (gets snapshot)
+	err = v3.Sync(ctx)
+	require.NoError(t, err)
+
+	for _, v := range []*vault.Vault{v2, v3} {
+		items, err := v.Items()
+		require.NoError(t, err)
+		require.Equal(t, 3, len(items))
+		out, err := v.Get("key1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("mysecretdata.1b"), out.Data)
+	}
+
+	// Changes after compaction
+	err = v2.Set(vault.NewItem("key2", []byte("mysecretdata.2b"), "", time.Now()))
+	require.NoError(t, err)
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	out, err := v1.Get("key2")
+	require.NoError(t, err)
+	require.Equal(t, []byte("mysecretdata.2b"), out.Data)
+}
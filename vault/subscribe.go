@@ -1,6 +1,9 @@
 package vault
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 // Event from vault.
 type Event interface{}
@@ -18,6 +21,44 @@ type SetEvent struct {
 	ID string
 }
 
+// DeleteEvent when item is deleted.
+type DeleteEvent struct {
+	ID string
+}
+
+// SyncStartEvent when sync starts.
+type SyncStartEvent struct{}
+
+// SyncFinishEvent when sync finishes.
+// If sync failed, Err is set.
+type SyncFinishEvent struct {
+	Err error
+}
+
+// PullEvent when an item change is pulled from the remote.
+type PullEvent struct {
+	ID      string
+	Deleted bool
+}
+
+// PullProgressEvent after each page of events is pulled from the remote.
+type PullProgressEvent struct {
+	// Events pulled (so far).
+	Events int
+	// Bytes pulled (so far).
+	Bytes int64
+	// Index is the remote index pulled to.
+	Index int64
+	// Max is the latest remote index.
+	Max int64
+	// Remaining is the estimated time remaining, or 0 if unknown or done.
+	Remaining time.Duration
+}
+
+// subscriberBuffer is the number of events a subscriber can have queued.
+// If a subscriber isn't receiving, events are dropped (notify never blocks).
+const subscriberBuffer = 100
+
 type subscribers struct {
 	sync.Mutex
 	subs map[string]chan Event
@@ -29,23 +70,28 @@ func newSubscribers() *subscribers {
 	}
 }
 
-// TODO: Fix subscribers
-
-// // Subscribe to topic.
-// func (v *Vault) Subscribe(topic string) chan Event {
-// 	return v.subs.Subscribe(topic)
-// }
+// Subscribe to topic.
+// Events are delivered without blocking the vault, so if the channel buffer
+// is full, events are dropped.
+// If you subscribe to an existing topic, the previous channel is closed.
+func (v *Vault) Subscribe(topic string) chan Event {
+	return v.subs.Subscribe(topic)
+}
 
-// // Unsubscribe from topic.
-// func (v *Vault) Unsubscribe(topic string) {
-// 	v.subs.Unsubscribe(topic)
-// }
+// Unsubscribe from topic.
+// The channel for the topic is closed.
+func (v *Vault) Unsubscribe(topic string) {
+	v.subs.Unsubscribe(topic)
+}
 
 // Subscribe to events.
 func (s *subscribers) Subscribe(topic string) chan Event {
 	s.Lock()
 	defer s.Unlock()
-	c := make(chan Event, 2)
+	if c, ok := s.subs[topic]; ok {
+		close(c)
+	}
+	c := make(chan Event, subscriberBuffer)
 	s.subs[topic] = c
 	return c
 }
@@ -54,16 +100,21 @@ func (s *subscribers) Subscribe(topic string) chan Event {
 func (s *subscribers) Unsubscribe(topic string) {
 	s.Lock()
 	defer s.Unlock()
-
-	delete(s.subs, topic)
+	if c, ok := s.subs[topic]; ok {
+		close(c)
+		delete(s.subs, topic)
+	}
 }
 
-// func (s *subscribers) notify(event Event) {
-// 	s.Lock()
-// 	defer s.Unlock()
+func (s *subscribers) notify(event Event) {
+	s.Lock()
+	defer s.Unlock()
 
-// 	for _, c := range s.subs {
-// 		// TODO: This will block if buffer is met
-// 		c <- event
-// 	}
-// }
+	for topic, c := range s.subs {
+		select {
+		case c <- event:
+		default:
+			logger.Warningf("Subscriber %s is full, dropping event %T", topic, event)
+		}
+	}
+}
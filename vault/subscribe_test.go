@@ -1,44 +1,129 @@
 package vault_test
 
-// func TestSubscribe(t *testing.T) {
-// 	var err error
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
 
-// 	vlt := vault.New(vault.NewMem())
-// 	ch := vlt.Subscribe("test")
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
 
-// 	key := keys.Rand32()
-// 	id := encoding.MustEncode(bytes.Repeat([]byte{0x01}, 32), encoding.Base62)
-// 	provision := &vault.Provision{ID: id}
-// 	err = vlt.Setup(key, provision)
-// 	require.NoError(t, err)
+func TestSubscribe(t *testing.T) {
+	var err error
 
-// 	_, err = vlt.Unlock(key)
-// 	require.NoError(t, err)
+	vlt := vault.New(vault.NewMem())
+	err = vlt.Open()
+	require.NoError(t, err)
+	defer vlt.Close()
+	ch := vlt.Subscribe("test")
 
-// 	err = vlt.Lock()
-// 	require.NoError(t, err)
+	key := keys.Rand32()
+	id := encoding.MustEncode(bytes.Repeat([]byte{0x01}, 32), encoding.Base62)
+	provision := &vault.Provision{ID: id}
+	err = vlt.Setup(key, provision)
+	require.NoError(t, err)
 
-// 	event := <-ch
-// 	require.IsType(t, event, vault.UnlockEvent{})
-// 	unlock := event.(vault.UnlockEvent)
-// 	require.Equal(t, provision.ID, unlock.Provision.ID)
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
 
-// 	event = <-ch
-// 	require.IsType(t, event, vault.LockEvent{})
+	vlt.Lock()
 
-// 	_, err = vlt.Unlock(key)
-// 	require.NoError(t, err)
+	event := <-ch
+	require.IsType(t, vault.UnlockEvent{}, event)
+	unlock := event.(vault.UnlockEvent)
+	require.Equal(t, provision.ID, unlock.Provision.ID)
 
-// 	item := vault.NewItem("test", []byte("testdata"), "", time.Now())
-// 	err = vlt.Set(item)
-// 	require.NoError(t, err)
+	event = <-ch
+	require.IsType(t, vault.LockEvent{}, event)
 
-// 	event = <-ch
-// 	require.IsType(t, event, vault.UnlockEvent{})
-// 	event = <-ch
-// 	require.IsType(t, event, vault.SetEvent{})
-// 	create := event.(vault.SetEvent)
-// 	require.Equal(t, item.ID, create.ID)
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
 
-// 	vlt.Unsubscribe("test")
-// }
+	item := vault.NewItem("test", []byte("testdata"), "", time.Now())
+	err = vlt.Set(item)
+	require.NoError(t, err)
+	_, err = vlt.Delete(item.ID)
+	require.NoError(t, err)
+
+	event = <-ch
+	require.IsType(t, vault.UnlockEvent{}, event)
+	event = <-ch
+	require.IsType(t, vault.SetEvent{}, event)
+	require.Equal(t, item.ID, event.(vault.SetEvent).ID)
+	event = <-ch
+	require.IsType(t, vault.DeleteEvent{}, event)
+	require.Equal(t, item.ID, event.(vault.DeleteEvent).ID)
+
+	vlt.Unsubscribe("test")
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestSubscribeSync(t *testing.T) {
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	v1, closeFn1 := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn1()
+	v1.SetClient(testClient(t, env))
+	err = v1.Set(vault.NewItem("key1", []byte("mysecretdata.1"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	st2, closeFn2 := newTestMem(t)
+	defer closeFn2()
+	v2 := vault.New(st2)
+	v2.SetClient(testClient(t, env))
+	err = v2.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+	key, _ := NewTestVaultKey(t, clock)
+	_, err = v2.Unlock(key)
+	require.NoError(t, err)
+
+	err = v1.Set(vault.NewItem("key2", []byte("mysecretdata.2"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	ch := v2.Subscribe("test")
+	defer v2.Unsubscribe("test")
+	err = v2.Sync(ctx)
+	require.NoError(t, err)
+
+	event := <-ch
+	require.IsType(t, vault.SyncStartEvent{}, event)
+	event = <-ch
+	require.IsType(t, vault.PullEvent{}, event)
+	require.Equal(t, vault.PullEvent{ID: "key2"}, event)
+	event = <-ch
+	require.IsType(t, vault.PullProgressEvent{}, event)
+	progress := event.(vault.PullProgressEvent)
+	require.Equal(t, 1, progress.Events)
+	require.Equal(t, progress.Max, progress.Index)
+	event = <-ch
+	require.IsType(t, vault.SyncFinishEvent{}, event)
+	require.NoError(t, event.(vault.SyncFinishEvent).Err)
+}
+
+func TestSubscribeFull(t *testing.T) {
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true})
+	defer closeFn()
+
+	// Not receiving doesn't block
+	ch := vlt.Subscribe("test")
+	defer vlt.Unsubscribe("test")
+	for i := 0; i < 200; i++ {
+		err := vlt.Set(vault.NewItem("key1", []byte("mysecretdata"), "", time.Now()))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 100, len(ch))
+}
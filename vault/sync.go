@@ -2,6 +2,7 @@ package vault
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/keys-pub/keys"
@@ -15,6 +16,7 @@ import (
 type SyncStatus struct {
 	KID      keys.ID
 	Salt     []byte
+	URL      *url.URL
 	SyncedAt time.Time
 }
 
@@ -24,16 +26,42 @@ func (v *Vault) Sync(ctx context.Context) error {
 	defer v.mtx.Unlock()
 	logger.Infof("Syncing...")
 
+	v.subs.notify(SyncStartEvent{})
+	err := v.sync(ctx)
+	v.subs.notify(SyncFinishEvent{Err: err})
+	return err
+}
+
+func (v *Vault) sync(ctx context.Context) error {
+	err := v.syncPrimary(ctx)
+
+	// Additional remotes are synced even if the primary remote fails.
+	if rerr := v.syncRemotes(ctx); rerr != nil {
+		return rerr
+	}
+	if perr := v.prunePush(); perr != nil {
+		return perr
+	}
+	return err
+}
+
+func (v *Vault) syncPrimary(ctx context.Context) error {
 	// What happens on connection failures, context cancellation?
 	//
-	// If we fail during push, we could push duplicates on the next push.
-	// But the duplicates would only show up in the history.
-	// We could de-dupe on the clients, but this is probably rare enough to
-	// ignore for now (TODO: resolve possible duplicate push events).
+	// If we fail during push, we re-push the same events on the next push.
+	// Each pushed event has an idempotency ID (derived from the push entry),
+	// so the remote ignores events it has already seen. If we fail after the
+	// push is acknowledged, the pushed index tells us which push entries to
+	// remove without re-pushing.
 	//
 	// If we fail after pull, we could pull duplicates, but this is ok since
 	// the partial data would be overwritten on the next pull.
 
+	// Finish migrating the remote, if we were interrupted rotating the master key.
+	if err := v.rotateRemote(ctx); err != nil {
+		return errors.Wrapf(err, "failed to migrate remote")
+	}
+
 	if err := v.push(ctx); err != nil {
 		return errors.Wrapf(err, "failed to push vault")
 	}
@@ -67,6 +95,7 @@ func (v *Vault) SyncStatus() (*SyncStatus, error) {
 	return &SyncStatus{
 		KID:      remote.Key.ID(),
 		Salt:     remote.Salt,
+		URL:      remote.URL,
 		SyncedAt: lastSync,
 	}, nil
 }
@@ -90,8 +119,13 @@ func (v *Vault) Unsync(ctx context.Context) error {
 		return errors.Errorf("vault is locked")
 	}
 
+	client, err := v.remoteClient(v.remote)
+	if err != nil {
+		return err
+	}
+
 	// Delete vault from the server
-	if err := v.client.VaultDelete(ctx, v.remote.Key); err != nil {
+	if err := client.VaultDelete(ctx, v.remote.Key); err != nil {
 		return err
 	}
 
@@ -107,6 +141,9 @@ func (v *Vault) Unsync(ctx context.Context) error {
 	if err := v.setPullIndex(0); err != nil {
 		return err
 	}
+	if err := v.setPushedIndex(0); err != nil {
+		return err
+	}
 
 	// Clear remote
 	if err := v.clearRemote(); err != nil {
@@ -130,10 +167,33 @@ func (v *Vault) resetLog() error {
 		return nil
 	}
 
+	// Clear heads (pointers into pull)
+	heads, err := v.store.List(&ListOptions{Prefix: dstore.Path("head"), NoData: true})
+	if err != nil {
+		return err
+	}
+	for _, doc := range heads {
+		if _, err := v.store.Delete(doc.Path); err != nil {
+			return err
+		}
+	}
+
 	if err := v.setPushIndex(int64(len(pull) + len(push))); err != nil {
 		return err
 	}
 
+	// Push indexes change, so additional remotes need an initial push (of the
+	// current items) instead of pushing from their (old) pushed index.
+	names, err := v.remoteNames()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if _, err := v.store.Delete(remoteSyncPath(name, "pushed")); err != nil {
+			return err
+		}
+	}
+
 	// Move push to the end
 	index := int64(len(pull))
 	for _, doc := range push {
@@ -163,7 +223,8 @@ func (v *Vault) resetLog() error {
 		}
 	}
 
-	return nil
+	// Log paths changed, so rebuild the history index
+	return v.reindexHistory()
 }
 
 // SyncEnabled returns true if sync is enabled.
@@ -257,6 +318,15 @@ func (v *Vault) setPushIndex(n int64) error {
 	return v.setInt64("/sync/push", n)
 }
 
+// pushedIndex is the last push index acknowledged by the remote.
+func (v *Vault) pushedIndex() (int64, error) {
+	return v.getInt64("/sync/pushed")
+}
+
+func (v *Vault) setPushedIndex(n int64) error {
+	return v.setInt64("/sync/pushed", n)
+}
+
 func (v *Vault) pushIndexNext() (int64, error) {
 	n, err := v.pushIndex()
 	if err != nil {
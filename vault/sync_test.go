@@ -2,12 +2,14 @@ package vault_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/keys-pub/keys-ext/vault"
 	"github.com/keys-pub/keys/dstore"
 	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -228,16 +230,18 @@ func testSync(t *testing.T, st1 vault.Store, st2 vault.Store) {
 	paths, err = vaultPaths(v1, "/sync")
 	require.NoError(t, err)
 	expected = []string{
+		"/sync/historyIndex",
 		"/sync/lastSync",
 		"/sync/pull",
 		"/sync/push",
+		"/sync/pushed",
 		"/sync/rsalt",
 	}
 	require.Equal(t, expected, paths)
 
 	cols, err := vault.Collections(v1.Store(), "")
 	require.NoError(t, err)
-	expected = []string{"/auth", "/item", "/provision", "/pull", "/sync"}
+	expected = []string{"/audit", "/auth", "/config", "/conflict", "/head", "/history", "/item", "/provision", "/pull", "/sync"}
 	require.Equal(t, expected, cols)
 }
 
@@ -350,3 +354,111 @@ func TestUnsync(t *testing.T) {
 	require.Equal(t, "key2", items[1].ID)
 	require.Equal(t, []byte("mysecretdata.2"), items[1].Data)
 }
+
+// testFailStore fails deleting push entries (after a push is acknowledged).
+type testFailStore struct {
+	vault.Store
+	fail bool
+}
+
+func (s *testFailStore) Delete(path string) (bool, error) {
+	if s.fail && dstore.PathFirst(path) == "push" {
+		return false, errors.Errorf("failed to delete (test)")
+	}
+	return s.Store.Delete(path)
+}
+
+func TestSyncPushFailures(t *testing.T) {
+	// vault.SetLogger(vault.NewLogger(vault.DebugLevel))
+	var err error
+	env := newTestEnv(t, nil)
+	defer env.closeFn()
+
+	ctx := context.TODO()
+	clock := tsutil.NewTestClock()
+
+	mem, closeFn := newTestMem(t)
+	defer closeFn()
+	st := &testFailStore{Store: mem}
+
+	v1 := vault.New(st)
+	v1.SetClient(testClient(t, env))
+	key, provision := NewTestVaultKey(t, clock)
+	err = v1.Setup(key, provision)
+	require.NoError(t, err)
+	_, err = v1.Unlock(key)
+	require.NoError(t, err)
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+
+	// Response lost after the server added the events
+	err = v1.Set(vault.NewItem("key1", []byte("mysecretdata.1a"), "", time.Now()))
+	require.NoError(t, err)
+	err = v1.Set(vault.NewItem("key2", []byte("mysecretdata.2a"), "", time.Now()))
+	require.NoError(t, err)
+	env.handler.drop = func(r *http.Request) bool { return r.Method == "POST" }
+	err = v1.Sync(ctx)
+	require.Error(t, err)
+	env.handler.drop = nil
+
+	paths, err := vaultPaths(v1, dstore.Path("push"))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(paths))
+
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	paths, err = vaultPaths(v1, dstore.Path("push"))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(paths))
+
+	history, err := v1.ItemHistory("key1")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	require.Equal(t, []byte("mysecretdata.1a"), history[0].Data)
+
+	// Failure after push was acknowledged (removing from push)
+	err = v1.Set(vault.NewItem("key1", []byte("mysecretdata.1b"), "", time.Now()))
+	require.NoError(t, err)
+	st.fail = true
+	err = v1.Sync(ctx)
+	require.Error(t, err)
+	st.fail = false
+
+	paths, err = vaultPaths(v1, dstore.Path("push"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(paths))
+
+	err = v1.Sync(ctx)
+	require.NoError(t, err)
+	paths, err = vaultPaths(v1, dstore.Path("push"))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(paths))
+
+	history, err = v1.ItemHistory("key1")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	require.Equal(t, []byte("mysecretdata.1a"), history[0].Data)
+	require.Equal(t, []byte("mysecretdata.1b"), history[1].Data)
+
+	history, err = v1.ItemHistory("key2")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+
+	// Another client sees no duplicates
+	v2 := vault.New(vault.NewMem())
+	err = v2.Open()
+	require.NoError(t, err)
+	defer v2.Close()
+	v2.SetClient(testClient(t, env))
+	err = v2.Clone(ctx, v1.Remote())
+	require.NoError(t, err)
+	_, err = v2.Unlock(key)
+	require.NoError(t, err)
+
+	paths, err = vaultPaths(v2, dstore.Path("pull"))
+	require.NoError(t, err)
+	require.Equal(t, 5, len(paths))
+	history, err = v2.ItemHistory("key1")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+}
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"reflect"
@@ -12,6 +13,7 @@ import (
 	"github.com/keys-pub/keys"
 	httpclient "github.com/keys-pub/keys-ext/http/client"
 	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/encoding"
 	"github.com/keys-pub/keys/tsutil"
 	"github.com/pkg/errors"
 	"github.com/vmihailenco/msgpack/v4"
@@ -31,6 +33,10 @@ type Vault struct {
 	client *httpclient.Client
 	clock  tsutil.Clock
 
+	// clients for remotes with a URL.
+	clients    map[string]*httpclient.Client
+	clientsMtx sync.Mutex
+
 	mk     *[32]byte
 	remote *Remote
 
@@ -40,15 +46,34 @@ type Vault struct {
 	checkMtx  sync.Mutex
 
 	subs *subscribers
+
+	authLogMtx sync.Mutex
+
+	resolver ConflictResolver
+
+	indexers map[string]Indexer
+	indexMtx sync.Mutex
+	// indexDict is the (sorted) index terms, if loaded, see indexTerms.
+	indexDict []string
+
+	// collections are (cached) shared collection vaults, see CollectionVault.
+	collections    map[keys.ID]*Vault
+	collectionsMtx sync.Mutex
+
+	// rotateHook, if set, is called on master key rotation, see SetRotateHook.
+	rotateHook RotateHook
 }
 
 // New vault.
 func New(st Store, opt ...Option) *Vault {
 	opts := newOptions(opt...)
 	return &Vault{
-		store: st,
-		clock: opts.Clock,
-		subs:  newSubscribers(),
+		store:       st,
+		clock:       opts.Clock,
+		subs:        newSubscribers(),
+		resolver:    opts.ConflictResolver,
+		indexers:    opts.Indexers,
+		collections: map[keys.ID]*Vault{},
 	}
 }
 
@@ -76,6 +101,7 @@ func (v *Vault) Close() error {
 		v.auto.Stop()
 		v.auto = nil
 	}
+	v.closeCollections()
 	// TODO: Sync could still be running when we close, this might be
 	//       ok, since it will error and eventually stop?
 	if err := v.store.Close(); err != nil {
@@ -102,6 +128,25 @@ func (v *Vault) Reset() error {
 // SetClient sets the client.
 func (v *Vault) SetClient(client *httpclient.Client) {
 	v.client = client
+	v.collectionsMtx.Lock()
+	defer v.collectionsMtx.Unlock()
+	for _, cv := range v.collections {
+		cv.client = client
+	}
+}
+
+// RotateHook is called with the old and new master key on rotation.
+type RotateHook func(mk *[32]byte, nmk *[32]byte)
+
+// SetRotateHook sets a hook, called on master key rotation, after items are
+// re-encrypted with the new master key (before the remote is migrated), and
+// whenever an interrupted rotation is resumed on Unlock.
+// This is for (local) data encrypted with a key derived from the master key,
+// that needs to be re-keyed.
+// The hook may be called more than once for the same rotation, and must not
+// call back into the vault.
+func (v *Vault) SetRotateHook(hook RotateHook) {
+	v.rotateHook = hook
 }
 
 // setMasterKey sets the master key.
@@ -119,11 +164,12 @@ func (v *Vault) setAuthFromMasterKey(mk *[32]byte) error {
 		return err
 	}
 
-	// Derive API key
-	seed := keys.Bytes32(keys.HKDFSHA256(mk[:], 32, rsalt, []byte("keys.pub/rk")))
-	rk := keys.NewEdX25519KeyFromSeed(seed)
+	u, err := v.remoteURL()
+	if err != nil {
+		return err
+	}
 
-	remote := &Remote{Key: rk, Salt: rsalt}
+	remote := &Remote{URL: u, Key: remoteKey(mk, rsalt), Salt: rsalt}
 
 	// If auth was already set in Clone, we should double check it matches the
 	// auth generated from the master key.
@@ -137,6 +183,12 @@ func (v *Vault) setAuthFromMasterKey(mk *[32]byte) error {
 	return nil
 }
 
+// remoteKey derives the remote (API) key from the master key.
+func remoteKey(mk *[32]byte, rsalt []byte) *keys.EdX25519Key {
+	seed := keys.Bytes32(keys.HKDFSHA256(mk[:], 32, rsalt, []byte("keys.pub/rk")))
+	return keys.NewEdX25519KeyFromSeed(seed)
+}
+
 func (v *Vault) clearRemote() error {
 	v.remote = nil
 	if err := v.setRemoteSalt(nil); err != nil {
@@ -161,16 +213,32 @@ func (v *Vault) MasterKey() *[32]byte {
 
 // Set vault item.
 func (v *Vault) Set(item *Item) error {
-	return v.setItem(item, true)
+	if err := v.setItem(item, true); err != nil {
+		return err
+	}
+	v.subs.notify(SetEvent{ID: item.ID})
+	return nil
 }
 
 func (v *Vault) setItem(item *Item, addToPush bool) error {
+	if addToPush {
+		// Local change, increment version from the current item.
+		version, err := v.itemVersion(item.ID)
+		if err != nil {
+			return err
+		}
+		item.Version = version + 1
+		item.UpdatedAt = v.clock.Now()
+	}
 	b, err := encryptItem(item, v.mk)
 	if err != nil {
 		return err
 	}
 	path := dstore.Path("item", item.ID)
-	return v.set(path, b, addToPush)
+	if err := v.set(path, b, addToPush); err != nil {
+		return err
+	}
+	return v.index(item)
 }
 
 func (v *Vault) set(path string, b []byte, addToPush bool) error {
@@ -192,6 +260,9 @@ func (v *Vault) addToPush(path string, b []byte) error {
 	if err := v.store.Set(push, b); err != nil {
 		return err
 	}
+	if err := v.setHistory(push); err != nil {
+		return err
+	}
 
 	if v.auto != nil {
 		v.auto.Stop()
@@ -233,6 +304,26 @@ func (v *Vault) Get(id string) (*Item, error) {
 	return item, err
 }
 
+// itemVersion returns the version of the current item, including deleted
+// items, or 0 if not found.
+func (v *Vault) itemVersion(id string) (int64, error) {
+	if id == "" {
+		return 0, errors.Errorf("invalid id")
+	}
+	b, err := v.store.Get(dstore.Path("item", id))
+	if err != nil {
+		return 0, err
+	}
+	if b == nil {
+		return 0, nil
+	}
+	item, err := decryptItem(b, v.mk, id)
+	if err != nil {
+		return 0, err
+	}
+	return item.Version, nil
+}
+
 // Delete vault item.
 func (v *Vault) Delete(id string) (bool, error) {
 	item, err := v.Get(id)
@@ -248,12 +339,15 @@ func (v *Vault) Delete(id string) (bool, error) {
 	if err := v.setItem(item, true); err != nil {
 		return false, err
 	}
+	v.subs.notify(DeleteEvent{ID: id})
 
 	return true, nil
 }
 
 // Items to list.
-func (v *Vault) Items() ([]*Item, error) {
+func (v *Vault) Items(opt ...ItemsOption) ([]*Item, error) {
+	opts := newItemsOptions(opt...)
+	now := v.clock.Now()
 	path := dstore.Path("item")
 	ds, err := v.store.List(&ListOptions{Prefix: path})
 	if err != nil {
@@ -270,21 +364,45 @@ func (v *Vault) Items() ([]*Item, error) {
 			// TODO: Deleted item (clean it up by removing?)
 			continue
 		}
+		if !opts.match(item, now) {
+			continue
+		}
 		items = append(items, item)
 	}
 	return items, nil
 }
 
-func (v *Vault) push(ctx context.Context) error {
-	if v.client == nil {
-		return errors.Errorf("no vault client set")
+// FilterItems returns items matching ItemsOption (at time now).
+func FilterItems(items []*Item, now time.Time, opt ...ItemsOption) []*Item {
+	opts := newItemsOptions(opt...)
+	out := make([]*Item, 0, len(items))
+	for _, item := range items {
+		if opts.match(item, now) {
+			out = append(out, item)
+		}
 	}
+	return out
+}
+
+func (v *Vault) push(ctx context.Context) error {
 	if v.remote == nil {
 		return errors.Errorf("no remote set")
 	}
+	client, err := v.remoteClient(v.remote)
+	if err != nil {
+		return err
+	}
+
+	// Push entries at or below the pushed index were acknowledged by the
+	// remote. They are removed, unless an additional remote hasn't pushed
+	// them yet.
+	pushed, err := v.pushedIndex()
+	if err != nil {
+		return err
+	}
 
-	paths := []string{}
 	events := []*httpclient.VaultEvent{}
+	last := int64(0)
 
 	// Get events from push.
 	path := dstore.Path("push")
@@ -296,27 +414,73 @@ func (v *Vault) push(ctx context.Context) error {
 	// TODO: Enforce event chaining yet.
 
 	for _, doc := range ds {
+		index, err := unpad(dstore.PathComponents(doc.Path)[1])
+		if err != nil {
+			return err
+		}
+		if index <= pushed {
+			continue
+		}
 		logger.Debugf("Push %s", doc.Path)
-		paths = append(paths, doc.Path)
 		path := dstore.PathFrom(doc.Path, 2)
-		event := &httpclient.VaultEvent{Path: path, Data: doc.Data}
+		event := &httpclient.VaultEvent{Path: path, Data: doc.Data, ID: pushID(doc.Path, doc.Data)}
 		events = append(events, event)
+		last = index
 	}
 
 	if len(events) > 0 {
 		logger.Infof("Pushing %d vault events", len(events))
-		if err := v.client.VaultSend(ctx, v.remote.Key, events); err != nil {
+		if err := client.VaultSend(ctx, v.remote.Key, events); err != nil {
 			return err
 		}
-		logger.Infof("Removing %d from push", len(paths))
-		if err := deleteAll(v.store, paths); err != nil {
+		// Only advance the pushed index after the remote acknowledged.
+		if err := v.setPushedIndex(last); err != nil {
 			return err
 		}
 	}
 
+	return v.prunePush()
+}
+
+// prunePush removes push entries pushed to all remotes.
+func (v *Vault) prunePush() error {
+	prune, err := v.prunePushIndex()
+	if err != nil {
+		return err
+	}
+	ds, err := v.store.List(&ListOptions{Prefix: dstore.Path("push"), NoData: true})
+	if err != nil {
+		return err
+	}
+	paths := []string{}
+	for _, doc := range ds {
+		index, err := unpad(dstore.PathComponents(doc.Path)[1])
+		if err != nil {
+			return err
+		}
+		if index <= prune {
+			paths = append(paths, doc.Path)
+		}
+	}
+	if len(paths) > 0 {
+		logger.Infof("Removing %d from push", len(paths))
+		if err := v.deleteLog(paths); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// pushID is an idempotency ID for a push entry.
+// If we fail before a push is acknowledged, we re-push with the same ID and
+// the remote ignores events it has already seen.
+func pushID(path string, b []byte) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(path))
+	_, _ = h.Write(b)
+	return encoding.MustEncode(h.Sum(nil), encoding.Base62)
+}
+
 // Pull events from remote.
 // Does NOT require Unlock.
 func (v *Vault) Pull(ctx context.Context) error {
@@ -326,9 +490,35 @@ func (v *Vault) Pull(ctx context.Context) error {
 }
 
 func (v *Vault) pull(ctx context.Context) error {
+	if v.remote == nil {
+		return errors.Errorf("no remote set")
+	}
+	return v.pullFrom(ctx, v.remote)
+}
+
+// pullLimit is the max number of events to pull per request.
+var pullLimit = 500
+
+// pullFrom pulls events from a remote, a page (pullLimit) at a time.
+// The pull index is saved after each page, so if interrupted, the next pull
+// resumes from the last page.
+func (v *Vault) pullFrom(ctx context.Context, remote *Remote) error {
+	client, err := v.remoteClient(remote)
+	if err != nil {
+		return err
+	}
+	index, err := v.pullIndex()
+	if err != nil {
+		return err
+	}
+	progress := newPullProgress(index, v.clock.Now())
+
 	// Keep pulling until no more.
 	for {
-		truncated, err := v.pullNext(ctx)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		truncated, err := v.pullNext(ctx, client, remote, progress)
 		if err != nil {
 			return err
 		}
@@ -339,21 +529,14 @@ func (v *Vault) pull(ctx context.Context) error {
 	return nil
 }
 
-func (v *Vault) pullNext(ctx context.Context) (bool, error) {
-	if v.client == nil {
-		return false, errors.Errorf("no vault client set")
-	}
-	if v.remote == nil {
-		return false, errors.Errorf("no remote set")
-	}
-
+func (v *Vault) pullNext(ctx context.Context, client *httpclient.Client, remote *Remote, progress *pullProgress) (bool, error) {
 	index, err := v.pullIndex()
 	if err != nil {
 		return false, err
 	}
 
-	logger.Infof("Pulling vault items")
-	vault, err := v.client.Vault(ctx, v.remote.Key, httpclient.VaultIndex(index))
+	logger.Infof("Pulling vault items (%d)", index)
+	vault, err := client.Vault(ctx, remote.Key, httpclient.VaultIndex(index), httpclient.VaultLimit(pullLimit))
 	if err != nil {
 		return false, err
 	}
@@ -361,6 +544,8 @@ func (v *Vault) pullNext(ctx context.Context) (bool, error) {
 		return false, err
 	}
 
+	v.subs.notify(progress.add(vault, v.clock.Now()))
+
 	return vault.Truncated, nil
 }
 
@@ -369,39 +554,79 @@ func (v *Vault) saveRemoteVault(vault *httpclient.Vault) error {
 		return errors.Errorf("vault not found")
 	}
 
-	for _, event := range vault.Events {
+	events := vault.Events
+	if vault.Snapshot != nil {
+		// Apply snapshot events we don't have yet, before the events after it.
+		index, err := v.pullIndex()
+		if err != nil {
+			return err
+		}
+		logger.Infof("Pulled snapshot (%d)", vault.Snapshot.Index)
+		snapshot := []*httpclient.VaultEvent{}
+		for _, event := range vault.Snapshot.Events {
+			if event.RemoteIndex > index {
+				snapshot = append(snapshot, event)
+			}
+		}
+		events = append(snapshot, events...)
+	}
+
+	for _, event := range events {
 		logger.Debugf("Pull %s", event.Path)
 		if event.Path == "" {
 			return errors.Errorf("invalid event (no path)")
 		}
-
-		if len(event.Data) == 0 {
-			logger.Debugf("Deleting %s", event.Path)
-			if _, err := v.store.Delete(event.Path); err != nil {
-				return err
-			}
-		} else {
-			logger.Debugf("Setting %s", event.Path)
-			if err := v.store.Set(event.Path, event.Data); err != nil {
-				return err
-			}
+		pull := dstore.Path("pull", pad(event.RemoteIndex), event.Path)
+		if err := v.applyPull(event, pull); err != nil {
+			return err
 		}
+	}
 
-		pull := dstore.Path("pull", pad(event.RemoteIndex), event.Path)
-		eb, err := msgpack.Marshal(event)
-		if err != nil {
+	// Update pull index.
+	if err := v.setPullIndex(vault.Index); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// applyPull applies a pulled event and saves it to the pull log (at pull).
+// For items, this updates the history and search index, and checks for
+// conflicts.
+func (v *Vault) applyPull(event *httpclient.VaultEvent, pull string) error {
+	if len(event.Data) == 0 {
+		logger.Debugf("Deleting %s", event.Path)
+		if _, err := v.store.Delete(event.Path); err != nil {
 			return err
 		}
-		if err := v.store.Set(pull, eb); err != nil {
+	} else {
+		logger.Debugf("Setting %s", event.Path)
+		if err := v.store.Set(event.Path, event.Data); err != nil {
 			return err
 		}
 	}
 
-	// Update pull index.
-	if err := v.setPullIndex(vault.Index); err != nil {
+	eb, err := msgpack.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := v.store.Set(pull, eb); err != nil {
+		return err
+	}
+	if err := v.setHistory(pull); err != nil {
 		return err
 	}
 
+	pc := dstore.PathComponents(event.Path)
+	if len(pc) == 2 && pc[0] == "item" {
+		if err := v.pullItem(pc[1], event, pull); err != nil {
+			return err
+		}
+		if err := v.indexPulled(pc[1]); err != nil {
+			return err
+		}
+		v.subs.notify(PullEvent{ID: pc[1], Deleted: len(event.Data) == 0})
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package vault_test
 import (
 	"bytes"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
@@ -107,9 +108,27 @@ type testEnv struct {
 	clock      tsutil.Clock
 	httpServer *httptest.Server
 	srv        *server.Server
+	handler    *testHandler
 	closeFn    func()
 }
 
+// testHandler wraps the server handler so we can inject failures.
+type testHandler struct {
+	handler http.Handler
+	// drop, if it returns true, handles the request and then fails the
+	// response, as if the connection was lost after the server handled it.
+	drop func(r *http.Request) bool
+}
+
+func (h *testHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.drop != nil && h.drop(r) {
+		h.handler.ServeHTTP(httptest.NewRecorder(), r)
+		http.Error(w, "connection lost", http.StatusBadGateway)
+		return
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
 func newTestEnv(t *testing.T, logger server.Logger) *testEnv {
 	if logger == nil {
 		logger = client.NewLogger(client.ErrLevel)
@@ -123,11 +142,11 @@ func newTestEnv(t *testing.T, logger server.Logger) *testEnv {
 	srv := server.New(fi, rds, req, clock, logger)
 	srv.SetClock(clock)
 	srv.SetInternalAuth("testtoken")
-	handler := server.NewHandler(srv)
+	handler := &testHandler{handler: server.NewHandler(srv)}
 	httpServer := httptest.NewServer(handler)
 	srv.URL = httpServer.URL
 
-	return &testEnv{clock, httpServer, srv, func() { httpServer.Close() }}
+	return &testEnv{clock, httpServer, srv, handler, func() { httpServer.Close() }}
 }
 
 func testPath() string {
@@ -375,7 +394,11 @@ func TestProtocol(t *testing.T) {
 	paths, err := vaultPaths(vlt, "")
 	require.NoError(t, err)
 	require.Equal(t, []string{
+		"/audit/000000000000001",
+		"/audit/000000000000002",
 		"/auth/0TWD4V5tkyUQGc5qXvlBDd2Fj97aqsMoBGJJjsttG4I",
+		"/config/audit",
+		"/history/testid1/push/000000000000003",
 		"/item/testid1",
 		"/provision/0TWD4V5tkyUQGc5qXvlBDd2Fj97aqsMoBGJJjsttG4I",
 		"/push/000000000000001/auth/0TWD4V5tkyUQGc5qXvlBDd2Fj97aqsMoBGJJjsttG4I",
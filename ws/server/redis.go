@@ -132,3 +132,29 @@ func (r *Redis) Delete(ctx context.Context, k string) error {
 	}
 	return nil
 }
+
+// GetDelete gets and deletes value (in a transaction).
+func (r *Redis) GetDelete(ctx context.Context, k string) (string, error) {
+	redisConn := r.redisPool.Get()
+	defer redisConn.Close()
+	if err := redisConn.Send("MULTI"); err != nil {
+		return "", err
+	}
+	if err := redisConn.Send("GET", k); err != nil {
+		return "", err
+	}
+	if err := redisConn.Send("DEL", k); err != nil {
+		return "", err
+	}
+	vals, err := redis.Values(redisConn.Do("EXEC"))
+	if err != nil {
+		return "", err
+	}
+	s, err := redis.String(vals[0], nil)
+	if err == redis.ErrNil {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return s, nil
+}